@@ -0,0 +1,190 @@
+// Package adapters feeds usage events from a message broker into Monigo, so
+// an existing event pipeline can be metered without custom glue.
+//
+// A Consumer reads messages from a Source, decodes each one as a
+// monigo.IngestEvent in the standard JSON schema
+//
+//	{"event_name": "api_call", "customer_id": "...", "idempotency_key": "...",
+//	 "timestamp": "2026-01-02T15:04:05Z", "properties": {"endpoint": "/v1/search"}}
+//
+// and ingests them in batches. A message is committed only after its event
+// has been ingested, so a crash or an outage redelivers it rather than
+// losing it; idempotency keys make the redelivery harmless.
+//
+// The package has no broker dependencies. Implement Source over the client
+// you already use:
+//
+//   - Kafka: Fetch polls records and sets Message.ID to
+//     "topic/partition/offset"; Commit commits the highest offset per
+//     partition.
+//   - NATS JetStream: Fetch pulls from a consumer; Commit acks each message.
+//   - SQS: Fetch calls ReceiveMessage; Commit calls DeleteMessageBatch with
+//     the receipt handles kept in Message.Handle.
+//
+// Then run a Consumer:
+//
+//	c := adapters.NewConsumer(src, client.Events, adapters.Options{BatchSize: 500})
+//	if err := c.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+//		log.Fatal(err)
+//	}
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+	"github.com/monigo-africa/go-monigo/eventqueue"
+)
+
+// Message is one record read from a topic or queue.
+type Message struct {
+	// ID uniquely identifies the message within the source, e.g.
+	// "usage/3/1042" for a Kafka record. It is used as the idempotency key
+	// of events that do not carry one.
+	ID string
+	// Value is the encoded event.
+	Value []byte
+	// Handle is whatever the Source needs to commit the message, such as a
+	// Kafka record or an SQS receipt handle. The Consumer does not read it.
+	Handle any
+}
+
+// Source is a broker subscription.
+type Source interface {
+	// Fetch blocks until at least one message is available or ctx is done,
+	// and returns at most max messages.
+	Fetch(ctx context.Context, max int) ([]Message, error)
+	// Commit marks msgs as processed so they are not delivered again.
+	Commit(ctx context.Context, msgs []Message) error
+}
+
+// Options configures a Consumer.
+type Options struct {
+	// BatchSize is the most messages fetched and ingested at once.
+	// Defaults to 500.
+	BatchSize int
+	// Decode turns a message into an event. Defaults to DecodeEvent.
+	Decode func(Message) (monigo.IngestEvent, error)
+	// OnInvalid, if set, is called for each message that cannot be decoded
+	// or fails validation. Such messages are committed and skipped, since
+	// redelivering them would not help.
+	OnInvalid func(Message, error)
+	// MaxBackoff caps the wait between retries of a batch that failed with
+	// a retryable error. Retries start at one second and double. Defaults
+	// to 30 seconds.
+	MaxBackoff time.Duration
+}
+
+// Consumer moves events from a Source to an eventqueue.Ingester.
+type Consumer struct {
+	source   Source
+	ingester eventqueue.Ingester
+	opts     Options
+}
+
+// NewConsumer returns a Consumer reading from source and ingesting through
+// ingester.
+func NewConsumer(source Source, ingester eventqueue.Ingester, opts Options) *Consumer {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 500
+	}
+	if opts.Decode == nil {
+		opts.Decode = DecodeEvent
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 30 * time.Second
+	}
+	return &Consumer{source: source, ingester: ingester, opts: opts}
+}
+
+// Run consumes until ctx is done or an error that retrying cannot fix
+// occurs, and returns that error. Batches failing with a retryable error
+// (see eventqueue.Retryable) are retried with backoff and left uncommitted
+// meanwhile; a batch the API rejects outright stops Run without being
+// committed, so it can be inspected and replayed.
+func (c *Consumer) Run(ctx context.Context) error {
+	for {
+		if err := c.Poll(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// Poll fetches, ingests and commits a single batch.
+func (c *Consumer) Poll(ctx context.Context) error {
+	msgs, err := c.source.Fetch(ctx, c.opts.BatchSize)
+	if err != nil {
+		return fmt.Errorf("adapters: fetch: %w", err)
+	}
+	if len(msgs) == 0 {
+		return ctx.Err()
+	}
+
+	events := make([]monigo.IngestEvent, 0, len(msgs))
+	for _, m := range msgs {
+		e, err := c.opts.Decode(m)
+		if err == nil {
+			err = monigo.IngestRequest{Events: []monigo.IngestEvent{e}}.Validate()
+		}
+		if err != nil {
+			if c.opts.OnInvalid != nil {
+				c.opts.OnInvalid(m, err)
+			}
+			continue
+		}
+		events = append(events, e)
+	}
+
+	if len(events) > 0 {
+		if err := c.ingest(ctx, events); err != nil {
+			return err
+		}
+	}
+	if err := c.source.Commit(ctx, msgs); err != nil {
+		return fmt.Errorf("adapters: commit: %w", err)
+	}
+	return nil
+}
+
+// ingest delivers events, retrying retryable failures until ctx is done.
+func (c *Consumer) ingest(ctx context.Context, events []monigo.IngestEvent) error {
+	backoff := min(time.Second, c.opts.MaxBackoff)
+	for {
+		_, err := c.ingester.Ingest(ctx, monigo.IngestRequest{Events: events})
+		if err == nil {
+			return nil
+		}
+		if !eventqueue.Retryable(err) || ctx.Err() != nil {
+			return err
+		}
+		t := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+		backoff = min(backoff*2, c.opts.MaxBackoff)
+	}
+}
+
+// DecodeEvent decodes m.Value as a JSON monigo.IngestEvent. An event
+// without an idempotency key is given m.ID, so a redelivered message is
+// de-duplicated by the API.
+func DecodeEvent(m Message) (monigo.IngestEvent, error) {
+	var e monigo.IngestEvent
+	if err := json.Unmarshal(m.Value, &e); err != nil {
+		return e, fmt.Errorf("adapters: decode message %s: %w", m.ID, err)
+	}
+	if e.IdempotencyKey == "" {
+		if m.ID == "" {
+			return e, errors.New("adapters: event has no idempotency_key and message has no ID")
+		}
+		e.IdempotencyKey = m.ID
+	}
+	return e, nil
+}
@@ -0,0 +1,122 @@
+package adapters_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+	"github.com/monigo-africa/go-monigo/adapters"
+	"github.com/monigo-africa/go-monigo/billingtest"
+)
+
+// memSource serves msgs once and records what was committed.
+type memSource struct {
+	mu        sync.Mutex
+	msgs      []adapters.Message
+	committed []string
+}
+
+func (s *memSource) Fetch(ctx context.Context, max int) ([]adapters.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := min(max, len(s.msgs))
+	out := s.msgs[:n]
+	s.msgs = s.msgs[n:]
+	return out, nil
+}
+
+func (s *memSource) Commit(ctx context.Context, msgs []adapters.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, m := range msgs {
+		s.committed = append(s.committed, m.ID)
+	}
+	return nil
+}
+
+func message(id, eventName string) adapters.Message {
+	return adapters.Message{
+		ID:    id,
+		Value: []byte(fmt.Sprintf(`{"event_name":%q,"customer_id":"cust-1","timestamp":"2026-01-02T15:04:05Z","properties":{}}`, eventName)),
+	}
+}
+
+func TestConsumer_IngestsThenCommits(t *testing.T) {
+	rec := billingtest.NewRecorder(t)
+	src := &memSource{msgs: []adapters.Message{
+		message("usage/0/1", "api_call"),
+		message("usage/0/2", "api_call"),
+		{ID: "usage/0/3", Value: []byte("not json")},
+	}}
+	var invalid []string
+	c := adapters.NewConsumer(src, rec, adapters.Options{
+		OnInvalid: func(m adapters.Message, err error) { invalid = append(invalid, m.ID) },
+	})
+
+	if err := c.Poll(context.Background()); err != nil {
+		t.Fatalf("Poll: %v", err)
+	}
+	if got := rec.Count("api_call"); got != 2 {
+		t.Errorf("expected 2 events ingested, got %d", got)
+	}
+	if rec.Events()[0].IdempotencyKey != "usage/0/1" {
+		t.Errorf("expected message ID as idempotency key, got %q", rec.Events()[0].IdempotencyKey)
+	}
+	if len(invalid) != 1 || invalid[0] != "usage/0/3" {
+		t.Errorf("expected usage/0/3 reported invalid, got %v", invalid)
+	}
+	if len(src.committed) != 3 {
+		t.Errorf("expected all 3 messages committed, got %v", src.committed)
+	}
+}
+
+// failingIngester fails with errs in turn, then succeeds.
+type failingIngester struct {
+	errs  []error
+	calls int
+}
+
+func (f *failingIngester) Ingest(ctx context.Context, req monigo.IngestRequest, opts ...monigo.RequestOption) (*monigo.IngestResponse, error) {
+	f.calls++
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		return nil, err
+	}
+	return &monigo.IngestResponse{}, nil
+}
+
+func TestConsumer_RetriesRetryableErrors(t *testing.T) {
+	src := &memSource{msgs: []adapters.Message{message("m-1", "api_call")}}
+	ing := &failingIngester{errs: []error{&monigo.APIError{StatusCode: 503}}}
+	c := adapters.NewConsumer(src, ing, adapters.Options{MaxBackoff: time.Millisecond})
+
+	if err := c.Poll(context.Background()); err != nil {
+		t.Fatalf("Poll: %v", err)
+	}
+	if ing.calls != 2 {
+		t.Errorf("expected 2 attempts, got %d", ing.calls)
+	}
+	if len(src.committed) != 1 {
+		t.Errorf("expected message committed after retry, got %v", src.committed)
+	}
+}
+
+func TestConsumer_RejectedBatchIsNotCommitted(t *testing.T) {
+	src := &memSource{msgs: []adapters.Message{message("m-1", "api_call")}}
+	ing := &failingIngester{errs: []error{&monigo.APIError{StatusCode: 403, Message: "forbidden"}}}
+	c := adapters.NewConsumer(src, ing, adapters.Options{})
+
+	err := c.Run(context.Background())
+	var apiErr *monigo.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 403 {
+		t.Fatalf("expected the 403 to stop Run, got %v", err)
+	}
+	if len(src.committed) != 0 {
+		t.Errorf("expected nothing committed, got %v", src.committed)
+	}
+}
@@ -0,0 +1,73 @@
+package monigo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// AlertService manages usage threshold alerts, e.g. "notify when customer X
+// exceeds 80% of included units on metric Y".
+type AlertService struct {
+	client *Client
+}
+
+// Create defines a new usage alert.
+func (s *AlertService) Create(ctx context.Context, req CreateAlertRequest, opts ...RequestOption) (*Alert, error) {
+	var wrapper struct {
+		Alert Alert `json:"alert"`
+	}
+	if err := s.client.do(ctx, "POST", "/v1/alerts", req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Alert, nil
+}
+
+// List returns alerts, optionally filtered by customer or metric.
+func (s *AlertService) List(ctx context.Context, params ListAlertsParams) (*ListAlertsResponse, error) {
+	q := url.Values{}
+	if params.CustomerID != "" {
+		q.Set("customer_id", params.CustomerID)
+	}
+	if params.MetricID != "" {
+		q.Set("metric_id", params.MetricID)
+	}
+
+	path := "/v1/alerts"
+	if len(q) > 0 {
+		path = path + "?" + q.Encode()
+	}
+
+	var out ListAlertsResponse
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete permanently removes an alert.
+func (s *AlertService) Delete(ctx context.Context, alertID string) error {
+	path, err := pathf("/v1/alerts/%s", alertID)
+	if err != nil {
+		return err
+	}
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// ParseAlertWebhook verifies and decodes the body of an alert webhook
+// request. signature is the request's WebhookSignatureHeader header and
+// secret the endpoint's signing secret; see VerifyWebhook.
+func ParseAlertWebhook(body []byte, signature, secret string) (*AlertWebhookPayload, error) {
+	if err := VerifyWebhook(body, signature, secret); err != nil {
+		return nil, err
+	}
+	var p AlertWebhookPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, fmt.Errorf("monigo: decode alert webhook: %w", err)
+	}
+	if p.Type != AlertWebhookEventTriggered {
+		return nil, fmt.Errorf("monigo: unexpected webhook type %q", p.Type)
+	}
+	return &p, nil
+}
@@ -0,0 +1,105 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+var sampleAlert = monigo.Alert{
+	ID:               "alert-1",
+	OrgID:            "org-1",
+	CustomerID:       "cust-abc",
+	MetricID:         "metric-1",
+	ThresholdPercent: 80,
+	Enabled:          true,
+	CreatedAt:        time.Now(),
+	UpdatedAt:        time.Now(),
+}
+
+func TestAlerts_Create(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/alerts")
+		assertBearerToken(t, r)
+
+		var req monigo.CreateAlertRequest
+		decodeBody(t, r, &req)
+		if req.ThresholdPercent != 80 {
+			t.Errorf("threshold_percent: got %v, want 80", req.ThresholdPercent)
+		}
+		respondJSON(t, w, 201, map[string]any{"alert": sampleAlert})
+	}))
+
+	alert, err := c.Alerts.Create(context.Background(), monigo.CreateAlertRequest{
+		CustomerID:       "cust-abc",
+		MetricID:         "metric-1",
+		ThresholdPercent: 80,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if alert.ID != "alert-1" {
+		t.Errorf("expected alert-1, got %s", alert.ID)
+	}
+}
+
+func TestAlerts_List_WithFilters(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/alerts")
+		if r.URL.Query().Get("metric_id") != "metric-1" {
+			t.Errorf("metric_id: got %q, want metric-1", r.URL.Query().Get("metric_id"))
+		}
+		respondJSON(t, w, 200, monigo.ListAlertsResponse{Alerts: []monigo.Alert{sampleAlert}, Count: 1})
+	}))
+
+	resp, err := c.Alerts.List(context.Background(), monigo.ListAlertsParams{MetricID: "metric-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Count != 1 {
+		t.Errorf("expected count 1, got %d", resp.Count)
+	}
+}
+
+func TestAlerts_Delete(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "DELETE")
+		assertPath(t, r, "/v1/alerts/alert-1")
+		w.WriteHeader(204)
+	}))
+
+	if err := c.Alerts.Delete(context.Background(), "alert-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestParseAlertWebhook(t *testing.T) {
+	body := []byte(`{
+		"type": "alert.triggered",
+		"alert_id": "alert-1",
+		"customer_id": "cust-abc",
+		"metric_id": "metric-1",
+		"threshold_percent": 80,
+		"included_units": 10000,
+		"current_usage": 8012,
+		"triggered_at": "2026-03-20T09:30:00Z"
+	}`)
+
+	p, err := monigo.ParseAlertWebhook(body, monigo.SignWebhook(body, "whsec_test", time.Now()), "whsec_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.CurrentUsage != 8012 || p.IncludedUnits != 10000 {
+		t.Errorf("unexpected payload: %+v", p)
+	}
+
+	other := []byte(`{"type":"invoice.finalized"}`)
+	if _, err := monigo.ParseAlertWebhook(other, monigo.SignWebhook(other, "whsec_test", time.Now()), "whsec_test"); err == nil {
+		t.Error("expected error for non-alert webhook type")
+	}
+}
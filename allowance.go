@@ -0,0 +1,101 @@
+package monigo
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// WithAllowanceCache caches UsageService.Allowance results in memory for
+// ttl, so pre-flight checks on hot paths cost one request per customer and
+// metric per ttl. Keep ttl short — a few seconds — since cached results do
+// not see usage ingested after they were fetched.
+func WithAllowanceCache(ttl time.Duration) Option {
+	return func(c *Client) {
+		if ttl > 0 {
+			c.allowances = &allowanceCache{ttl: ttl, entries: make(map[string]allowanceEntry)}
+		}
+	}
+}
+
+type allowanceCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]allowanceEntry
+	swept   time.Time
+}
+
+type allowanceEntry struct {
+	allowance Allowance
+	expires   time.Time
+}
+
+func (c *allowanceCache) get(key string) (Allowance, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return Allowance{}, false
+	}
+	if time.Now().After(e.expires) {
+		delete(c.entries, key)
+		return Allowance{}, false
+	}
+	return e.allowance, true
+}
+
+// set stores a under key. At most once per TTL it first drops every expired
+// entry, so the map only holds keys written in the last two TTLs however
+// many customers and metrics are queried over the client's lifetime.
+func (c *allowanceCache) set(key string, a Allowance) {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if now.Sub(c.swept) >= c.ttl {
+		for k, e := range c.entries {
+			if now.After(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.swept = now
+	}
+	c.entries[key] = allowanceEntry{allowance: a, expires: now.Add(c.ttl)}
+}
+
+// Allowance returns how much of a metric a customer has included, has
+// consumed, and has remaining in the current billing period. It is served
+// from a lightweight endpoint meant for pre-flight checks such as "does
+// this customer have SMS credits left?"; see WithAllowanceCache to cache
+// the answer briefly.
+func (s *UsageService) Allowance(ctx context.Context, customerID, metricID string) (*Allowance, error) {
+	if err := checkID(customerID); err != nil {
+		return nil, err
+	}
+	if err := checkID(metricID); err != nil {
+		return nil, err
+	}
+	q := url.Values{"customer_id": {customerID}, "metric_id": {metricID}}
+	path := "/v1/usage/allowance?" + q.Encode()
+	// Headers from the context, such as X-On-Behalf-Of, are part of the
+	// key: the same customer ID can name another organisation's customer.
+	var key string
+	if s.client.allowances != nil {
+		key = s.client.cacheKey(path, s.client.requestConfig(ctx, nil).header)
+		if a, ok := s.client.allowances.get(key); ok {
+			return &a, nil
+		}
+	}
+
+	var wrapper struct {
+		Allowance Allowance `json:"allowance"`
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	if s.client.allowances != nil {
+		s.client.allowances.set(key, wrapper.Allowance)
+	}
+	return &wrapper.Allowance, nil
+}
@@ -0,0 +1,84 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestUsage_Allowance(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/usage/allowance")
+		q := r.URL.Query()
+		if q.Get("customer_id") != "cust-abc" || q.Get("metric_id") != "metric-1" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		respondJSON(t, w, 200, map[string]any{"allowance": monigo.Allowance{
+			CustomerID: "cust-abc",
+			MetricID:   "metric-1",
+			Included:   1000,
+			Consumed:   990,
+			Remaining:  10,
+		}})
+	}))
+
+	a, err := c.Usage.Allowance(context.Background(), "cust-abc", "metric-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !a.Has(10) || a.Has(11) {
+		t.Errorf("unexpected Has results for remaining %v", a.Remaining)
+	}
+}
+
+func TestUsage_Allowance_Cached(t *testing.T) {
+	var calls atomic.Int32
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		respondJSON(t, w, 200, map[string]any{"allowance": monigo.Allowance{Unlimited: true}})
+	}), monigo.WithAllowanceCache(50*time.Millisecond))
+
+	ctx := context.Background()
+	for range 3 {
+		if _, err := c.Usage.Allowance(ctx, "cust-abc", "metric-1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := c.Usage.Allowance(ctx, "cust-abc", "metric-2"); err != nil {
+		t.Fatal(err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected 2 requests within the TTL, got %d", got)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if _, err := c.Usage.Allowance(ctx, "cust-abc", "metric-1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("expected a fresh request after the TTL, got %d requests", got)
+	}
+}
+
+func TestUsage_Allowance_CachedPerOnBehalfOf(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		unlimited := r.Header.Get("X-On-Behalf-Of") == "org-a"
+		respondJSON(t, w, 200, map[string]any{"allowance": monigo.Allowance{Unlimited: unlimited}})
+	}), monigo.WithAllowanceCache(time.Minute))
+
+	for _, org := range []string{"org-a", "org-b"} {
+		ctx := monigo.ContextWithOptions(context.Background(), monigo.WithHeader("X-On-Behalf-Of", org))
+		a, err := c.Usage.Allowance(ctx, "cust-abc", "metric-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.Unlimited != (org == "org-a") {
+			t.Errorf("%s got another organisation's allowance: %+v", org, a)
+		}
+	}
+}
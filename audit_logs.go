@@ -0,0 +1,55 @@
+package monigo
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// AuditLogService reads the organisation's audit trail: who changed what,
+// and when.
+type AuditLogService struct {
+	client *Client
+}
+
+// List returns audit log entries matching params, newest first. Follow
+// NextCursor to page through the full trail.
+func (s *AuditLogService) List(ctx context.Context, params ListAuditLogsParams) (*ListAuditLogsResponse, error) {
+	q := url.Values{}
+	if params.ActorID != "" {
+		q.Set("actor_id", params.ActorID)
+	}
+	if params.Action != "" {
+		q.Set("action", params.Action)
+	}
+	if params.ResourceType != "" {
+		q.Set("resource_type", params.ResourceType)
+	}
+	if params.ResourceID != "" {
+		q.Set("resource_id", params.ResourceID)
+	}
+	if params.From != nil {
+		q.Set("from", params.From.UTC().Format(time.RFC3339))
+	}
+	if params.To != nil {
+		q.Set("to", params.To.UTC().Format(time.RFC3339))
+	}
+	if params.Cursor != "" {
+		q.Set("cursor", params.Cursor)
+	}
+	if params.Limit > 0 {
+		q.Set("limit", strconv.Itoa(params.Limit))
+	}
+
+	path := "/v1/audit-logs"
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	var out ListAuditLogsResponse
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,51 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestAuditLogs_List(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/audit-logs")
+		q := r.URL.Query()
+		if q.Get("resource_type") != "plan" || q.Get("action") != "plan.updated" || q.Get("from") != "2026-01-01T00:00:00Z" || q.Get("limit") != "50" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		respondJSON(t, w, 200, map[string]any{
+			"audit_logs": []map[string]any{{
+				"id":            "audit-1",
+				"action":        "plan.updated",
+				"resource_type": "plan",
+				"resource_id":   "plan-1",
+				"actor":         map[string]any{"type": "user", "id": "user-1", "email": "ops@acme.example"},
+				"changes":       map[string]any{"name": map[string]any{"old": "Starter", "new": "Starter 2026"}},
+			}},
+			"count":       1,
+			"next_cursor": "next",
+		})
+	}))
+
+	res, err := c.AuditLogs.List(context.Background(), monigo.ListAuditLogsParams{
+		Action:       "plan.updated",
+		ResourceType: "plan",
+		From:         &from,
+		Limit:        50,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(res.Entries) != 1 || res.NextCursor != "next" {
+		t.Fatalf("unexpected response: %+v", res)
+	}
+	e := res.Entries[0]
+	if e.Actor.Type != monigo.AuditActorUser || string(e.Changes["name"].New) != `"Starter 2026"` {
+		t.Errorf("unexpected entry: %+v", e)
+	}
+}
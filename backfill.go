@@ -0,0 +1,252 @@
+package monigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// EventSource yields the events for EventService.Backfill. Next returns
+// io.EOF once the source is exhausted; any other error stops the backfill.
+type EventSource interface {
+	Next(ctx context.Context) (IngestEvent, error)
+}
+
+// EventSourceFunc adapts an ordinary function to the EventSource interface.
+type EventSourceFunc func(ctx context.Context) (IngestEvent, error)
+
+// Next calls f(ctx).
+func (f EventSourceFunc) Next(ctx context.Context) (IngestEvent, error) {
+	return f(ctx)
+}
+
+// EventsFromChannel returns an EventSource that reads ch until it is closed.
+func EventsFromChannel(ch <-chan IngestEvent) EventSource {
+	return EventSourceFunc(func(ctx context.Context) (IngestEvent, error) {
+		select {
+		case <-ctx.Done():
+			return IngestEvent{}, ctx.Err()
+		case e, ok := <-ch:
+			if !ok {
+				return IngestEvent{}, io.EOF
+			}
+			return e, nil
+		}
+	})
+}
+
+// EventsFromSlice returns an EventSource that yields events in order.
+func EventsFromSlice(events []IngestEvent) EventSource {
+	i := 0
+	return EventSourceFunc(func(context.Context) (IngestEvent, error) {
+		if i >= len(events) {
+			return IngestEvent{}, io.EOF
+		}
+		i++
+		return events[i-1], nil
+	})
+}
+
+// BackfillOptions configures EventService.Backfill.
+type BackfillOptions struct {
+	// Workers is the number of batches in flight at once. Defaults to 4.
+	Workers int
+	// BatchSize is the number of events per Ingest call. Defaults to 500.
+	BatchSize int
+	// RateLimit caps Ingest calls per second across all workers, on top of
+	// any limiter configured on the client. Zero means no extra cap.
+	RateLimit float64
+	// Resume skips this many events from the start of the source, typically
+	// the last checkpoint of an interrupted run.
+	Resume int64
+	// Checkpoint, if set, is called from a single goroutine whenever the
+	// prefix of the source known to be ingested grows, with the number of
+	// events in that prefix (including Resume). Persist it and pass it back
+	// as Resume to continue after a failure. An error stops the backfill.
+	Checkpoint func(offset int64) error
+	// Progress, if set, is called from a single goroutine after every batch.
+	Progress func(BackfillReport)
+}
+
+// BackfillReport summarises a backfill. Counts are of events.
+type BackfillReport struct {
+	// Sent is the number of events in batches that completed, successfully
+	// or not.
+	Sent int64
+	// Ingested and Duplicates are totals of the IngestResponse fields.
+	Ingested   int64
+	Duplicates int64
+	// Failed is the number of events in batches that returned an error.
+	Failed int64
+	// Batches is the number of batches that completed.
+	Batches int
+	// Checkpoint is the offset to pass as BackfillOptions.Resume to continue
+	// from this run. Every event before it has been accepted.
+	Checkpoint int64
+	// Elapsed is the time since Backfill started.
+	Elapsed time.Duration
+}
+
+// Backfill ingests every event from src using a pool of workers, for
+// migrations of historical usage too large for a single Ingest call.
+// Batches may complete out of order, but the checkpoint only ever covers
+// a contiguous prefix of the source, so resuming from it never skips an
+// event. Events are de-duplicated by IdempotencyKey, so re-sending the
+// batches after the checkpoint is safe.
+//
+// The first failing batch stops the backfill; the report so far is returned
+// together with the error. Requires an API key with the "ingest" scope.
+func (s *EventService) Backfill(ctx context.Context, src EventSource, opts BackfillOptions) (*BackfillReport, error) {
+	if opts.Workers <= 0 {
+		opts.Workers = 4
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 500
+	}
+	var limiter *TokenBucket
+	if opts.RateLimit > 0 {
+		limiter = NewTokenBucket(opts.RateLimit, 1)
+	}
+
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	start := time.Now()
+
+	type batch struct {
+		seq    int
+		events []IngestEvent
+	}
+	type result struct {
+		seq  int
+		n    int
+		resp *IngestResponse
+		err  error
+	}
+	batches := make(chan batch)
+	results := make(chan result)
+
+	// readErr is written before batches is closed and read after results
+	// is closed, so it needs no lock. When the caller's ctx ends the read
+	// it is ctx's error; when a failing batch does, the batch's error is
+	// reported instead.
+	var readErr error
+	go func() {
+		defer close(batches)
+		var (
+			seq     int
+			skipped int64
+			events  []IngestEvent
+		)
+		send := func() bool {
+			select {
+			case batches <- batch{seq, events}:
+				seq++
+				events = nil
+				return true
+			case <-ctx.Done():
+				readErr = parent.Err()
+				return false
+			}
+		}
+		for {
+			e, err := src.Next(ctx)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				switch {
+				case parent.Err() != nil:
+					readErr = parent.Err()
+				case ctx.Err() == nil:
+					readErr = fmt.Errorf("monigo: backfill source: %w", err)
+				}
+				return
+			}
+			if skipped < opts.Resume {
+				skipped++
+				continue
+			}
+			events = append(events, e)
+			if len(events) == opts.BatchSize && !send() {
+				return
+			}
+		}
+		if len(events) > 0 {
+			send()
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < opts.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := range batches {
+				r := result{seq: b.seq, n: len(b.events)}
+				if limiter != nil {
+					r.err = limiter.Wait(ctx)
+				}
+				if r.err == nil {
+					r.resp, r.err = s.Ingest(ctx, IngestRequest{Events: b.events})
+				}
+				results <- r
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	report := &BackfillReport{Checkpoint: opts.Resume}
+	var (
+		firstErr error
+		next     int
+		done     = make(map[int]int) // completed batches past the checkpoint
+	)
+	fail := func(err error) {
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+	for r := range results {
+		report.Batches++
+		report.Sent += int64(r.n)
+		if r.resp != nil {
+			report.Ingested += int64(len(r.resp.Ingested))
+			report.Duplicates += int64(len(r.resp.Duplicates))
+		}
+		if r.err != nil {
+			report.Failed += int64(r.n)
+			fail(fmt.Errorf("monigo: backfill batch %d: %w", r.seq, r.err))
+		} else if firstErr == nil {
+			done[r.seq] = r.n
+			advanced := false
+			for n, ok := done[next]; ok; n, ok = done[next] {
+				report.Checkpoint += int64(n)
+				delete(done, next)
+				next++
+				advanced = true
+			}
+			if advanced && opts.Checkpoint != nil {
+				if err := opts.Checkpoint(report.Checkpoint); err != nil {
+					fail(fmt.Errorf("monigo: backfill checkpoint: %w", err))
+				}
+			}
+		}
+		report.Elapsed = time.Since(start)
+		if opts.Progress != nil {
+			opts.Progress(*report)
+		}
+	}
+	report.Elapsed = time.Since(start)
+	if firstErr == nil {
+		firstErr = readErr
+	}
+	return report, firstErr
+}
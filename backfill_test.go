@@ -0,0 +1,143 @@
+package monigo_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func backfillEvents(n int) []monigo.IngestEvent {
+	events := make([]monigo.IngestEvent, n)
+	for i := range events {
+		events[i] = monigo.IngestEvent{EventName: "api_call", CustomerID: "cust-abc", IdempotencyKey: fmt.Sprintf("k%d", i)}
+	}
+	return events
+}
+
+func TestEvents_Backfill(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		seen = make(map[string]bool)
+	)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertPath(t, r, "/v1/ingest")
+		var req monigo.IngestRequest
+		decodeBody(t, r, &req)
+		if len(req.Events) > 10 {
+			t.Errorf("expected batches of at most 10, got %d", len(req.Events))
+		}
+		var keys []string
+		mu.Lock()
+		for _, e := range req.Events {
+			seen[e.IdempotencyKey] = true
+			keys = append(keys, e.IdempotencyKey)
+		}
+		mu.Unlock()
+		respondJSON(t, w, 202, map[string]any{"ingested": keys, "duplicates": []string{}})
+	}))
+
+	var checkpoints []int64
+	report, err := c.Events.Backfill(context.Background(), monigo.EventsFromSlice(backfillEvents(95)), monigo.BackfillOptions{
+		Workers:    3,
+		BatchSize:  10,
+		Checkpoint: func(offset int64) error { checkpoints = append(checkpoints, offset); return nil },
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Ingested != 95 || report.Batches != 10 || report.Checkpoint != 95 || report.Failed != 0 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+	if len(seen) != 95 {
+		t.Errorf("expected 95 distinct events, got %d", len(seen))
+	}
+	for i := 1; i < len(checkpoints); i++ {
+		if checkpoints[i] <= checkpoints[i-1] {
+			t.Errorf("checkpoints must increase, got %v", checkpoints)
+		}
+	}
+	if len(checkpoints) == 0 || checkpoints[len(checkpoints)-1] != 95 {
+		t.Errorf("expected a final checkpoint of 95, got %v", checkpoints)
+	}
+}
+
+func TestEvents_Backfill_Resume(t *testing.T) {
+	var first string
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req monigo.IngestRequest
+		decodeBody(t, r, &req)
+		first = req.Events[0].IdempotencyKey
+		respondJSON(t, w, 202, map[string]any{"ingested": []string{first}, "duplicates": []string{}})
+	}))
+
+	ch := make(chan monigo.IngestEvent, 5)
+	for _, e := range backfillEvents(5) {
+		ch <- e
+	}
+	close(ch)
+	report, err := c.Events.Backfill(context.Background(), monigo.EventsFromChannel(ch), monigo.BackfillOptions{Workers: 1, Resume: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first != "k3" {
+		t.Errorf("expected the first sent event to be k3, got %s", first)
+	}
+	if report.Sent != 2 || report.Checkpoint != 5 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+}
+
+func TestEvents_Backfill_StopsOnError(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req monigo.IngestRequest
+		decodeBody(t, r, &req)
+		if req.Events[0].IdempotencyKey == "k20" {
+			respondError(t, w, 400, "invalid event")
+			return
+		}
+		respondJSON(t, w, 202, map[string]any{"ingested": []string{}, "duplicates": []string{}})
+	}))
+
+	report, err := c.Events.Backfill(context.Background(), monigo.EventsFromSlice(backfillEvents(100)), monigo.BackfillOptions{Workers: 1, BatchSize: 10})
+	var apiErr *monigo.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 400 {
+		t.Fatalf("expected the batch's 400, got %v", err)
+	}
+	if report.Checkpoint != 20 || report.Failed == 0 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+}
+
+func TestEvents_Backfill_SourceError(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no batch should be sent")
+	}))
+	src := monigo.EventSourceFunc(func(context.Context) (monigo.IngestEvent, error) {
+		return monigo.IngestEvent{}, errors.New("disk on fire")
+	})
+	if _, err := c.Events.Backfill(context.Background(), src, monigo.BackfillOptions{}); err == nil {
+		t.Error("expected the source error")
+	}
+}
+
+func TestEvents_Backfill_Canceled(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no batch should be sent")
+	}))
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan monigo.IngestEvent)
+	go func() {
+		ch <- backfillEvents(1)[0]
+		cancel()
+	}()
+
+	_, err := c.Events.Backfill(ctx, monigo.EventsFromChannel(ch), monigo.BackfillOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,28 @@
+package monigo
+
+import (
+	"context"
+	"net/url"
+)
+
+// BankService lists the banks and mobile-money providers Monigo can pay
+// out to.
+type BankService struct {
+	client *Client
+}
+
+// List returns the banks and mobile-money providers in country, given as an
+// ISO 3166-1 alpha-2 code such as "NG", "KE", or "GH". An empty country
+// returns every supported institution.
+func (s *BankService) List(ctx context.Context, country string) (*ListBanksResponse, error) {
+	path := "/v1/banks"
+	if country != "" {
+		path += "?" + url.Values{"country": {country}}.Encode()
+	}
+
+	var out ListBanksResponse
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,48 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestBanks_List(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/banks")
+		assertBearerToken(t, r)
+		if got := r.URL.Query().Get("country"); got != "KE" {
+			t.Errorf("country: got %q, want KE", got)
+		}
+		respondJSON(t, w, 200, monigo.ListBanksResponse{
+			Banks: []monigo.Bank{
+				{Code: "01", Name: "KCB Bank", Country: "KE", Currency: "KES", PayoutMethods: []monigo.PayoutMethod{monigo.PayoutMethodBankTransfer}},
+				{Code: "MPESA", Name: "M-Pesa", Country: "KE", Currency: "KES", PayoutMethods: []monigo.PayoutMethod{monigo.PayoutMethodMobileMoney}},
+			},
+			Count: 2,
+		})
+	}))
+
+	resp, err := c.Banks.List(context.Background(), "KE")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Count != 2 || resp.Banks[1].PayoutMethods[0] != monigo.PayoutMethodMobileMoney {
+		t.Errorf("unexpected banks: %+v", resp)
+	}
+}
+
+func TestBanks_List_AllCountries(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("expected no query, got %q", r.URL.RawQuery)
+		}
+		respondJSON(t, w, 200, monigo.ListBanksResponse{})
+	}))
+
+	if _, err := c.Banks.List(context.Background(), ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+// Package billingtest helps unit-test that code paths meter usage correctly
+// without calling the Monigo API.
+//
+// A Recorder stands in for Monigo: hand its Client to the code under test,
+// run the code path, then assert on the events it would have ingested:
+//
+//	func TestSearchMeters(t *testing.T) {
+//		rec := billingtest.NewRecorder(t)
+//		svc := search.New(rec.Client())
+//
+//		svc.Search(ctx, "cust-1", "shoes")
+//
+//		rec.AssertCharged(t, "search.query", 1)
+//	}
+package billingtest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+// Recorder records every usage event sent to it, either through the client
+// returned by Client or by calling Ingest directly. Events re-sent with an
+// idempotency key that was already recorded are ignored, as the API would.
+// It is safe for concurrent use.
+type Recorder struct {
+	srv    *httptest.Server
+	client *monigo.Client
+
+	mu     sync.Mutex
+	events []monigo.IngestEvent
+	seen   map[string]bool
+}
+
+// NewRecorder starts a Recorder that is shut down when t finishes.
+func NewRecorder(t testing.TB) *Recorder {
+	t.Helper()
+	r := &Recorder{seen: make(map[string]bool)}
+	r.srv = httptest.NewServer(http.HandlerFunc(r.serveHTTP))
+	t.Cleanup(r.srv.Close)
+	r.client = monigo.New("sk_test_billingtest", monigo.WithBaseURL(r.srv.URL))
+	return r
+}
+
+// Client returns a *monigo.Client whose Events.Ingest calls are recorded.
+// Any other API call fails with a 501 APIError.
+func (r *Recorder) Client() *monigo.Client {
+	return r.client
+}
+
+// Ingest records req.Events. It has the same signature as
+// EventService.Ingest so the Recorder can satisfy interfaces that code under
+// test defines over the event service, and rejects the same invalid requests.
+func (r *Recorder) Ingest(ctx context.Context, req monigo.IngestRequest, opts ...monigo.RequestOption) (*monigo.IngestResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return r.record(req.Events), nil
+}
+
+// Events returns a copy of the recorded events in the order they arrived.
+func (r *Recorder) Events() []monigo.IngestEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]monigo.IngestEvent(nil), r.events...)
+}
+
+// Reset discards everything recorded so far.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = nil
+	r.seen = make(map[string]bool)
+}
+
+// Count returns the number of recorded events named eventName.
+func (r *Recorder) Count(eventName string) int {
+	return r.count(func(e monigo.IngestEvent) bool { return e.EventName == eventName })
+}
+
+// AssertCharged fails t unless exactly n events named eventName were recorded.
+func (r *Recorder) AssertCharged(t testing.TB, eventName string, n int) {
+	t.Helper()
+	if got := r.Count(eventName); got != n {
+		t.Errorf("billingtest: %q charged %d times, want %d", eventName, got, n)
+	}
+}
+
+// AssertChargedCustomer fails t unless exactly n events named eventName were
+// recorded for customerID.
+func (r *Recorder) AssertChargedCustomer(t testing.TB, customerID, eventName string, n int) {
+	t.Helper()
+	got := r.count(func(e monigo.IngestEvent) bool {
+		return e.EventName == eventName && e.CustomerID == customerID
+	})
+	if got != n {
+		t.Errorf("billingtest: %q charged %d times to customer %s, want %d", eventName, got, customerID, n)
+	}
+}
+
+// AssertNotCharged fails t if any event named eventName was recorded.
+func (r *Recorder) AssertNotCharged(t testing.TB, eventName string) {
+	t.Helper()
+	if got := r.Count(eventName); got != 0 {
+		t.Errorf("billingtest: %q charged %d times, want none", eventName, got)
+	}
+}
+
+// AssertNothingCharged fails t if any event at all was recorded.
+func (r *Recorder) AssertNothingCharged(t testing.TB) {
+	t.Helper()
+	if events := r.Events(); len(events) != 0 {
+		t.Errorf("billingtest: %d events charged, want none (first: %q)", len(events), events[0].EventName)
+	}
+}
+
+func (r *Recorder) count(match func(monigo.IngestEvent) bool) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, e := range r.events {
+		if match(e) {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *Recorder) record(events []monigo.IngestEvent) *monigo.IngestResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	resp := &monigo.IngestResponse{Ingested: []string{}, Duplicates: []string{}}
+	for _, e := range events {
+		if e.IdempotencyKey != "" && r.seen[e.IdempotencyKey] {
+			resp.Duplicates = append(resp.Duplicates, e.IdempotencyKey)
+			continue
+		}
+		if e.IdempotencyKey != "" {
+			r.seen[e.IdempotencyKey] = true
+		}
+		r.events = append(r.events, e)
+		resp.Ingested = append(resp.Ingested, e.IdempotencyKey)
+	}
+	return resp
+}
+
+func (r *Recorder) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if req.Method != http.MethodPost || req.URL.Path != "/v1/ingest" {
+		w.WriteHeader(http.StatusNotImplemented)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error": "billingtest: only event ingestion is recorded, got " + req.Method + " " + req.URL.Path,
+		})
+		return
+	}
+	var body monigo.IngestRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "billingtest: " + err.Error()})
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(r.record(body.Events))
+}
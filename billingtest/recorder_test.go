@@ -0,0 +1,103 @@
+package billingtest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+	"github.com/monigo-africa/go-monigo/billingtest"
+)
+
+func event(name, customer, key string) monigo.IngestEvent {
+	return monigo.IngestEvent{
+		EventName:      name,
+		CustomerID:     customer,
+		IdempotencyKey: key,
+		Timestamp:      time.Now(),
+	}
+}
+
+func TestRecorder_ThroughClient(t *testing.T) {
+	rec := billingtest.NewRecorder(t)
+
+	resp, err := rec.Client().Events.Ingest(context.Background(), monigo.IngestRequest{Events: []monigo.IngestEvent{
+		event("api_call", "cust-1", "k1"),
+		event("api_call", "cust-1", "k2"),
+		event("api_call", "cust-2", "k3"),
+		event("storage.write", "cust-1", "k4"),
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Ingested) != 4 {
+		t.Errorf("expected 4 ingested, got %d", len(resp.Ingested))
+	}
+
+	rec.AssertCharged(t, "api_call", 3)
+	rec.AssertChargedCustomer(t, "cust-1", "api_call", 2)
+	rec.AssertNotCharged(t, "export")
+}
+
+func TestRecorder_DeduplicatesIdempotencyKeys(t *testing.T) {
+	rec := billingtest.NewRecorder(t)
+	ctx := context.Background()
+
+	req := monigo.IngestRequest{Events: []monigo.IngestEvent{event("api_call", "cust-1", "k1")}}
+	rec.Ingest(ctx, req)
+	resp, _ := rec.Ingest(ctx, req)
+
+	if len(resp.Duplicates) != 1 {
+		t.Errorf("expected the retry to be reported as a duplicate, got %+v", resp)
+	}
+	rec.AssertCharged(t, "api_call", 1)
+}
+
+func TestRecorder_IngestValidates(t *testing.T) {
+	rec := billingtest.NewRecorder(t)
+
+	_, err := rec.Ingest(context.Background(), monigo.IngestRequest{Events: []monigo.IngestEvent{event("api_call", "cust-1", "")}})
+	if !errors.Is(err, monigo.ErrInvalidRequest) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	rec.AssertNotCharged(t, "api_call")
+}
+
+func TestRecorder_Reset(t *testing.T) {
+	rec := billingtest.NewRecorder(t)
+	rec.Ingest(context.Background(), monigo.IngestRequest{Events: []monigo.IngestEvent{event("api_call", "cust-1", "k1")}})
+
+	rec.Reset()
+	rec.AssertNothingCharged(t)
+}
+
+func TestRecorder_OtherCallsFail(t *testing.T) {
+	rec := billingtest.NewRecorder(t)
+
+	_, err := rec.Client().Customers.Get(context.Background(), "cust-1")
+	var apiErr *monigo.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 501 {
+		t.Errorf("expected 501 APIError, got %v", err)
+	}
+}
+
+// fakeT captures failures so the assertions themselves can be tested.
+type fakeT struct {
+	testing.TB
+	failed bool
+}
+
+func (f *fakeT) Helper()                           {}
+func (f *fakeT) Errorf(format string, args ...any) { f.failed = true }
+
+func TestRecorder_AssertChargedFails(t *testing.T) {
+	rec := billingtest.NewRecorder(t)
+	rec.Ingest(context.Background(), monigo.IngestRequest{Events: []monigo.IngestEvent{event("api_call", "cust-1", "k1")}})
+
+	ft := &fakeT{TB: t}
+	rec.AssertCharged(ft, "api_call", 2)
+	if !ft.failed {
+		t.Error("AssertCharged should fail when the count differs")
+	}
+}
@@ -0,0 +1,124 @@
+package monigo
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+// ResponseCache stores GET responses for conditional requests. See
+// WithResponseCache. Implementations must be safe for concurrent use.
+type ResponseCache interface {
+	Get(key string) (CachedResponse, bool)
+	Set(key string, resp CachedResponse)
+}
+
+// CachedResponse is a response body together with the validators the API
+// sent for it.
+type CachedResponse struct {
+	ETag         string
+	LastModified string
+	Body         []byte
+}
+
+// WithResponseCache makes the client revalidate GET requests against cache
+// using If-None-Match and If-Modified-Since. When the API answers 304 Not
+// Modified the cached body is decoded instead, saving the transfer and the
+// work of rendering it; responses without an ETag or Last-Modified header
+// are not cached. Because every request is still revalidated, results are
+// never stale.
+//
+// Keys include a hash of the API key, so one cache can be shared by clients
+// for different organisations. ResponseMeta.StatusCode is 304 for calls
+// served from the cache.
+func WithResponseCache(cache ResponseCache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// MemoryCache is a ResponseCache that keeps the most recently used entries
+// in memory.
+type MemoryCache struct {
+	max int
+
+	mu      sync.Mutex
+	order   *list.List // front is most recently used
+	entries map[string]*list.Element
+}
+
+type memoryCacheEntry struct {
+	key  string
+	resp CachedResponse
+}
+
+// NewMemoryCache returns a MemoryCache holding up to maxEntries responses.
+// A non-positive maxEntries defaults to 1,000.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	if maxEntries <= 0 {
+		maxEntries = 1000
+	}
+	return &MemoryCache{max: maxEntries, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+// Get returns the response stored under key.
+func (m *MemoryCache) Get(key string) (CachedResponse, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	el, ok := m.entries[key]
+	if !ok {
+		return CachedResponse{}, false
+	}
+	m.order.MoveToFront(el)
+	return el.Value.(*memoryCacheEntry).resp, true
+}
+
+// Set stores resp under key, evicting the least recently used entry when
+// the cache is full.
+func (m *MemoryCache) Set(key string, resp CachedResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if el, ok := m.entries[key]; ok {
+		el.Value.(*memoryCacheEntry).resp = resp
+		m.order.MoveToFront(el)
+		return
+	}
+	m.entries[key] = m.order.PushFront(&memoryCacheEntry{key: key, resp: resp})
+	if m.order.Len() > m.max {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// cacheKey scopes path to the client's API key and base URL and to the
+// extra headers sent with WithHeader, such as X-On-Behalf-Of, which can
+// change whose data the API returns.
+func (c *Client) cacheKey(path string, header http.Header) string {
+	h := sha256.New()
+	h.Write([]byte(c.apiKey))
+	keys := make([]string, 0, len(header))
+	for k := range header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range header[k] {
+			h.Write([]byte("\x00" + k + ":" + v))
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8]) + " " + c.baseURL + path
+}
+
+// conditionalHeaders sets the validators of cached on req.
+func conditionalHeaders(req *http.Request, cached CachedResponse) {
+	if cached.ETag != "" {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+	if cached.LastModified != "" {
+		req.Header.Set("If-Modified-Since", cached.LastModified)
+	}
+}
@@ -0,0 +1,94 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestWithResponseCache_RevalidatesWithETag(t *testing.T) {
+	var calls int
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		if calls > 1 {
+			t.Errorf("expected If-None-Match on call %d", calls)
+		}
+		w.Header().Set("ETag", `"v1"`)
+		respondJSON(t, w, 200, map[string]any{"plan": samplePlan})
+	}), monigo.WithResponseCache(monigo.NewMemoryCache(0)))
+
+	var meta monigo.ResponseMeta
+	ctx := monigo.ContextWithResponseMeta(context.Background(), &meta)
+	for i := 0; i < 2; i++ {
+		p, err := c.Plans.Get(ctx, samplePlan.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if p.ID != samplePlan.ID {
+			t.Errorf("call %d: expected plan %s, got %+v", i, samplePlan.ID, p)
+		}
+	}
+	if calls != 2 || meta.StatusCode != http.StatusNotModified {
+		t.Errorf("expected a 304 on the second call, got %d calls and status %d", calls, meta.StatusCode)
+	}
+}
+
+func TestWithResponseCache_SkipsUnvalidatedResponses(t *testing.T) {
+	cache := monigo.NewMemoryCache(0)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" || r.Header.Get("If-Modified-Since") != "" {
+			t.Error("expected no conditional headers")
+		}
+		respondJSON(t, w, 200, map[string]any{"customer": sampleCustomer})
+	}), monigo.WithResponseCache(cache))
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.Customers.Get(context.Background(), "cust-abc"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+func TestWithResponseCache_KeysOnExtraHeaders(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			t.Errorf("org %s revalidated another org's response", r.Header.Get("X-On-Behalf-Of"))
+		}
+		cust := sampleCustomer
+		cust.OrgID = r.Header.Get("X-On-Behalf-Of")
+		w.Header().Set("ETag", `"v1"`)
+		respondJSON(t, w, 200, map[string]any{"customer": cust})
+	}), monigo.WithResponseCache(monigo.NewMemoryCache(0)))
+
+	for _, org := range []string{"org-a", "org-b"} {
+		ctx := monigo.ContextWithOptions(context.Background(), monigo.WithHeader("X-On-Behalf-Of", org))
+		cust, err := c.Customers.Get(ctx, "cust-abc")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cust.OrgID != org {
+			t.Errorf("expected %s's customer, got %s's", org, cust.OrgID)
+		}
+	}
+}
+
+func TestMemoryCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	m := monigo.NewMemoryCache(2)
+	m.Set("a", monigo.CachedResponse{ETag: "a"})
+	m.Set("b", monigo.CachedResponse{ETag: "b"})
+	m.Get("a")
+	m.Set("c", monigo.CachedResponse{ETag: "c"})
+
+	if _, ok := m.Get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	if _, ok := m.Get("a"); !ok {
+		t.Error("expected a to be kept")
+	}
+}
@@ -0,0 +1,130 @@
+package monigo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// API key scopes reported by Client.Capabilities.
+const (
+	// ScopeRead allows read-only access to every resource.
+	ScopeRead = "read"
+	// ScopeWrite allows creating, updating, and deleting resources. A
+	// write-scoped key may also ingest events.
+	ScopeWrite = "write"
+	// ScopeIngest allows the POST endpoints under /v1/ingest — sending,
+	// validating and streaming events — and nothing else.
+	ScopeIngest = "ingest"
+)
+
+// Capabilities describes what the configured API key is allowed to do.
+type Capabilities struct {
+	// KeyID is the UUID of the API key making the request.
+	KeyID string `json:"key_id"`
+	// OrgID is the organisation the key belongs to.
+	OrgID string `json:"org_id"`
+	// Scopes lists the scopes granted to the key. See the ScopeXxx constants.
+	Scopes []string `json:"scopes"`
+	// IsTest is true for test-mode keys.
+	IsTest bool `json:"is_test"`
+	// MaxIngestBatchSize is the most events POST /v1/ingest accepts in one
+	// request. Zero means the server did not say.
+	MaxIngestBatchSize int `json:"max_ingest_batch_size,omitempty"`
+	// MaxIngestBodyBytes is the largest POST /v1/ingest body, in bytes,
+	// before compression. Zero means the server did not say.
+	MaxIngestBodyBytes int `json:"max_ingest_body_bytes,omitempty"`
+	// BillingTimezone is the IANA name of the timezone the organisation's
+	// billing periods start and end in, e.g. "Africa/Lagos". Empty means
+	// UTC. See Client.BillingLocation.
+	BillingTimezone string `json:"billing_timezone,omitempty"`
+}
+
+// Ingest limits assumed until Capabilities reports the server's own.
+const (
+	defaultMaxIngestBatchSize = 1000
+	defaultMaxIngestBodyBytes = 5 << 20
+)
+
+// HasScope reports whether the key was granted scope.
+func (c *Capabilities) HasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Capabilities fetches the scopes granted to the configured API key and
+// caches them on the client. Once cached, mutating calls the key is not
+// allowed to make fail fast with ErrInsufficientScope instead of surfacing
+// as a 403 from the API. Call it again to refresh the cached value.
+func (c *Client) Capabilities(ctx context.Context) (*Capabilities, error) {
+	var wrapper struct {
+		Capabilities Capabilities `json:"capabilities"`
+	}
+	if err := c.do(ctx, "GET", "/v1/capabilities", nil, &wrapper); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.caps = &wrapper.Capabilities
+	c.mu.Unlock()
+	return &wrapper.Capabilities, nil
+}
+
+// readOnlyPosts lists the POST endpoints that compute a result without
+// changing anything, so a read-scoped key may call them.
+var readOnlyPosts = map[string]bool{
+	"/v1/metrics/preview": true,
+}
+
+// checkScope returns ErrInsufficientScope if capabilities have been cached
+// and the key is not allowed to perform a mutating request on path. Reads
+// are never pre-flighted.
+func (c *Client) checkScope(method, path string) error {
+	if method != "POST" && method != "PUT" && method != "PATCH" && method != "DELETE" {
+		return nil
+	}
+	c.mu.RLock()
+	caps := c.caps
+	c.mu.RUnlock()
+	if caps == nil || caps.HasScope(ScopeWrite) {
+		return nil
+	}
+
+	required := requiredScope(path)
+	if caps.HasScope(required) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s %s requires the %q scope (key has %v)", ErrInsufficientScope, method, path, required, caps.Scopes)
+}
+
+// requiredScope returns the scope a mutating request on path needs from a
+// key without ScopeWrite.
+func requiredScope(path string) string {
+	if readOnlyPosts[path] {
+		return ScopeRead
+	}
+	if path == "/v1/ingest" || strings.HasPrefix(path, "/v1/ingest/") {
+		return ScopeIngest
+	}
+	return ScopeWrite
+}
+
+// ingestLimits returns the batch limits from the cached capabilities, or the
+// defaults when they have not been fetched.
+func (c *Client) ingestLimits() (maxEvents, maxBytes int) {
+	maxEvents, maxBytes = defaultMaxIngestBatchSize, defaultMaxIngestBodyBytes
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.caps != nil {
+		if c.caps.MaxIngestBatchSize > 0 {
+			maxEvents = c.caps.MaxIngestBatchSize
+		}
+		if c.caps.MaxIngestBodyBytes > 0 {
+			maxBytes = c.caps.MaxIngestBodyBytes
+		}
+	}
+	return maxEvents, maxBytes
+}
@@ -0,0 +1,147 @@
+package monigo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestClient_Capabilities(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/capabilities")
+		assertBearerToken(t, r)
+		respondJSON(t, w, 200, map[string]any{"capabilities": monigo.Capabilities{
+			KeyID:  "key-1",
+			Scopes: []string{monigo.ScopeRead},
+		}})
+	}))
+
+	caps, err := c.Capabilities(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !caps.HasScope(monigo.ScopeRead) {
+		t.Error("expected read scope")
+	}
+	if caps.HasScope(monigo.ScopeWrite) {
+		t.Error("did not expect write scope")
+	}
+}
+
+func TestClient_Capabilities_ReadOnlyKeyBlocksWrites(t *testing.T) {
+	calls := 0
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/capabilities" {
+			respondJSON(t, w, 200, map[string]any{"capabilities": monigo.Capabilities{
+				Scopes: []string{monigo.ScopeRead},
+			}})
+			return
+		}
+		calls++
+		respondJSON(t, w, 200, map[string]any{"customer": sampleCustomer})
+	}))
+
+	ctx := context.Background()
+	if _, err := c.Capabilities(ctx); err != nil {
+		t.Fatalf("capabilities: %v", err)
+	}
+
+	_, err := c.Customers.Create(ctx, monigo.CreateCustomerRequest{ExternalID: "x", Name: "X"})
+	if !errors.Is(err, monigo.ErrInsufficientScope) {
+		t.Errorf("expected ErrInsufficientScope, got %v", err)
+	}
+	if calls != 0 {
+		t.Errorf("expected blocked call not to reach the server, got %d calls", calls)
+	}
+
+	// Reads are never pre-flighted.
+	if _, err := c.Customers.Get(ctx, "cust-abc"); err != nil {
+		t.Errorf("read should be allowed: %v", err)
+	}
+
+	// Nor are POSTs that only compute a result.
+	_, err = c.Metrics.Preview(ctx, monigo.CreateMetricRequest{
+		EventName: "api_call", Aggregation: monigo.AggregationCount,
+	}, time.Hour)
+	if errors.Is(err, monigo.ErrInsufficientScope) {
+		t.Errorf("preview should be allowed: %v", err)
+	}
+}
+
+func TestClient_Capabilities_IngestOnlyKey(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/capabilities" {
+			respondJSON(t, w, 200, map[string]any{"capabilities": monigo.Capabilities{
+				Scopes: []string{monigo.ScopeIngest},
+			}})
+			return
+		}
+		respondJSON(t, w, 202, map[string]any{"ingested": []string{"k1"}, "duplicates": []string{}})
+	}))
+
+	ctx := context.Background()
+	if _, err := c.Capabilities(ctx); err != nil {
+		t.Fatalf("capabilities: %v", err)
+	}
+
+	_, err := c.Events.Ingest(ctx, monigo.IngestRequest{Events: []monigo.IngestEvent{
+		{EventName: "api_call", CustomerID: "cust-abc", IdempotencyKey: "k1", Timestamp: time.Now()},
+	}})
+	if err != nil {
+		t.Errorf("ingest should be allowed: %v", err)
+	}
+	_, err = c.Events.Validate(ctx, monigo.IngestRequest{Events: []monigo.IngestEvent{
+		{EventName: "api_call", CustomerID: "cust-abc", IdempotencyKey: "k1"},
+	}})
+	if err != nil {
+		t.Errorf("validate should be allowed: %v", err)
+	}
+
+	_, err = c.Plans.Create(ctx, monigo.CreatePlanRequest{Name: "Blocked"})
+	if !errors.Is(err, monigo.ErrInsufficientScope) {
+		t.Errorf("expected ErrInsufficientScope, got %v", err)
+	}
+}
+
+func TestClient_Capabilities_IngestOnlyKeyOpensStream(t *testing.T) {
+	var paths []string
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/capabilities" {
+			respondJSON(t, w, 200, map[string]any{"capabilities": monigo.Capabilities{
+				Scopes: []string{monigo.ScopeIngest},
+			}})
+			return
+		}
+		paths = append(paths, r.URL.Path)
+		if r.URL.Path == "/v1/ingest/streams" {
+			respondJSON(t, w, 201, map[string]any{"stream": map[string]any{"id": "str-1"}})
+			return
+		}
+		rc := http.NewResponseController(w)
+		if err := rc.EnableFullDuplex(); err != nil {
+			t.Fatalf("EnableFullDuplex: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.WriteHeader(http.StatusOK)
+		rc.Flush()
+	}))
+
+	ctx := context.Background()
+	if _, err := c.Capabilities(ctx); err != nil {
+		t.Fatalf("capabilities: %v", err)
+	}
+
+	st, err := c.Events.Stream(ctx, monigo.StreamOptions{})
+	if err != nil {
+		t.Fatalf("stream should be allowed: %v", err)
+	}
+	st.Close()
+	if len(paths) != 2 || paths[1] != "/v1/ingest/streams/str-1" {
+		t.Errorf("expected the stream to be opened and attached, got %v", paths)
+	}
+}
@@ -0,0 +1,415 @@
+// Package catalog manages an organisation's billing catalog — its metrics,
+// plans, and prices — from a definition file kept in version control, so
+// catalog changes can be reviewed and applied like any other code change.
+//
+// A definition lists the desired metrics and plans. Prices refer to
+// metrics by name, so the file needs no server-assigned IDs:
+//
+//	{
+//	  "metrics": [
+//	    {"name": "API Calls", "event_name": "api_call", "aggregation": "count"}
+//	  ],
+//	  "plans": [
+//	    {
+//	      "name": "Pro",
+//	      "currency": "NGN",
+//	      "billing_period": "monthly",
+//	      "prices": [{"metric": "API Calls", "model": "per_unit", "unit_price": "2.000000"}]
+//	    }
+//	  ]
+//	}
+//
+// Sync compares the definition with the live organisation, creating what is
+// missing and updating what has drifted. With DryRun set it only reports
+// the changes it would make:
+//
+//	cat, err := catalog.LoadFile("billing/catalog.json")
+//	report, err := catalog.Sync(ctx, client, cat, catalog.Options{DryRun: true})
+//	for _, c := range report.Changes {
+//		fmt.Println(c)
+//	}
+//
+// Metrics and plans are matched by name. Fields left empty in the
+// definition are not managed, so server defaults and values set elsewhere
+// are left alone. Nothing is ever deleted: live metrics, plans, and prices
+// missing from the definition are reported as ActionUnmanaged.
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+// Catalog is a desired billing catalog.
+type Catalog struct {
+	Metrics []monigo.CreateMetricRequest `json:"metrics,omitempty"`
+	Plans   []Plan                       `json:"plans,omitempty"`
+}
+
+// Plan is a desired plan. Its fields are those of monigo.CreatePlanRequest
+// except Prices, which name their metric instead of giving its ID.
+type Plan struct {
+	monigo.CreatePlanRequest
+	Prices []Price `json:"prices,omitempty"`
+}
+
+// Price is a desired price on a Plan.
+type Price struct {
+	// Metric is the name of the priced metric, defined in the catalog or
+	// already present in the organisation.
+	Metric string `json:"metric"`
+	monigo.CreatePriceRequest
+}
+
+// Load decodes a catalog definition from r. Unknown fields are rejected so
+// that typos do not silently leave settings unmanaged.
+func Load(r io.Reader) (*Catalog, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	var cat Catalog
+	if err := dec.Decode(&cat); err != nil {
+		return nil, fmt.Errorf("catalog: decode: %w", err)
+	}
+	return &cat, nil
+}
+
+// LoadFile decodes the catalog definition in the named file.
+func LoadFile(name string) (*Catalog, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Load(f)
+}
+
+// Kinds of object reported in a Change.
+const (
+	KindMetric = "metric"
+	KindPlan   = "plan"
+	KindPrice  = "price"
+)
+
+// Actions reported in a Change.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	// ActionUnmanaged marks a live object the catalog does not define.
+	// Sync never acts on it.
+	ActionUnmanaged = "unmanaged"
+)
+
+// Change is one difference between the catalog and the organisation.
+type Change struct {
+	Kind   string
+	Name   string
+	Action string
+	// ID is the live object's ID; empty for objects still to be created.
+	ID string
+	// Fields lists the fields that differ, for ActionUpdate.
+	Fields []string
+}
+
+// String formats the change for a plan or log line, e.g.
+// `update plan "Pro" (currency, prices)`.
+func (c Change) String() string {
+	s := fmt.Sprintf("%s %s %q", c.Action, c.Kind, c.Name)
+	if len(c.Fields) > 0 {
+		s += " (" + strings.Join(c.Fields, ", ") + ")"
+	}
+	return s
+}
+
+// Report lists the changes Sync made, or would make in a dry run.
+type Report struct {
+	Changes []Change
+	// Applied is false for a dry run.
+	Applied bool
+}
+
+// InSync reports whether the organisation already matches the catalog,
+// ignoring unmanaged objects.
+func (r *Report) InSync() bool {
+	for _, c := range r.Changes {
+		if c.Action != ActionUnmanaged {
+			return false
+		}
+	}
+	return true
+}
+
+// Options configures Sync.
+type Options struct {
+	// DryRun reports the changes without making them.
+	DryRun bool
+}
+
+// Sync reconciles the organisation's metrics and plans with cat. Metrics
+// are synced first so that prices can refer to newly created metrics. On
+// error the returned report holds the changes made so far.
+func Sync(ctx context.Context, client *monigo.Client, cat *Catalog, opts Options) (*Report, error) {
+	report := &Report{Applied: !opts.DryRun}
+
+	metrics, err := client.Metrics.List(ctx)
+	if err != nil {
+		return report, err
+	}
+	metricIDs := make(map[string]string)
+	live := make(map[string]monigo.Metric)
+	for _, m := range metrics.Metrics {
+		live[m.Name] = m
+		metricIDs[m.Name] = m.ID
+	}
+
+	defined := make(map[string]bool)
+	for _, want := range cat.Metrics {
+		defined[want.Name] = true
+		got, ok := live[want.Name]
+		if !ok {
+			report.Changes = append(report.Changes, Change{Kind: KindMetric, Name: want.Name, Action: ActionCreate})
+			if opts.DryRun {
+				continue
+			}
+			m, err := client.Metrics.Create(ctx, want)
+			if err != nil {
+				return report, fmt.Errorf("catalog: create metric %q: %w", want.Name, err)
+			}
+			metricIDs[m.Name] = m.ID
+			continue
+		}
+		fields := metricDiff(want, got)
+		if len(fields) == 0 {
+			continue
+		}
+		report.Changes = append(report.Changes, Change{Kind: KindMetric, Name: want.Name, Action: ActionUpdate, ID: got.ID, Fields: fields})
+		if opts.DryRun {
+			continue
+		}
+		if _, err := client.Metrics.Update(ctx, got.ID, monigo.UpdateMetricRequest{
+			Name:                want.Name,
+			EventName:           want.EventName,
+			Aggregation:         want.Aggregation,
+			Description:         want.Description,
+			AggregationProperty: want.AggregationProperty,
+			PropertyRules:       want.PropertyRules,
+			Filters:             want.Filters,
+			GroupBy:             want.GroupBy,
+		}); err != nil {
+			return report, fmt.Errorf("catalog: update metric %q: %w", want.Name, err)
+		}
+	}
+	for _, m := range metrics.Metrics {
+		if !defined[m.Name] {
+			report.Changes = append(report.Changes, Change{Kind: KindMetric, Name: m.Name, Action: ActionUnmanaged, ID: m.ID})
+		}
+	}
+
+	plans, err := client.Plans.List(ctx)
+	if err != nil {
+		return report, err
+	}
+	livePlans := make(map[string]monigo.Plan)
+	for _, p := range plans.Plans {
+		livePlans[p.Name] = p
+	}
+
+	defined = make(map[string]bool)
+	for _, want := range cat.Plans {
+		defined[want.Name] = true
+		prices, err := resolvePrices(want, metricIDs, opts.DryRun)
+		if err != nil {
+			return report, err
+		}
+		got, ok := livePlans[want.Name]
+		if !ok {
+			report.Changes = append(report.Changes, Change{Kind: KindPlan, Name: want.Name, Action: ActionCreate})
+			if opts.DryRun {
+				continue
+			}
+			req := want.CreatePlanRequest
+			req.Prices = prices
+			if _, err := client.Plans.Create(ctx, req); err != nil {
+				return report, fmt.Errorf("catalog: create plan %q: %w", want.Name, err)
+			}
+			continue
+		}
+
+		// List responses may omit prices; fetch the plan to compare them.
+		full, err := client.Plans.Get(ctx, got.ID)
+		if err != nil {
+			return report, err
+		}
+		fields, update, unmanaged := planDiff(want.CreatePlanRequest, prices, *full)
+		for _, p := range unmanaged {
+			report.Changes = append(report.Changes, Change{Kind: KindPrice, Name: want.Name + "/" + metricName(metricIDs, p.MetricID), Action: ActionUnmanaged, ID: p.ID})
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		report.Changes = append(report.Changes, Change{Kind: KindPlan, Name: want.Name, Action: ActionUpdate, ID: got.ID, Fields: fields})
+		if opts.DryRun {
+			continue
+		}
+		if _, err := client.Plans.Update(ctx, got.ID, update); err != nil {
+			return report, fmt.Errorf("catalog: update plan %q: %w", want.Name, err)
+		}
+	}
+	for _, p := range plans.Plans {
+		if !defined[p.Name] {
+			report.Changes = append(report.Changes, Change{Kind: KindPlan, Name: p.Name, Action: ActionUnmanaged, ID: p.ID})
+		}
+	}
+	return report, nil
+}
+
+// resolvePrices turns the plan's prices into API requests, looking metric
+// names up in ids. In a dry run a metric that would be created has no ID
+// yet; its prices keep an empty MetricID.
+func resolvePrices(p Plan, ids map[string]string, dryRun bool) ([]monigo.CreatePriceRequest, error) {
+	out := make([]monigo.CreatePriceRequest, len(p.Prices))
+	for i, price := range p.Prices {
+		req := price.CreatePriceRequest
+		id, ok := ids[price.Metric]
+		if !ok && !dryRun {
+			return nil, fmt.Errorf("catalog: plan %q prices unknown metric %q", p.Name, price.Metric)
+		}
+		req.MetricID = id
+		out[i] = req
+	}
+	return out, nil
+}
+
+// metricDiff returns the names of the fields set in want that differ in got.
+func metricDiff(want monigo.CreateMetricRequest, got monigo.Metric) []string {
+	var fields []string
+	diff := func(name string, set, same bool) {
+		if set && !same {
+			fields = append(fields, name)
+		}
+	}
+	diff("event_name", want.EventName != "", want.EventName == got.EventName)
+	diff("aggregation", want.Aggregation != "", want.Aggregation == got.Aggregation)
+	diff("aggregation_property", want.AggregationProperty != "", want.AggregationProperty == got.AggregationProperty)
+	diff("description", want.Description != "", want.Description == got.Description)
+	diff("property_rules", len(want.PropertyRules) > 0, sameJSON(want.PropertyRules, got.PropertyRules))
+	diff("filters", len(want.Filters) > 0, sameJSON(want.Filters, got.Filters))
+	diff("group_by", len(want.GroupBy) > 0, sameJSON(want.GroupBy, got.GroupBy))
+	return fields
+}
+
+// planDiff compares the fields set in want, and the wanted prices, with got.
+// It returns the differing field names, the update that applies them, and
+// the live prices the catalog does not define.
+func planDiff(want monigo.CreatePlanRequest, prices []monigo.CreatePriceRequest, got monigo.Plan) (fields []string, update monigo.UpdatePlanRequest, unmanaged []monigo.Price) {
+	diff := func(name string, set, same bool) bool {
+		if set && !same {
+			fields = append(fields, name)
+			return true
+		}
+		return false
+	}
+	if diff("description", want.Description != "", want.Description == got.Description) {
+		update.Description = want.Description
+	}
+	if diff("currency", want.Currency != "", want.Currency == got.Currency) {
+		update.Currency = want.Currency
+	}
+	if diff("plan_type", want.PlanType != "", want.PlanType == got.PlanType) {
+		update.PlanType = want.PlanType
+	}
+	if diff("billing_period", want.BillingPeriod != "", want.BillingPeriod == got.BillingPeriod) {
+		update.BillingPeriod = want.BillingPeriod
+	}
+	if diff("minimum_amount", want.MinimumAmount != "", want.MinimumAmount == got.MinimumAmount) {
+		minimum := want.MinimumAmount
+		update.MinimumAmount = &minimum
+	}
+	if diff("tax_behavior", want.TaxBehavior != "", want.TaxBehavior == got.TaxBehavior) {
+		update.TaxBehavior = want.TaxBehavior
+	}
+	if diff("commission", want.Commission != nil, sameJSON(want.Commission, got.Commission)) {
+		update.Commission = want.Commission
+	}
+	if diff("metadata", want.Metadata != nil, sameJSON(want.Metadata, got.Metadata)) {
+		metadata := want.Metadata
+		update.Metadata = &metadata
+	}
+
+	// Prices are matched by metric and currency.
+	key := func(metricID, currency string) string { return metricID + "/" + currency }
+	livePrices := make(map[string]monigo.Price)
+	for _, p := range got.Prices {
+		livePrices[key(p.MetricID, p.Currency)] = p
+	}
+	wanted := make(map[string]bool)
+	for _, p := range prices {
+		k := key(p.MetricID, p.Currency)
+		wanted[k] = true
+		live, ok := livePrices[k]
+		if ok && p.Model == live.Model && p.UnitPrice == live.UnitPrice && sameTiers(p.Tiers, live.Tiers) {
+			continue
+		}
+		update.Prices = append(update.Prices, monigo.UpdatePriceRequest{
+			ID:        live.ID,
+			MetricID:  p.MetricID,
+			Model:     p.Model,
+			UnitPrice: p.UnitPrice,
+			Tiers:     p.Tiers,
+			Currency:  p.Currency,
+		})
+	}
+	if len(update.Prices) > 0 {
+		fields = append(fields, "prices")
+	}
+	for _, p := range got.Prices {
+		if !wanted[key(p.MetricID, p.Currency)] {
+			unmanaged = append(unmanaged, p)
+		}
+	}
+	return fields, update, unmanaged
+}
+
+// sameTiers reports whether two tier configurations are equivalent once
+// normalised with monigo.NormalizeTiers.
+func sameTiers(a, b json.RawMessage) bool {
+	na, errA := monigo.NormalizeTiers(a)
+	nb, errB := monigo.NormalizeTiers(b)
+	return errA == nil && errB == nil && bytes.Equal(na, nb)
+}
+
+// sameJSON reports whether a and b encode to the same JSON once object keys
+// are sorted and numbers normalised.
+func sameJSON(a, b any) bool {
+	return normalJSON(a) == normalJSON(b)
+}
+
+func normalJSON(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	var generic any
+	if err := json.Unmarshal(b, &generic); err != nil {
+		return string(b)
+	}
+	b, _ = json.Marshal(generic)
+	return string(b)
+}
+
+// metricName returns the name of the metric with the given ID, or the ID
+// itself when it is not known.
+func metricName(ids map[string]string, id string) string {
+	for name, mid := range ids {
+		if mid == id {
+			return name
+		}
+	}
+	return id
+}
@@ -0,0 +1,109 @@
+package catalog_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+	"github.com/monigo-africa/go-monigo/catalog"
+	"github.com/monigo-africa/go-monigo/monigotest"
+)
+
+const definition = `{
+  "metrics": [
+    {"name": "API Calls", "event_name": "api_call", "aggregation": "count"}
+  ],
+  "plans": [
+    {
+      "name": "Pro",
+      "currency": "NGN",
+      "billing_period": "monthly",
+      "prices": [{"metric": "API Calls", "model": "per_unit", "unit_price": "2.000000"}]
+    }
+  ]
+}`
+
+func load(t *testing.T, def string) *catalog.Catalog {
+	t.Helper()
+	cat, err := catalog.Load(strings.NewReader(def))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	return cat
+}
+
+func TestSync_CreatesThenReportsInSync(t *testing.T) {
+	srv := monigotest.NewServer(t)
+	client := srv.Client()
+	ctx := context.Background()
+	cat := load(t, definition)
+
+	dry, err := catalog.Sync(ctx, client, cat, catalog.Options{DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if dry.Applied || len(dry.Changes) != 2 {
+		t.Fatalf("expected 2 planned creates, got %v", dry.Changes)
+	}
+	if metrics, _ := client.Metrics.List(ctx); len(metrics.Metrics) != 0 {
+		t.Fatal("dry run must not create anything")
+	}
+
+	report, err := catalog.Sync(ctx, client, cat, catalog.Options{})
+	if err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+	if got := report.Changes[1].String(); got != `create plan "Pro"` {
+		t.Errorf("unexpected change %q", got)
+	}
+	plans, err := client.Plans.List(ctx)
+	if err != nil || len(plans.Plans) != 1 {
+		t.Fatalf("expected the plan to be created, got %v %v", plans, err)
+	}
+	metrics, _ := client.Metrics.List(ctx)
+	if p := plans.Plans[0].Prices; len(p) != 1 || p[0].MetricID != metrics.Metrics[0].ID {
+		t.Errorf("expected the price to reference the new metric, got %+v", p)
+	}
+
+	again, err := catalog.Sync(ctx, client, cat, catalog.Options{DryRun: true})
+	if err != nil {
+		t.Fatalf("second dry run: %v", err)
+	}
+	if !again.InSync() {
+		t.Errorf("expected no changes, got %v", again.Changes)
+	}
+}
+
+func TestSync_ReportsDriftAndUnmanaged(t *testing.T) {
+	srv := monigotest.NewServer(t)
+	client := srv.Client()
+	ctx := context.Background()
+	if _, err := catalog.Sync(ctx, client, load(t, definition), catalog.Options{}); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+	if _, err := client.Plans.Create(ctx, monigo.CreatePlanRequest{Name: "Legacy"}); err != nil {
+		t.Fatalf("create plan: %v", err)
+	}
+
+	drifted := strings.Replace(definition, `"2.000000"`, `"3.000000"`, 1)
+	report, err := catalog.Sync(ctx, client, load(t, drifted), catalog.Options{DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	var got []string
+	for _, c := range report.Changes {
+		got = append(got, c.String())
+	}
+	want := []string{`update plan "Pro" (prices)`, `unmanaged plan "Legacy"`}
+	if strings.Join(got, "; ") != strings.Join(want, "; ") {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestLoad_RejectsUnknownFields(t *testing.T) {
+	_, err := catalog.Load(strings.NewReader(`{"plans": [{"name": "Pro", "billing_perod": "monthly"}]}`))
+	if err == nil {
+		t.Fatal("expected an error for the misspelt field")
+	}
+}
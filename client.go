@@ -2,20 +2,31 @@ package monigo
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 )
 
 const defaultBaseURL = "https://api.monigo.co"
 
+// defaultCompressionThreshold is the WithCompression threshold used when
+// none is given.
+const defaultCompressionThreshold = 1024
+
 // requestConfig holds per-request options resolved from RequestOption values.
 type requestConfig struct {
 	idempotencyKey string
+	timeout        time.Duration
+	capture        *ResponseMeta
+	header         http.Header
 }
 
 // RequestOption configures a single API request.
@@ -30,6 +41,55 @@ func WithIdempotencyKey(key string) RequestOption {
 	}
 }
 
+// WithTimeout bounds this request, including any wait for the client's rate
+// limiter, to d. It overrides the client-wide WithRequestTimeout. Use it to
+// give a slow call more time, or a latency-sensitive one less, than the
+// client default.
+func WithTimeout(d time.Duration) RequestOption {
+	return func(c *requestConfig) {
+		c.timeout = d
+	}
+}
+
+// WithHeader sets an extra HTTP header on the request, such as an
+// on-behalf-of header for platform integrations. Headers the SDK manages
+// itself, like Authorization, cannot be overridden.
+func WithHeader(key, value string) RequestOption {
+	return func(c *requestConfig) {
+		if c.header == nil {
+			c.header = make(http.Header)
+		}
+		c.header.Set(key, value)
+	}
+}
+
+// WithDefaultRequestOptions applies opts to every request the client makes,
+// before any options from the context or the call itself.
+func WithDefaultRequestOptions(opts ...RequestOption) Option {
+	return func(c *Client) {
+		c.defaultOpts = append(c.defaultOpts, opts...)
+	}
+}
+
+type requestOptionsKey struct{}
+
+// ContextWithOptions returns a context that applies opts to every API call
+// made with it, after the client's defaults and before the call's own
+// options. It reaches methods that take no RequestOption arguments, and
+// stacks with options already on ctx:
+//
+//	ctx = monigo.ContextWithOptions(ctx, monigo.WithHeader("X-On-Behalf-Of", orgID))
+func ContextWithOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	prev := requestOptionsFromContext(ctx)
+	all := append(prev[:len(prev):len(prev)], opts...)
+	return context.WithValue(ctx, requestOptionsKey{}, all)
+}
+
+func requestOptionsFromContext(ctx context.Context) []RequestOption {
+	opts, _ := ctx.Value(requestOptionsKey{}).([]RequestOption)
+	return opts
+}
+
 // newUUID returns a randomly-generated UUID v4 using crypto/rand.
 func newUUID() string {
 	var b [16]byte
@@ -48,6 +108,36 @@ type Client struct {
 	baseURL    string
 	httpClient *http.Client
 
+	logger     *slog.Logger
+	logBodies  bool
+	redactKeys map[string]bool
+
+	// defaultOpts are applied to every request before per-call options.
+	defaultOpts []RequestOption
+
+	limiter    RateLimiter
+	cache      ResponseCache
+	allowances *allowanceCache // set by WithAllowanceCache
+	stats      *Stats          // set by WithStats
+	// rateLimitRetries is how many times a 429 is retried after the limiter
+	// has backed off. Set by WithRateLimit.
+	rateLimitRetries int
+
+	// compressMin is the smallest request body, in bytes, that is gzipped.
+	// Zero disables compression.
+	compressMin int
+
+	// requestTimeout bounds each request unless overridden by WithTimeout.
+	// Zero means no limit beyond the caller's context.
+	requestTimeout time.Duration
+
+	// testMode marks ingested events as test data. Detected from the key
+	// prefix unless set with WithTestMode.
+	testMode bool
+
+	mu   sync.RWMutex
+	caps *Capabilities // cached by Capabilities; nil until fetched
+
 	// Events handles usage event ingestion and event replay.
 	Events *EventService
 	// Customers manages your end-customers.
@@ -60,6 +150,10 @@ type Client struct {
 	Subscriptions *SubscriptionService
 	// PayoutAccounts manages bank/mobile-money accounts for customer payouts.
 	PayoutAccounts *PayoutAccountService
+	// PaymentMethods manages the cards and bank-debit mandates invoices are charged to.
+	PaymentMethods *PaymentMethodService
+	// Dunning configures reminders and retries for overdue invoices.
+	Dunning *DunningService
 	// Invoices manages invoice generation, finalization, and voiding.
 	Invoices *InvoiceService
 	// Usage queries usage rollups per customer/metric.
@@ -68,6 +162,30 @@ type Client struct {
 	PortalTokens *PortalTokenService
 	// Wallets manages customer wallets, balance operations, and virtual accounts.
 	Wallets *WalletService
+	// CreditWallets manages prepaid customer credit applied against invoices.
+	CreditWallets *CreditWalletService
+	// Coupons manages discount coupons applied to subscriptions.
+	Coupons *CouponService
+	// Alerts manages usage threshold alerts.
+	Alerts *AlertService
+	// Quotas manages hard usage limits on subscriptions.
+	Quotas *QuotaService
+	// Reports produces aggregate reports such as trial conversions.
+	Reports *ReportService
+	// Disputes tracks customer disputes raised against invoices.
+	Disputes *DisputeService
+	// Tax configures VAT and withholding tax rates.
+	Tax *TaxService
+	// Currencies lists the currencies available for billing.
+	Currencies *CurrencyService
+	// Banks lists the banks and mobile-money providers available for payouts.
+	Banks *BankService
+	// Payouts manages payout slips and payout runs for payout-type plans.
+	Payouts *PayoutService
+	// TestClocks simulates the passage of time for test-mode customers.
+	TestClocks *TestClockService
+	// AuditLogs lists the organisation's activity trail.
+	AuditLogs *AuditLogService
 }
 
 // Option is a functional option for configuring a Client.
@@ -89,6 +207,70 @@ func WithHTTPClient(hc *http.Client) Option {
 	}
 }
 
+// WithLogger enables structured logging of every API request to l. Each
+// request is logged at debug level with its method, path, status, latency,
+// and the server's request ID; failed requests are logged at warn level.
+// Use the handler's level to control verbosity.
+func WithLogger(l *slog.Logger) Option {
+	return func(c *Client) {
+		c.logger = l
+	}
+}
+
+// WithLogBodies adds request and response bodies to the debug log written
+// by WithLogger. The values of any JSON object keys listed in redactKeys are
+// replaced with "[REDACTED]" at every nesting level, e.g.
+//
+//	monigo.WithLogBodies("email", "phone", "account_number")
+func WithLogBodies(redactKeys ...string) Option {
+	return func(c *Client) {
+		c.logBodies = true
+		c.redactKeys = make(map[string]bool, len(redactKeys))
+		for _, k := range redactKeys {
+			c.redactKeys[k] = true
+		}
+	}
+}
+
+// WithCompression gzips request bodies of at least minBytes bytes and sends
+// them with Content-Encoding: gzip. Ingest batches of hundreds of events
+// shrink several-fold, which matters on metered or high-latency links.
+// A minBytes of zero or less uses 1 KiB; smaller bodies are sent as-is
+// because compressing them costs more than it saves.
+func WithCompression(minBytes int) Option {
+	return func(c *Client) {
+		if minBytes <= 0 {
+			minBytes = defaultCompressionThreshold
+		}
+		c.compressMin = minBytes
+	}
+}
+
+// WithRequestTimeout bounds every request made by the client to d, unless
+// the call passes its own WithTimeout. Unlike http.Client.Timeout it applies
+// per call, so individual calls can be given more or less time. The caller's
+// context deadline still applies when it is earlier.
+func WithRequestTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.requestTimeout = d
+	}
+}
+
+// WithTestMode overrides the mode detected from the API key prefix
+// ("sk_test_" is test mode, anything else live). In test mode every event
+// sent with Events.Ingest is flagged IsTest, so it is kept out of live usage
+// and invoices.
+func WithTestMode(enabled bool) Option {
+	return func(c *Client) {
+		c.testMode = enabled
+	}
+}
+
+// TestMode reports whether the client is in test mode. See WithTestMode.
+func (c *Client) TestMode() bool {
+	return c.testMode
+}
+
 // New creates a new Monigo API client authenticated with apiKey.
 // Pass functional options to override defaults.
 //
@@ -98,6 +280,7 @@ func New(apiKey string, opts ...Option) *Client {
 		apiKey:     apiKey,
 		baseURL:    defaultBaseURL,
 		httpClient: &http.Client{},
+		testMode:   strings.HasPrefix(apiKey, "sk_test_"),
 	}
 	for _, o := range opts {
 		o(c)
@@ -108,13 +291,43 @@ func New(apiKey string, opts ...Option) *Client {
 	c.Plans = &PlanService{client: c}
 	c.Subscriptions = &SubscriptionService{client: c}
 	c.PayoutAccounts = &PayoutAccountService{client: c}
+	c.PaymentMethods = &PaymentMethodService{client: c}
+	c.Dunning = &DunningService{client: c}
 	c.Invoices = &InvoiceService{client: c}
 	c.Usage = &UsageService{client: c}
 	c.PortalTokens = &PortalTokenService{client: c}
 	c.Wallets = &WalletService{client: c}
+	c.CreditWallets = &CreditWalletService{client: c}
+	c.Coupons = &CouponService{client: c}
+	c.Alerts = &AlertService{client: c}
+	c.Quotas = &QuotaService{client: c}
+	c.Reports = &ReportService{client: c}
+	c.Disputes = &DisputeService{client: c}
+	c.Tax = &TaxService{client: c}
+	c.Currencies = &CurrencyService{client: c}
+	c.Banks = &BankService{client: c}
+	c.Payouts = &PayoutService{client: c}
+	c.TestClocks = &TestClockService{client: c}
+	c.AuditLogs = &AuditLogService{client: c}
 	return c
 }
 
+// requestConfig resolves the client's default options, then those on ctx,
+// then opts.
+func (c *Client) requestConfig(ctx context.Context, opts []RequestOption) *requestConfig {
+	cfg := &requestConfig{timeout: c.requestTimeout}
+	for _, o := range c.defaultOpts {
+		o(cfg)
+	}
+	for _, o := range requestOptionsFromContext(ctx) {
+		o(cfg)
+	}
+	for _, o := range opts {
+		o(cfg)
+	}
+	return cfg
+}
+
 // do executes an HTTP request against the Monigo API.
 //
 // method is the HTTP method (GET, POST, PUT, PATCH, DELETE).
@@ -123,60 +336,182 @@ func New(apiKey string, opts ...Option) *Client {
 // out is decoded from the JSON response body (pass nil to discard response body).
 // opts are optional per-request options such as WithIdempotencyKey.
 func (c *Client) do(ctx context.Context, method, path string, body, out any, opts ...RequestOption) error {
-	cfg := &requestConfig{}
-	for _, o := range opts {
-		o(cfg)
+	cfg := c.requestConfig(ctx, opts)
+	if cfg.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.timeout)
+		defer cancel()
+	}
+
+	if err := c.checkScope(method, path); err != nil {
+		return err
 	}
 
-	var bodyReader io.Reader
+	var (
+		reqBody []byte
+		payload []byte
+		gzipped bool
+	)
 	if body != nil {
 		b, err := json.Marshal(body)
 		if err != nil {
 			return fmt.Errorf("monigo: marshal request body: %w", err)
 		}
-		bodyReader = bytes.NewReader(b)
+		reqBody, payload = b, b
+		if c.compressMin > 0 && len(b) >= c.compressMin {
+			gz, err := gzipBytes(b)
+			if err != nil {
+				return fmt.Errorf("monigo: compress request body: %w", err)
+			}
+			payload = gz
+			gzipped = true
+		}
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bodyReader)
-	if err != nil {
-		return fmt.Errorf("monigo: build request: %w", err)
+	// The key is fixed before the first attempt so a retried 429 is
+	// recognised as the same request.
+	var idempotencyKey string
+	if method == "POST" || method == "PUT" || method == "PATCH" {
+		idempotencyKey = cfg.idempotencyKey
+		if idempotencyKey == "" {
+			idempotencyKey = newUUID()
+		}
 	}
-	req.Header.Set("Authorization", "Bearer "+c.apiKey)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
 
-	if method == "POST" || method == "PUT" || method == "PATCH" {
-		key := cfg.idempotencyKey
-		if key == "" {
-			key = newUUID()
+	for attempt := 0; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx); err != nil {
+				return err
+			}
+		}
+
+		var bodyReader io.Reader
+		if payload != nil {
+			bodyReader = bytes.NewReader(payload)
+		}
+		req, err := c.newRequest(ctx, cfg, method, path, bodyReader, "application/json")
+		if err != nil {
+			return err
+		}
+		if gzipped {
+			req.Header.Set("Content-Encoding", "gzip")
+		}
+		if idempotencyKey != "" {
+			req.Header.Set("Idempotency-Key", idempotencyKey)
 		}
-		req.Header.Set("Idempotency-Key", key)
+		var (
+			cacheKey string
+			cached   CachedResponse
+			isCached bool
+		)
+		if c.cache != nil && method == "GET" {
+			cacheKey = c.cacheKey(path, cfg.header)
+			if cached, isCached = c.cache.Get(cacheKey); isCached {
+				conditionalHeaders(req, cached)
+			}
+		}
+
+		start := time.Now()
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			c.logRequest(ctx, req, nil, time.Since(start), reqBody, nil, err)
+			return fmt.Errorf("monigo: execute request: %w", err)
+		}
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		c.logRequest(ctx, req, resp, time.Since(start), reqBody, respBody, err)
+		if err != nil {
+			return fmt.Errorf("monigo: read response body: %w", err)
+		}
+
+		meta := recordResponseMeta(ctx, cfg, resp)
+
+		if resp.StatusCode >= 400 {
+			apiErr := newAPIError(resp, meta, respBody)
+			if b, ok := c.limiter.(rateLimitBackoff); ok && resp.StatusCode == http.StatusTooManyRequests {
+				b.Backoff(ctx, apiErr.RetryAfter)
+				if attempt < c.rateLimitRetries {
+					if c.stats != nil {
+						c.stats.observeRetry()
+					}
+					continue
+				}
+			}
+			return apiErr
+		}
+
+		if isCached && resp.StatusCode == http.StatusNotModified {
+			respBody = cached.Body
+		} else if cacheKey != "" && resp.StatusCode == http.StatusOK {
+			etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+			if etag != "" || lastModified != "" {
+				c.cache.Set(cacheKey, CachedResponse{ETag: etag, LastModified: lastModified, Body: respBody})
+			}
+		}
+
+		if out != nil && len(respBody) > 0 {
+			if err := json.Unmarshal(respBody, out); err != nil {
+				return fmt.Errorf("monigo: decode response: %w", err)
+			}
+		}
+		return nil
 	}
+}
 
-	resp, err := c.httpClient.Do(req)
+// newRequest builds a request for path carrying the client's credentials and
+// the headers resolved in cfg. contentType is also sent as Accept.
+func (c *Client) newRequest(ctx context.Context, cfg *requestConfig, method, path string, body io.Reader, contentType string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
 	if err != nil {
-		return fmt.Errorf("monigo: execute request: %w", err)
+		return nil, fmt.Errorf("monigo: build request: %w", err)
+	}
+	for k, v := range cfg.header {
+		req.Header[k] = v
 	}
-	defer resp.Body.Close()
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", contentType)
+	return req, nil
+}
 
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("monigo: read response body: %w", err)
+// recordResponseMeta parses the metadata of resp and stores it wherever the
+// caller asked for it with ContextWithResponseMeta or WithResponseCapture.
+func recordResponseMeta(ctx context.Context, cfg *requestConfig, resp *http.Response) ResponseMeta {
+	meta := newResponseMeta(resp)
+	if s := responseMetaFromContext(ctx); s != nil {
+		s.store(meta)
+	}
+	if cfg.capture != nil {
+		*cfg.capture = meta
 	}
+	return meta
+}
 
-	if resp.StatusCode >= 400 {
-		apiErr := &APIError{StatusCode: resp.StatusCode}
-		// Try to decode structured error; fall back to raw body.
-		if jsonErr := json.Unmarshal(respBody, apiErr); jsonErr != nil {
-			apiErr.Message = string(respBody)
-		}
-		return apiErr
+// newAPIError builds the error for a response with status >= 400, decoding
+// the structured error body when there is one and keeping the raw body
+// otherwise.
+func newAPIError(resp *http.Response, meta ResponseMeta, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  meta.RequestID,
+		RetryAfter: parseRetryAfter(resp.Header),
+		RateLimit:  meta.RateLimit,
+	}
+	if jsonErr := json.Unmarshal(body, apiErr); jsonErr != nil {
+		apiErr.Message = string(body)
 	}
+	return apiErr
+}
 
-	if out != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, out); err != nil {
-			return fmt.Errorf("monigo: decode response: %w", err)
-		}
+// gzipBytes returns b compressed with gzip.
+func gzipBytes(b []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(b); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
 	}
-	return nil
+	return buf.Bytes(), nil
 }
@@ -1,10 +1,15 @@
 package monigo_test
 
 import (
+	"compress/gzip"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	monigo "github.com/monigo-africa/go-monigo"
 )
@@ -39,6 +44,39 @@ func TestNew_Defaults(t *testing.T) {
 	if c.Usage == nil {
 		t.Error("Usage service is nil")
 	}
+	if c.CreditWallets == nil {
+		t.Error("CreditWallets service is nil")
+	}
+	if c.Coupons == nil {
+		t.Error("Coupons service is nil")
+	}
+	if c.Alerts == nil {
+		t.Error("Alerts service is nil")
+	}
+	if c.Reports == nil {
+		t.Error("Reports service is nil")
+	}
+	if c.Disputes == nil {
+		t.Error("Disputes service is nil")
+	}
+	if c.Tax == nil {
+		t.Error("Tax service is nil")
+	}
+	if c.Currencies == nil {
+		t.Error("Currencies service is nil")
+	}
+	if c.Banks == nil {
+		t.Error("Banks service is nil")
+	}
+	if c.Payouts == nil {
+		t.Error("Payouts service is nil")
+	}
+	if c.TestClocks == nil {
+		t.Error("TestClocks service is nil")
+	}
+	if c.AuditLogs == nil {
+		t.Error("AuditLogs service is nil")
+	}
 }
 
 func TestWithBaseURL(t *testing.T) {
@@ -126,3 +164,158 @@ func TestDo_Returns500AsAPIError(t *testing.T) {
 		t.Fatal("expected error, got nil")
 	}
 }
+
+func TestDo_DecodesErrorCode(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, 409, map[string]string{
+			"error": "a customer with this external_id already exists",
+			"code":  "duplicate_customer",
+		})
+	}))
+	_, err := c.Customers.Create(context.Background(), monigo.CreateCustomerRequest{ExternalID: "ext-1", Name: "Acme"})
+	if !errors.Is(err, monigo.ErrCodeDuplicateCustomer) {
+		t.Errorf("expected ErrCodeDuplicateCustomer, got %v", err)
+	}
+}
+
+func TestWithCompression_GzipsLargeBodies(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Content-Encoding"); got != "gzip" {
+			t.Fatalf("Content-Encoding: got %q, want gzip", got)
+		}
+		zr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			t.Fatalf("gzip reader: %v", err)
+		}
+		var req monigo.IngestRequest
+		if err := json.NewDecoder(zr).Decode(&req); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if len(req.Events) != 100 {
+			t.Errorf("expected 100 events, got %d", len(req.Events))
+		}
+		respondJSON(t, w, 202, monigo.IngestResponse{})
+	}), monigo.WithCompression(0))
+
+	events := make([]monigo.IngestEvent, 100)
+	for i := range events {
+		events[i] = monigo.IngestEvent{EventName: "api_call", CustomerID: "cust-abc", IdempotencyKey: fmt.Sprintf("k%d", i)}
+	}
+	if _, err := c.Events.Ingest(context.Background(), monigo.IngestRequest{Events: events}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWithCompression_SkipsSmallBodies(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Content-Encoding"); got != "" {
+			t.Errorf("Content-Encoding: got %q, want none", got)
+		}
+		var req monigo.CreateCustomerRequest
+		decodeBody(t, r, &req)
+		respondJSON(t, w, 201, map[string]any{"customer": sampleCustomer})
+	}), monigo.WithCompression(4096))
+
+	if _, err := c.Customers.Create(context.Background(), monigo.CreateCustomerRequest{Name: "Acme"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTestMode(t *testing.T) {
+	if !monigo.New("sk_test_abc").TestMode() {
+		t.Error("expected sk_test_ key to select test mode")
+	}
+	if monigo.New("sk_live_abc").TestMode() {
+		t.Error("expected sk_live_ key to select live mode")
+	}
+	if !monigo.New("sk_live_abc", monigo.WithTestMode(true)).TestMode() {
+		t.Error("expected WithTestMode(true) to override the key prefix")
+	}
+}
+
+func TestTestMode_FlagsIngestedEvents(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req monigo.IngestRequest
+		decodeBody(t, r, &req)
+		if len(req.Events) != 1 || !req.Events[0].IsTest {
+			t.Errorf("expected test-mode event, got %+v", req.Events)
+		}
+		respondJSON(t, w, 202, monigo.IngestResponse{Ingested: []string{"k1"}})
+	}), monigo.WithTestMode(true))
+
+	events := []monigo.IngestEvent{{EventName: "api_call", CustomerID: "cust-abc", IdempotencyKey: "k1"}}
+	if _, err := c.Events.Ingest(context.Background(), monigo.IngestRequest{Events: events}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if events[0].IsTest {
+		t.Error("Ingest modified the caller's events")
+	}
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}), monigo.WithRequestTimeout(20*time.Millisecond))
+
+	_, err := c.Customers.Create(context.Background(), monigo.CreateCustomerRequest{Name: "Acme"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestWithTimeout_OverridesClientDefault(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		respondJSON(t, w, 201, map[string]any{"customer": sampleCustomer})
+	}), monigo.WithRequestTimeout(10*time.Millisecond))
+
+	_, err := c.Customers.Create(context.Background(), monigo.CreateCustomerRequest{Name: "Acme"}, monigo.WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestContextWithOptions(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Source"); got != "sdk-default" {
+			t.Errorf("X-Source: got %q, want sdk-default", got)
+		}
+		if got := r.Header.Get("X-On-Behalf-Of"); got != "org-2" {
+			t.Errorf("X-On-Behalf-Of: got %q, want org-2", got)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test_key_abc" {
+			t.Errorf("Authorization was overridden: %q", got)
+		}
+		respondJSON(t, w, 200, map[string]any{"customer": sampleCustomer})
+	}), monigo.WithDefaultRequestOptions(
+		monigo.WithHeader("X-Source", "sdk-default"),
+		monigo.WithHeader("X-On-Behalf-Of", "org-1"),
+	))
+
+	ctx := monigo.ContextWithOptions(context.Background(),
+		monigo.WithHeader("X-On-Behalf-Of", "org-2"),
+		monigo.WithHeader("Authorization", "Bearer other"),
+	)
+	if _, err := c.Customers.Get(ctx, "cust-abc"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestContextWithOptions_CallOptionsWin(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Idempotency-Key"); got != "call-key" {
+			t.Errorf("Idempotency-Key: got %q, want call-key", got)
+		}
+		respondJSON(t, w, 201, map[string]any{"customer": sampleCustomer})
+	}))
+
+	ctx := monigo.ContextWithOptions(context.Background(), monigo.WithIdempotencyKey("ctx-key"))
+	if _, err := c.Customers.Create(ctx, monigo.CreateCustomerRequest{Name: "Acme"}, monigo.WithIdempotencyKey("call-key")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,309 @@
+// Command monigo runs common Monigo operations from the shell, for
+// debugging and ops scripts that should not need a Go program:
+//
+//	monigo ingest -file events.ndjson
+//	monigo invoices list -customer <id> -status finalized
+//	monigo invoices generate -subscription <id>
+//	monigo customers create -file customer.json
+//	monigo plans create -file plan.json
+//	monigo usage -customer <id> -metric <id> -from 2026-01-01T00:00:00Z
+//	monigo webhooks tail -addr :8787
+//
+// The API key is read from MONIGO_API_KEY and the API URL, if not the
+// default, from MONIGO_BASE_URL. Results are printed as indented JSON.
+//
+// Install:
+//
+//	go install github.com/monigo-africa/go-monigo/cmd/monigo@latest
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+const usage = `usage: monigo <command> [flags]
+
+commands:
+  ingest             ingest events from an NDJSON or JSON-array file
+  invoices list      list invoices
+  invoices generate  generate a draft invoice for a subscription
+  customers create   create customers from a JSON file
+  plans create       create plans from a JSON file
+  usage              query usage rollups
+  webhooks tail      print webhook deliveries received on a local address
+
+Run "monigo <command> -h" for a command's flags.`
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Getenv); err != nil {
+		fmt.Fprintln(os.Stderr, "monigo:", err)
+		os.Exit(1)
+	}
+}
+
+// run executes the command in args. getenv supplies the configuration so
+// tests can run commands without touching the process environment.
+func run(ctx context.Context, args []string, stdin io.Reader, stdout io.Writer, getenv func(string) string) error {
+	if len(args) == 0 {
+		return errors.New(usage)
+	}
+	cmd, args := args[0], args[1:]
+	if (cmd == "invoices" || cmd == "customers" || cmd == "plans" || cmd == "webhooks") && len(args) > 0 {
+		cmd, args = cmd+" "+args[0], args[1:]
+	}
+
+	if cmd == "webhooks tail" {
+		return tailWebhooks(ctx, args, stdout)
+	}
+
+	apiKey := getenv("MONIGO_API_KEY")
+	if apiKey == "" {
+		return errors.New("MONIGO_API_KEY environment variable is required")
+	}
+	var opts []monigo.Option
+	if baseURL := getenv("MONIGO_BASE_URL"); baseURL != "" {
+		opts = append(opts, monigo.WithBaseURL(baseURL))
+	}
+	client := monigo.New(apiKey, opts...)
+
+	switch cmd {
+	case "ingest":
+		return ingest(ctx, client, args, stdin, stdout)
+	case "invoices list":
+		return listInvoices(ctx, client, args, stdout)
+	case "invoices generate":
+		return generateInvoice(ctx, client, args, stdout)
+	case "customers create":
+		return createCustomers(ctx, client, args, stdin, stdout)
+	case "plans create":
+		return createPlans(ctx, client, args, stdin, stdout)
+	case "usage":
+		return queryUsage(ctx, client, args, stdout)
+	default:
+		return fmt.Errorf("unknown command %q\n\n%s", cmd, usage)
+	}
+}
+
+func ingest(ctx context.Context, client *monigo.Client, args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := flag.NewFlagSet("ingest", flag.ContinueOnError)
+	file := fs.String("file", "-", "NDJSON or JSON-array file of events; - reads stdin")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	var events []monigo.IngestEvent
+	if err := readJSONList(*file, stdin, &events); err != nil {
+		return err
+	}
+	resp, err := client.Events.Ingest(ctx, monigo.IngestRequest{Events: events})
+	if err != nil {
+		return err
+	}
+	return printJSON(stdout, resp)
+}
+
+func listInvoices(ctx context.Context, client *monigo.Client, args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("invoices list", flag.ContinueOnError)
+	customer := fs.String("customer", "", "only invoices for this customer ID")
+	subscription := fs.String("subscription", "", "only invoices for this subscription ID")
+	status := fs.String("status", "", "only invoices with this status (draft, finalized, paid, void)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	resp, err := client.Invoices.List(ctx, monigo.ListInvoicesParams{
+		CustomerID:     *customer,
+		SubscriptionID: *subscription,
+		Status:         monigo.InvoiceStatus(*status),
+	})
+	if err != nil {
+		return err
+	}
+	return printJSON(stdout, resp)
+}
+
+func generateInvoice(ctx context.Context, client *monigo.Client, args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("invoices generate", flag.ContinueOnError)
+	subscription := fs.String("subscription", "", "subscription ID (required)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *subscription == "" {
+		return errors.New("invoices generate: -subscription is required")
+	}
+	inv, err := client.Invoices.Generate(ctx, *subscription)
+	if err != nil {
+		return err
+	}
+	return printJSON(stdout, inv)
+}
+
+func createCustomers(ctx context.Context, client *monigo.Client, args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := flag.NewFlagSet("customers create", flag.ContinueOnError)
+	file := fs.String("file", "-", "JSON file of one customer or an array of them; - reads stdin")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	var reqs []monigo.CreateCustomerRequest
+	if err := readJSONList(*file, stdin, &reqs); err != nil {
+		return err
+	}
+	for _, req := range reqs {
+		cust, err := client.Customers.Create(ctx, req)
+		if err != nil {
+			return fmt.Errorf("create customer %q: %w", req.Name, err)
+		}
+		if err := printJSON(stdout, cust); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func createPlans(ctx context.Context, client *monigo.Client, args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := flag.NewFlagSet("plans create", flag.ContinueOnError)
+	file := fs.String("file", "-", "JSON file of one plan or an array of them; - reads stdin")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	var reqs []monigo.CreatePlanRequest
+	if err := readJSONList(*file, stdin, &reqs); err != nil {
+		return err
+	}
+	for _, req := range reqs {
+		plan, err := client.Plans.Create(ctx, req)
+		if err != nil {
+			return fmt.Errorf("create plan %q: %w", req.Name, err)
+		}
+		if err := printJSON(stdout, plan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func queryUsage(ctx context.Context, client *monigo.Client, args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("usage", flag.ContinueOnError)
+	customer := fs.String("customer", "", "only usage for this customer ID")
+	metric := fs.String("metric", "", "only usage for this metric ID")
+	from := fs.String("from", "", "RFC 3339 start; defaults to the current billing period")
+	to := fs.String("to", "", "RFC 3339 end; defaults to the current billing period")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	params := monigo.UsageParams{CustomerID: *customer, MetricID: *metric}
+	for _, f := range []struct {
+		value string
+		dst   **time.Time
+	}{{*from, &params.From}, {*to, &params.To}} {
+		if f.value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, f.value)
+		if err != nil {
+			return fmt.Errorf("usage: %w", err)
+		}
+		*f.dst = &t
+	}
+	res, err := client.Usage.Query(ctx, params)
+	if err != nil {
+		return err
+	}
+	return printJSON(stdout, res)
+}
+
+// tailWebhooks serves until ctx is done, printing each delivery's method,
+// path and body. Point a webhook endpoint at it through a tunnel to
+// watch deliveries while debugging.
+func tailWebhooks(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("webhooks tail", flag.ContinueOnError)
+	addr := fs.String("addr", ":8787", "address to listen on")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	srv := &http.Server{Addr: *addr, Handler: webhookPrinter(stdout)}
+	go func() {
+		<-ctx.Done()
+		srv.Close()
+	}()
+	fmt.Fprintf(stdout, "listening for webhooks on %s\n", *addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// webhookPrinter writes each request it receives to w and answers 200.
+func webhookPrinter(w io.Writer) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, "--- %s %s %s\n", time.Now().UTC().Format(time.RFC3339), r.Method, r.URL.Path)
+		var pretty bytes.Buffer
+		if json.Indent(&pretty, body, "", "  ") == nil {
+			body = pretty.Bytes()
+		}
+		fmt.Fprintf(w, "%s\n", body)
+		rw.WriteHeader(http.StatusOK)
+	})
+}
+
+// readJSONList decodes name (or stdin for "-") into dst, a pointer to a
+// slice. The input may be a JSON array, a single object, or one object per
+// line.
+func readJSONList(name string, stdin io.Reader, dst any) error {
+	var data []byte
+	var err error
+	if name == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(name)
+	}
+	if err != nil {
+		return err
+	}
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		return json.Unmarshal(data, dst)
+	}
+
+	// One or more objects: wrap them in an array.
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	dec := json.NewDecoder(bytes.NewReader(data))
+	for i := 0; ; i++ {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(raw)
+	}
+	buf.WriteByte(']')
+	return json.Unmarshal(buf.Bytes(), dst)
+}
+
+func printJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
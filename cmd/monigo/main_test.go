@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+	"github.com/monigo-africa/go-monigo/monigotest"
+)
+
+func runAgainst(t *testing.T, srv *monigotest.Server, stdin string, args ...string) string {
+	t.Helper()
+	env := map[string]string{"MONIGO_API_KEY": "sk_test_cli", "MONIGO_BASE_URL": srv.URL}
+	var out bytes.Buffer
+	if err := run(context.Background(), args, strings.NewReader(stdin), &out, func(k string) string { return env[k] }); err != nil {
+		t.Fatalf("monigo %s: %v", strings.Join(args, " "), err)
+	}
+	return out.String()
+}
+
+func TestIngest_NDJSONFromStdin(t *testing.T) {
+	srv := monigotest.NewServer(t)
+	stdin := `{"event_name":"api_call","customer_id":"cust-1","idempotency_key":"k1","timestamp":"2026-01-02T15:04:05Z","properties":{}}
+{"event_name":"api_call","customer_id":"cust-1","idempotency_key":"k2","timestamp":"2026-01-02T15:04:06Z","properties":{}}
+`
+	out := runAgainst(t, srv, stdin, "ingest")
+
+	var resp monigo.IngestResponse
+	if err := json.Unmarshal([]byte(out), &resp); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, out)
+	}
+	if len(resp.Ingested) != 2 || len(srv.Events()) != 2 {
+		t.Errorf("expected 2 events ingested, got %v", resp.Ingested)
+	}
+}
+
+func TestCustomersCreate_Array(t *testing.T) {
+	srv := monigotest.NewServer(t)
+	out := runAgainst(t, srv, `[{"name":"Acme Corp"},{"name":"Globex"}]`, "customers", "create")
+	if strings.Count(out, `"name"`) != 2 {
+		t.Errorf("expected two customers printed, got:\n%s", out)
+	}
+}
+
+func TestRun_RequiresAPIKey(t *testing.T) {
+	err := run(context.Background(), []string{"usage"}, nil, &bytes.Buffer{}, func(string) string { return "" })
+	if err == nil || !strings.Contains(err.Error(), "MONIGO_API_KEY") {
+		t.Errorf("expected missing key error, got %v", err)
+	}
+}
+
+func TestWebhookPrinter(t *testing.T) {
+	var out bytes.Buffer
+	rec := httptest.NewRecorder()
+	webhookPrinter(&out).ServeHTTP(rec, httptest.NewRequest("POST", "/hooks", strings.NewReader(`{"event":"invoice.paid"}`)))
+	if rec.Code != 200 {
+		t.Errorf("expected 200, got %d", rec.Code)
+	}
+	if !strings.Contains(out.String(), `"event": "invoice.paid"`) {
+		t.Errorf("expected the indented body, got:\n%s", out.String())
+	}
+}
@@ -0,0 +1,56 @@
+package monigo
+
+import (
+	"context"
+)
+
+// CouponService manages discount coupons. Apply a coupon to a subscription
+// with SubscriptionService.ApplyCoupon.
+type CouponService struct {
+	client *Client
+}
+
+// Create defines a new coupon.
+func (s *CouponService) Create(ctx context.Context, req CreateCouponRequest, opts ...RequestOption) (*Coupon, error) {
+	var wrapper struct {
+		Coupon Coupon `json:"coupon"`
+	}
+	if err := s.client.do(ctx, "POST", "/v1/coupons", req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Coupon, nil
+}
+
+// List returns all coupons for the authenticated organisation.
+func (s *CouponService) List(ctx context.Context) (*ListCouponsResponse, error) {
+	var out ListCouponsResponse
+	if err := s.client.do(ctx, "GET", "/v1/coupons", nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Get fetches a single coupon by its UUID.
+func (s *CouponService) Get(ctx context.Context, couponID string) (*Coupon, error) {
+	var wrapper struct {
+		Coupon Coupon `json:"coupon"`
+	}
+	path, err := pathf("/v1/coupons/%s", couponID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Coupon, nil
+}
+
+// Delete removes a coupon so it can no longer be applied. Subscriptions that
+// already carry the coupon keep their discount until its duration ends.
+func (s *CouponService) Delete(ctx context.Context, couponID string) error {
+	path, err := pathf("/v1/coupons/%s", couponID)
+	if err != nil {
+		return err
+	}
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
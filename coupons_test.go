@@ -0,0 +1,139 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+var sampleCoupon = monigo.Coupon{
+	ID:              "coupon-1",
+	OrgID:           "org-1",
+	Code:            "LAUNCH20",
+	Name:            "Launch 20%",
+	Type:            monigo.CouponTypePercentage,
+	PercentOff:      "20.00",
+	Duration:        monigo.CouponDurationRepeating,
+	DurationPeriods: 3,
+	CreatedAt:       time.Now(),
+	UpdatedAt:       time.Now(),
+}
+
+func TestCoupons_Create(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/coupons")
+		assertBearerToken(t, r)
+
+		var req monigo.CreateCouponRequest
+		decodeBody(t, r, &req)
+		if req.Type != monigo.CouponTypePercentage {
+			t.Errorf("type: got %q, want percentage", req.Type)
+		}
+		if req.DurationPeriods != 3 {
+			t.Errorf("duration_periods: got %d, want 3", req.DurationPeriods)
+		}
+		respondJSON(t, w, 201, map[string]any{"coupon": sampleCoupon})
+	}))
+
+	coupon, err := c.Coupons.Create(context.Background(), monigo.CreateCouponRequest{
+		Code:            "LAUNCH20",
+		Type:            monigo.CouponTypePercentage,
+		PercentOff:      "20.00",
+		Duration:        monigo.CouponDurationRepeating,
+		DurationPeriods: 3,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if coupon.ID != "coupon-1" {
+		t.Errorf("expected coupon-1, got %s", coupon.ID)
+	}
+}
+
+func TestCoupons_List(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/coupons")
+		respondJSON(t, w, 200, monigo.ListCouponsResponse{Coupons: []monigo.Coupon{sampleCoupon}, Count: 1})
+	}))
+
+	resp, err := c.Coupons.List(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Count != 1 {
+		t.Errorf("expected count 1, got %d", resp.Count)
+	}
+}
+
+func TestCoupons_Get(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/coupons/coupon-1")
+		respondJSON(t, w, 200, map[string]any{"coupon": sampleCoupon})
+	}))
+
+	coupon, err := c.Coupons.Get(context.Background(), "coupon-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if coupon.Code != "LAUNCH20" {
+		t.Errorf("expected LAUNCH20, got %s", coupon.Code)
+	}
+}
+
+func TestCoupons_Delete(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "DELETE")
+		assertPath(t, r, "/v1/coupons/coupon-1")
+		w.WriteHeader(204)
+	}))
+
+	if err := c.Coupons.Delete(context.Background(), "coupon-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSubscriptions_ApplyCoupon(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/subscriptions/sub-1/coupon")
+
+		var req monigo.ApplyCouponRequest
+		decodeBody(t, r, &req)
+		if req.Code != "LAUNCH20" {
+			t.Errorf("code: got %q, want LAUNCH20", req.Code)
+		}
+		sub := sampleSubscription
+		sub.CouponID = "coupon-1"
+		respondJSON(t, w, 200, map[string]any{"subscription": sub})
+	}))
+
+	sub, err := c.Subscriptions.ApplyCoupon(context.Background(), "sub-1", monigo.ApplyCouponRequest{Code: "LAUNCH20"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sub.CouponID != "coupon-1" {
+		t.Errorf("expected coupon-1, got %s", sub.CouponID)
+	}
+}
+
+func TestSubscriptions_RemoveCoupon(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "DELETE")
+		assertPath(t, r, "/v1/subscriptions/sub-1/coupon")
+		respondJSON(t, w, 200, map[string]any{"subscription": sampleSubscription})
+	}))
+
+	sub, err := c.Subscriptions.RemoveCoupon(context.Background(), "sub-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sub.CouponID != "" {
+		t.Errorf("expected no coupon, got %s", sub.CouponID)
+	}
+}
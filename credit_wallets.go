@@ -0,0 +1,104 @@
+package monigo
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// CreditWalletService manages prepaid credit balances. Credit is applied
+// automatically to a customer's invoices before any amount becomes due and
+// shows up as an InvoiceLineItemTypeCredit line.
+type CreditWalletService struct {
+	client *Client
+}
+
+// Grant adds prepaid credit to a customer's balance.
+func (s *CreditWalletService) Grant(ctx context.Context, customerID string, req GrantCreditsRequest, opts ...RequestOption) (*CreditTransaction, error) {
+	var wrapper struct {
+		Transaction CreditTransaction `json:"transaction"`
+	}
+	path, err := pathf("/v1/customers/%s/credits/grant", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Transaction, nil
+}
+
+// Deduct removes credit from a customer's balance.
+// Returns a 402 error if the balance is insufficient.
+func (s *CreditWalletService) Deduct(ctx context.Context, customerID string, req DeductCreditsRequest, opts ...RequestOption) (*CreditTransaction, error) {
+	var wrapper struct {
+		Transaction CreditTransaction `json:"transaction"`
+	}
+	path, err := pathf("/v1/customers/%s/credits/deduct", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Transaction, nil
+}
+
+// GetBalance returns the customer's current credit balance.
+func (s *CreditWalletService) GetBalance(ctx context.Context, customerID string) (*CreditBalance, error) {
+	var wrapper struct {
+		Balance CreditBalance `json:"balance"`
+	}
+	path, err := pathf("/v1/customers/%s/credits", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Balance, nil
+}
+
+// ListTransactions returns paginated credit grants, deductions, and invoice
+// applications for a customer, newest first.
+func (s *CreditWalletService) ListTransactions(ctx context.Context, customerID string, params ListTransactionsParams) (*ListCreditTransactionsResponse, error) {
+	q := url.Values{}
+	if params.Limit > 0 {
+		q.Set("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Offset > 0 {
+		q.Set("offset", strconv.Itoa(params.Offset))
+	}
+
+	path, err := pathf("/v1/customers/%s/credits/transactions", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(q) > 0 {
+		path = path + "?" + q.Encode()
+	}
+
+	var out ListCreditTransactionsResponse
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGrants returns the customer's credit grants in the order invoices
+// consume them, with how much of each remains.
+func (s *CreditWalletService) ListGrants(ctx context.Context, customerID string, params ListCreditGrantsParams) (*ListCreditGrantsResponse, error) {
+	path, err := pathf("/v1/customers/%s/credits/grants", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if params.IncludeExhausted {
+		path += "?include_exhausted=true"
+	}
+
+	var out ListCreditGrantsResponse
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,180 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+var sampleCreditTransaction = monigo.CreditTransaction{
+	ID:           "ctx-1",
+	OrgID:        "org-1",
+	CustomerID:   "cust-abc",
+	Type:         monigo.CreditTransactionTypeGrant,
+	Amount:       "5000.00",
+	Currency:     "NGN",
+	BalanceAfter: "5000.00",
+	Description:  "Launch promo",
+	CreatedAt:    time.Now(),
+}
+
+func TestCreditWallets_Grant(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/customers/cust-abc/credits/grant")
+		assertBearerToken(t, r)
+
+		var req monigo.GrantCreditsRequest
+		decodeBody(t, r, &req)
+		if req.Amount != "5000.00" {
+			t.Errorf("amount: got %q, want 5000.00", req.Amount)
+		}
+		respondJSON(t, w, 201, map[string]any{"transaction": sampleCreditTransaction})
+	}))
+
+	txn, err := c.CreditWallets.Grant(context.Background(), "cust-abc", monigo.GrantCreditsRequest{
+		Amount:      "5000.00",
+		Currency:    "NGN",
+		Description: "Launch promo",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if txn.BalanceAfter != "5000.00" {
+		t.Errorf("expected balance_after 5000.00, got %s", txn.BalanceAfter)
+	}
+}
+
+func TestCreditWallets_Deduct_Insufficient(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/customers/cust-abc/credits/deduct")
+		respondError(t, w, 402, "insufficient credit balance")
+	}))
+
+	_, err := c.CreditWallets.Deduct(context.Background(), "cust-abc", monigo.DeductCreditsRequest{
+		Amount:   "99999.00",
+		Currency: "NGN",
+	})
+	if !monigo.IsQuotaExceeded(err) {
+		t.Errorf("expected 402 error, got %v", err)
+	}
+}
+
+func TestCreditWallets_GetBalance(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/customers/cust-abc/credits")
+		respondJSON(t, w, 200, map[string]any{"balance": monigo.CreditBalance{
+			CustomerID: "cust-abc",
+			Currency:   "NGN",
+			Balance:    "3500.00",
+		}})
+	}))
+
+	bal, err := c.CreditWallets.GetBalance(context.Background(), "cust-abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bal.Balance != "3500.00" {
+		t.Errorf("expected balance 3500.00, got %s", bal.Balance)
+	}
+}
+
+func TestCreditWallets_ListTransactions(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/customers/cust-abc/credits/transactions")
+		if r.URL.Query().Get("limit") != "10" {
+			t.Errorf("limit: got %q, want 10", r.URL.Query().Get("limit"))
+		}
+		respondJSON(t, w, 200, monigo.ListCreditTransactionsResponse{
+			Transactions: []monigo.CreditTransaction{sampleCreditTransaction},
+			Total:        1,
+			Limit:        10,
+		})
+	}))
+
+	resp, err := c.CreditWallets.ListTransactions(context.Background(), "cust-abc", monigo.ListTransactionsParams{Limit: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Transactions) != 1 {
+		t.Errorf("expected 1 transaction, got %d", len(resp.Transactions))
+	}
+}
+
+func TestCreditWallets_Grant_Expiring(t *testing.T) {
+	expires := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req monigo.GrantCreditsRequest
+		decodeBody(t, r, &req)
+		if req.ExpiresAt == nil || !req.ExpiresAt.Equal(expires) || req.Priority != -1 {
+			t.Errorf("unexpected body: %+v", req)
+		}
+		txn := sampleCreditTransaction
+		txn.ExpiresAt, txn.Priority = req.ExpiresAt, req.Priority
+		respondJSON(t, w, 201, map[string]any{"transaction": txn})
+	}))
+
+	txn, err := c.CreditWallets.Grant(context.Background(), "cust-abc", monigo.GrantCreditsRequest{
+		Amount:    "2000.00",
+		Currency:  "NGN",
+		ExpiresAt: &expires,
+		Priority:  -1,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if txn.ExpiresAt == nil || txn.Priority != -1 {
+		t.Errorf("unexpected transaction: %+v", txn)
+	}
+}
+
+func TestCreditWallets_ListGrants(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/customers/cust-abc/credits/grants")
+		if r.URL.Query().Get("include_exhausted") != "true" {
+			t.Errorf("expected include_exhausted=true, got %q", r.URL.RawQuery)
+		}
+		respondJSON(t, w, 200, monigo.ListCreditGrantsResponse{
+			Grants: []monigo.CreditGrant{{ID: "ctx-1", Amount: "5000.00", Remaining: "1200.00", Currency: "NGN"}},
+			Count:  1,
+		})
+	}))
+
+	resp, err := c.CreditWallets.ListGrants(context.Background(), "cust-abc", monigo.ListCreditGrantsParams{IncludeExhausted: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Grants[0].Remaining != "1200.00" {
+		t.Errorf("expected remaining 1200.00, got %s", resp.Grants[0].Remaining)
+	}
+}
+
+func TestInvoices_ListCreditApplications(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/invoices/inv-1/credit-applications")
+		respondJSON(t, w, 200, monigo.ListCreditApplicationsResponse{
+			Applications: []monigo.CreditApplication{
+				{ID: "ca-1", InvoiceID: "inv-1", GrantID: "ctx-2", Amount: "2000.00", Currency: "NGN"},
+				{ID: "ca-2", InvoiceID: "inv-1", GrantID: "ctx-1", Amount: "800.00", Currency: "NGN"},
+			},
+			Count: 2,
+		})
+	}))
+
+	resp, err := c.Invoices.ListCreditApplications(context.Background(), "inv-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Count != 2 || resp.Applications[0].GrantID != "ctx-2" {
+		t.Errorf("unexpected applications: %+v", resp.Applications)
+	}
+}
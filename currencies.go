@@ -0,0 +1,17 @@
+package monigo
+
+import "context"
+
+// CurrencyService lists the currencies Monigo can bill in.
+type CurrencyService struct {
+	client *Client
+}
+
+// ListSupported returns every currency that plans and prices may use.
+func (s *CurrencyService) ListSupported(ctx context.Context) (*ListCurrenciesResponse, error) {
+	var out ListCurrenciesResponse
+	if err := s.client.do(ctx, "GET", "/v1/currencies", nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,32 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestCurrencies_ListSupported(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/currencies")
+		assertBearerToken(t, r)
+		respondJSON(t, w, 200, monigo.ListCurrenciesResponse{
+			Currencies: []monigo.Currency{
+				{Code: "NGN", Name: "Nigerian Naira", Symbol: "₦", MinorUnits: 2},
+				{Code: "KES", Name: "Kenyan Shilling", Symbol: "KSh", MinorUnits: 2},
+			},
+			Count: 2,
+		})
+	}))
+
+	resp, err := c.Currencies.ListSupported(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Count != 2 || resp.Currencies[1].Code != "KES" {
+		t.Errorf("unexpected currencies: %+v", resp)
+	}
+}
@@ -0,0 +1,17 @@
+package monigo
+
+import "fmt"
+
+// cursorGuard remembers the cursors a pagination loop has followed, so a
+// server that hands one back twice ends the loop with an error instead of
+// fetching the same pages forever.
+type cursorGuard map[string]bool
+
+// follow records cursor, reporting an error if it was followed before.
+func (g cursorGuard) follow(cursor string) error {
+	if g[cursor] {
+		return fmt.Errorf("monigo: pagination cursor %q was returned twice", cursor)
+	}
+	g[cursor] = true
+	return nil
+}
@@ -0,0 +1,135 @@
+package monigo
+
+import (
+	"context"
+	"sync"
+)
+
+// overviewPageSize is the page size Overview lists subscriptions and
+// invoices with.
+const overviewPageSize = 100
+
+// Overview fetches a customer together with their active subscriptions,
+// current-period usage, open invoices, and default payout account. The five
+// lookups run in parallel, each following every page of its results; the
+// first one to fail cancels the rest and its error is returned, so an
+// unknown customerID yields a 404 (use IsNotFound).
+func (s *CustomerService) Overview(ctx context.Context, customerID string) (*CustomerOverview, error) {
+	if err := checkID(customerID); err != nil {
+		return nil, err
+	}
+	c := s.client
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		out      CustomerOverview
+	)
+	run := func(fetch func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fetch(); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	run(func() error {
+		cust, err := c.Customers.Get(ctx, customerID)
+		if err == nil {
+			out.Customer = *cust
+		}
+		return err
+	})
+	run(func() error {
+		params := ListSubscriptionsParams{
+			CustomerID: customerID,
+			Status:     SubscriptionStatusActive,
+			Page:       1,
+			PerPage:    overviewPageSize,
+		}
+		for {
+			page, err := c.Subscriptions.List(ctx, params)
+			if err != nil {
+				return err
+			}
+			out.Subscriptions = append(out.Subscriptions, page.Subscriptions...)
+			if lastPage(len(page.Subscriptions), len(out.Subscriptions), page.Total) {
+				return nil
+			}
+			params.Page++
+		}
+	})
+	run(func() error {
+		params := UsageParams{CustomerID: customerID}
+		seen := cursorGuard{}
+		for {
+			page, err := c.Usage.Query(ctx, params)
+			if err != nil {
+				return err
+			}
+			out.Usage = append(out.Usage, page.Rollups...)
+			if page.NextCursor == "" {
+				return nil
+			}
+			if err := seen.follow(page.NextCursor); err != nil {
+				return err
+			}
+			params.Cursor = page.NextCursor
+		}
+	})
+	run(func() error {
+		params := ListInvoicesParams{
+			CustomerID: customerID,
+			Status:     InvoiceStatusFinalized,
+			Page:       1,
+			PerPage:    overviewPageSize,
+		}
+		for {
+			page, err := c.Invoices.List(ctx, params)
+			if err != nil {
+				return err
+			}
+			out.OpenInvoices = append(out.OpenInvoices, page.Invoices...)
+			if lastPage(len(page.Invoices), len(out.OpenInvoices), page.Total) {
+				return nil
+			}
+			params.Page++
+		}
+	})
+	run(func() error {
+		accts, err := c.PayoutAccounts.List(ctx, customerID)
+		if err != nil {
+			return err
+		}
+		for i := range accts.PayoutAccounts {
+			if accts.PayoutAccounts[i].IsDefault {
+				out.DefaultPayoutAccount = &accts.PayoutAccounts[i]
+				break
+			}
+		}
+		return nil
+	})
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return &out, nil
+}
+
+// lastPage reports whether a page-numbered listing is exhausted: the page
+// came back short, or everything the server counted has been fetched.
+func lastPage(got, fetched, total int) bool {
+	return got < overviewPageSize || (total > 0 && fetched >= total)
+}
@@ -0,0 +1,105 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestCustomers_Overview(t *testing.T) {
+	fallback := sampleAccount
+	fallback.ID = "acct-2"
+	def := sampleAccount
+	def.IsDefault = true
+
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch r.URL.Path {
+		case "/v1/customers/cust-1":
+			respondJSON(t, w, 200, map[string]any{"customer": sampleCustomer})
+		case "/v1/subscriptions":
+			if q.Get("customer_id") != "cust-1" || q.Get("status") != "active" {
+				t.Errorf("unexpected subscriptions query: %s", r.URL.RawQuery)
+			}
+			respondJSON(t, w, 200, monigo.ListSubscriptionsResponse{Subscriptions: []monigo.Subscription{sampleSubscription}, Count: 1})
+		case "/v1/usage":
+			if q.Get("cursor") == "" {
+				respondJSON(t, w, 200, monigo.UsageQueryResult{Rollups: []monigo.UsageRollup{{ID: "r-1"}}, Count: 1, NextCursor: "next"})
+				return
+			}
+			respondJSON(t, w, 200, monigo.UsageQueryResult{Rollups: []monigo.UsageRollup{{ID: "r-2"}}, Count: 1})
+		case "/v1/invoices":
+			if q.Get("customer_id") != "cust-1" || q.Get("status") != "finalized" {
+				t.Errorf("unexpected invoices query: %s", r.URL.RawQuery)
+			}
+			// 101 open invoices: a full first page and one more.
+			if q.Get("page") == "1" {
+				page := make([]monigo.Invoice, 100)
+				for i := range page {
+					page[i] = sampleInvoice
+				}
+				respondJSON(t, w, 200, monigo.ListInvoicesResponse{Invoices: page, Count: 100, Total: 101})
+				return
+			}
+			respondJSON(t, w, 200, monigo.ListInvoicesResponse{Invoices: []monigo.Invoice{sampleInvoice}, Count: 1, Total: 101})
+		case "/v1/customers/cust-1/payout-accounts":
+			respondJSON(t, w, 200, monigo.ListPayoutAccountsResponse{PayoutAccounts: []monigo.PayoutAccount{fallback, def}, Count: 2})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			respondError(t, w, 404, "not found")
+		}
+	}))
+
+	ov, err := c.Customers.Overview(context.Background(), "cust-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ov.Customer.ID != sampleCustomer.ID {
+		t.Errorf("customer: got %q", ov.Customer.ID)
+	}
+	if len(ov.Subscriptions) != 1 {
+		t.Errorf("expected 1 subscription, got %d", len(ov.Subscriptions))
+	}
+	if len(ov.OpenInvoices) != 101 {
+		t.Errorf("expected both invoice pages, got %d invoices", len(ov.OpenInvoices))
+	}
+	if len(ov.Usage) != 2 {
+		t.Errorf("expected both usage pages, got %d rollups", len(ov.Usage))
+	}
+	if ov.DefaultPayoutAccount == nil || !ov.DefaultPayoutAccount.IsDefault {
+		t.Errorf("default payout account: got %+v", ov.DefaultPayoutAccount)
+	}
+}
+
+func TestCustomers_Overview_NotFound(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/customers/cust-missing" {
+			respondError(t, w, 404, "customer not found")
+			return
+		}
+		<-r.Context().Done()
+	}))
+
+	if _, err := c.Customers.Overview(context.Background(), "cust-missing"); !monigo.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestCustomers_Overview_RepeatedCursor(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/usage":
+			respondJSON(t, w, 200, monigo.UsageQueryResult{Rollups: []monigo.UsageRollup{{ID: "r-1"}}, Count: 1, NextCursor: "stuck"})
+		case "/v1/customers/cust-1":
+			respondJSON(t, w, 200, map[string]any{"customer": sampleCustomer})
+		default:
+			respondJSON(t, w, 200, map[string]any{})
+		}
+	}))
+
+	if _, err := c.Customers.Overview(context.Background(), "cust-1"); err == nil {
+		t.Error("expected an error for a cursor returned twice")
+	}
+}
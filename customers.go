@@ -2,7 +2,12 @@ package monigo
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 )
 
 // CustomerService manages the end-customers in your Monigo organisation.
@@ -12,6 +17,9 @@ type CustomerService struct {
 
 // Create registers a new customer.
 func (s *CustomerService) Create(ctx context.Context, req CreateCustomerRequest, opts ...RequestOption) (*Customer, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	var wrapper struct {
 		Customer Customer `json:"customer"`
 	}
@@ -21,10 +29,19 @@ func (s *CustomerService) Create(ctx context.Context, req CreateCustomerRequest,
 	return &wrapper.Customer, nil
 }
 
-// List returns all customers belonging to the authenticated organisation.
-func (s *CustomerService) List(ctx context.Context) (*ListCustomersResponse, error) {
+// List returns customers belonging to the authenticated organisation. With
+// no params every customer is returned; pass a ListCustomersParams to search
+// and paginate server-side.
+func (s *CustomerService) List(ctx context.Context, params ...ListCustomersParams) (*ListCustomersResponse, error) {
+	path := "/v1/customers"
+	if len(params) > 0 {
+		if q := params[0].values(); len(q) > 0 {
+			path += "?" + q.Encode()
+		}
+	}
+
 	var out ListCustomersResponse
-	if err := s.client.do(ctx, "GET", "/v1/customers", nil, &out); err != nil {
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
 		return nil, err
 	}
 	return &out, nil
@@ -35,25 +52,234 @@ func (s *CustomerService) Get(ctx context.Context, customerID string) (*Customer
 	var wrapper struct {
 		Customer Customer `json:"customer"`
 	}
-	if err := s.client.do(ctx, "GET", fmt.Sprintf("/v1/customers/%s", customerID), nil, &wrapper); err != nil {
+	path, err := pathf("/v1/customers/%s", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
 		return nil, err
 	}
 	return &wrapper.Customer, nil
 }
 
+// GetByExternalID fetches the customer whose ExternalID is externalID.
+// Archived customers are included. It returns a 404 *APIError (use
+// IsNotFound) when no customer matches.
+func (s *CustomerService) GetByExternalID(ctx context.Context, externalID string) (*Customer, error) {
+	if err := checkID(externalID); err != nil {
+		return nil, err
+	}
+	list, err := s.List(ctx, ListCustomersParams{ExternalID: externalID, IncludeArchived: true, PerPage: 1})
+	if err != nil {
+		return nil, err
+	}
+	for i := range list.Customers {
+		if list.Customers[i].ExternalID == externalID {
+			return &list.Customers[i], nil
+		}
+	}
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Code:       ErrCodeNotFound,
+		Message:    fmt.Sprintf("no customer with external ID %q", externalID),
+	}
+}
+
+// Upsert creates the customer described by req or, if a customer with the
+// same ExternalID already exists, updates that customer's name, email,
+// phone, locale, and metadata to match req. It makes provisioning pipelines
+// idempotent without handling ErrCodeDuplicateCustomer themselves.
+//
+// req.ExternalID is required. TestClockID only applies when the customer is
+// created. An idempotency key in opts is used for the create; the update
+// uses the same key with ":update" appended.
+func (s *CustomerService) Upsert(ctx context.Context, req CreateCustomerRequest, opts ...RequestOption) (*Customer, error) {
+	if req.ExternalID == "" {
+		return nil, &RequestValidationError{Fields: map[string]string{"external_id": "is required"}}
+	}
+	cust, err := s.Create(ctx, req, opts...)
+	if err == nil || !errors.Is(err, ErrCodeDuplicateCustomer) {
+		return cust, err
+	}
+
+	existing, err := s.GetByExternalID(ctx, req.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+	// The create and the update are different requests; replaying the
+	// create's idempotency key on the update would return the stored
+	// duplicate-customer error instead of updating.
+	if cfg := s.client.requestConfig(ctx, opts); cfg.idempotencyKey != "" {
+		opts = append(opts[:len(opts):len(opts)], WithIdempotencyKey(cfg.idempotencyKey+":update"))
+	}
+	update := UpdateCustomerRequest{
+		Name:   req.Name,
+		Email:  req.Email,
+		Phone:  req.Phone,
+		Locale: req.Locale,
+	}
+	if req.Metadata != nil {
+		update.Metadata = &req.Metadata
+	}
+	return s.Update(ctx, existing.ID, update, opts...)
+}
+
+// ListChildren returns the child accounts of a parent customer.
+func (s *CustomerService) ListChildren(ctx context.Context, customerID string) (*ListCustomersResponse, error) {
+	var out ListCustomersResponse
+	path, err := pathf("/v1/customers/%s/children", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Update modifies an existing customer's name, email, or metadata.
 // Only non-zero fields in req are sent; pass zero values to leave fields unchanged.
 func (s *CustomerService) Update(ctx context.Context, customerID string, req UpdateCustomerRequest, opts ...RequestOption) (*Customer, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	var wrapper struct {
+		Customer Customer `json:"customer"`
+	}
+	path, err := pathf("/v1/customers/%s", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "PUT", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Customer, nil
+}
+
+// Archive stops future invoicing for a customer while keeping their invoices,
+// usage, and payout history. Active subscriptions stop billing at the end of
+// the current period. Prefer Archive over Delete for customers who have been
+// billed.
+func (s *CustomerService) Archive(ctx context.Context, customerID string, opts ...RequestOption) (*Customer, error) {
+	var wrapper struct {
+		Customer Customer `json:"customer"`
+	}
+	path, err := pathf("/v1/customers/%s/archive", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, nil, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Customer, nil
+}
+
+// Unarchive returns an archived customer to active status. Subscriptions must
+// be resumed or recreated separately.
+func (s *CustomerService) Unarchive(ctx context.Context, customerID string, opts ...RequestOption) (*Customer, error) {
 	var wrapper struct {
 		Customer Customer `json:"customer"`
 	}
-	if err := s.client.do(ctx, "PUT", fmt.Sprintf("/v1/customers/%s", customerID), req, &wrapper, opts...); err != nil {
+	path, err := pathf("/v1/customers/%s/unarchive", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, nil, &wrapper, opts...); err != nil {
 		return nil, err
 	}
 	return &wrapper.Customer, nil
 }
 
-// Delete permanently removes a customer record.
+// Delete removes a customer record. Deleted customers can be recovered with Restore
+// within the retention window; after that they are purged. To stop billing a
+// customer while keeping their history, use Archive instead.
 func (s *CustomerService) Delete(ctx context.Context, customerID string) error {
-	return s.client.do(ctx, "DELETE", fmt.Sprintf("/v1/customers/%s", customerID), nil, nil)
+	path, err := pathf("/v1/customers/%s", customerID)
+	if err != nil {
+		return err
+	}
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// Restore recovers a recently deleted customer. Returns a 404 error (use
+// IsNotFound) once the retention window has passed.
+func (s *CustomerService) Restore(ctx context.Context, customerID string, opts ...RequestOption) (*Customer, error) {
+	var wrapper struct {
+		Customer Customer `json:"customer"`
+	}
+	path, err := pathf("/v1/customers/%s/restore", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, nil, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Customer, nil
+}
+
+// GetBillingSettings returns the customer's invoicing preferences.
+func (s *CustomerService) GetBillingSettings(ctx context.Context, customerID string) (*BillingSettings, error) {
+	var wrapper struct {
+		BillingSettings BillingSettings `json:"billing_settings"`
+	}
+	path, err := pathf("/v1/customers/%s/billing-settings", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.BillingSettings, nil
+}
+
+// UpdateBillingSettings changes the customer's invoicing preferences:
+// subscription consolidation, invoice day of month, delivery channel, and CC
+// recipients. Changes apply from the next generated invoice.
+func (s *CustomerService) UpdateBillingSettings(ctx context.Context, customerID string, req UpdateBillingSettingsRequest, opts ...RequestOption) (*BillingSettings, error) {
+	var wrapper struct {
+		BillingSettings BillingSettings `json:"billing_settings"`
+	}
+	path, err := pathf("/v1/customers/%s/billing-settings", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "PUT", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.BillingSettings, nil
+}
+
+// values encodes p as query parameters. Metadata filters are sent as
+// metadata[key]=value.
+func (p ListCustomersParams) values() url.Values {
+	q := url.Values{}
+	if p.Query != "" {
+		q.Set("q", p.Query)
+	}
+	if p.Email != "" {
+		q.Set("email", p.Email)
+	}
+	if p.ExternalID != "" {
+		q.Set("external_id", p.ExternalID)
+	}
+	if p.ParentCustomerID != "" {
+		q.Set("parent_customer_id", p.ParentCustomerID)
+	}
+	if p.CreatedAfter != nil {
+		q.Set("created_after", p.CreatedAfter.UTC().Format(time.RFC3339))
+	}
+	if p.CreatedBefore != nil {
+		q.Set("created_before", p.CreatedBefore.UTC().Format(time.RFC3339))
+	}
+	setMetadataFilter(q, p.MetadataFilter)
+	if p.Page > 0 {
+		q.Set("page", strconv.Itoa(p.Page))
+	}
+	if p.PerPage > 0 {
+		q.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	if p.IncludeArchived {
+		q.Set("include_archived", "true")
+	}
+	return q
 }
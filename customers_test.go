@@ -2,6 +2,7 @@ package monigo_test
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"testing"
 	"time"
@@ -46,6 +47,31 @@ func TestCustomers_Create(t *testing.T) {
 	}
 }
 
+func TestCustomers_Create_WithLocale(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req monigo.CreateCustomerRequest
+		decodeBody(t, r, &req)
+		if req.Locale != monigo.LocaleFrench {
+			t.Errorf("locale: got %q, want fr", req.Locale)
+		}
+		cust := sampleCustomer
+		cust.Locale = req.Locale
+		respondJSON(t, w, 201, map[string]any{"customer": cust})
+	}))
+
+	cust, err := c.Customers.Create(context.Background(), monigo.CreateCustomerRequest{
+		ExternalID: "ext-1",
+		Name:       "Société Dakar",
+		Locale:     monigo.LocaleFrench,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cust.Locale != monigo.LocaleFrench {
+		t.Errorf("expected locale fr, got %s", cust.Locale)
+	}
+}
+
 func TestCustomers_List(t *testing.T) {
 	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertMethod(t, r, "GET")
@@ -68,6 +94,51 @@ func TestCustomers_List(t *testing.T) {
 	}
 }
 
+func TestCustomers_ListWithParams(t *testing.T) {
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/customers")
+		q := r.URL.Query()
+		want := map[string]string{
+			"q":              "acme",
+			"email":          "billing@acme.example",
+			"created_after":  "2026-01-01T00:00:00Z",
+			"metadata[tier]": "enterprise",
+			"page":           "2",
+			"per_page":       "50",
+		}
+		for k, v := range want {
+			if got := q.Get(k); got != v {
+				t.Errorf("%s: got %q, want %q", k, got, v)
+			}
+		}
+		if q.Has("external_id") || q.Has("created_before") {
+			t.Errorf("unset params should be omitted, got %s", r.URL.RawQuery)
+		}
+		respondJSON(t, w, 200, monigo.ListCustomersResponse{
+			Customers: []monigo.Customer{sampleCustomer},
+			Count:     1,
+			Total:     51,
+		})
+	}))
+
+	resp, err := c.Customers.List(context.Background(), monigo.ListCustomersParams{
+		Query:          "acme",
+		Email:          "billing@acme.example",
+		CreatedAfter:   &after,
+		MetadataFilter: map[string]string{"tier": "enterprise"},
+		Page:           2,
+		PerPage:        50,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Total != 51 {
+		t.Errorf("expected total 51, got %d", resp.Total)
+	}
+}
+
 func TestCustomers_Get(t *testing.T) {
 	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertMethod(t, r, "GET")
@@ -119,6 +190,176 @@ func TestCustomers_Update(t *testing.T) {
 	}
 }
 
+func TestCustomers_Update_ClearsMetadata(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		decodeBody(t, r, &body)
+		if m, ok := body["metadata"].(map[string]any); !ok || len(m) != 0 {
+			t.Errorf("expected an empty metadata object, got %v", body["metadata"])
+		}
+		respondJSON(t, w, 200, map[string]any{"customer": sampleCustomer})
+	}))
+
+	if _, err := c.Customers.Update(context.Background(), "cust-abc", monigo.UpdateCustomerRequest{Metadata: &monigo.Metadata{}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCustomers_Upsert_Creates(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/customers")
+		respondJSON(t, w, 201, map[string]any{"customer": sampleCustomer})
+	}))
+
+	cust, err := c.Customers.Upsert(context.Background(), monigo.CreateCustomerRequest{ExternalID: "ext-1", Name: "Acme Corp"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cust.ID != sampleCustomer.ID {
+		t.Errorf("expected %s, got %s", sampleCustomer.ID, cust.ID)
+	}
+}
+
+func TestCustomers_Upsert_UpdatesExisting(t *testing.T) {
+	updated := sampleCustomer
+	updated.Email = "new@acme.example"
+
+	var calls []string
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case "POST":
+			respondJSON(t, w, 409, map[string]string{
+				"error": "a customer with this external_id already exists",
+				"code":  "duplicate_customer",
+			})
+		case "GET":
+			if r.URL.Query().Get("external_id") != "ext-1" {
+				t.Errorf("external_id: got %q", r.URL.Query().Get("external_id"))
+			}
+			respondJSON(t, w, 200, monigo.ListCustomersResponse{Customers: []monigo.Customer{sampleCustomer}, Count: 1})
+		case "PUT":
+			assertPath(t, r, "/v1/customers/cust-abc")
+			var body monigo.UpdateCustomerRequest
+			decodeBody(t, r, &body)
+			if body.Email != "new@acme.example" || body.Name != "Acme Corp" {
+				t.Errorf("unexpected update body: %+v", body)
+			}
+			respondJSON(t, w, 200, map[string]any{"customer": updated})
+		}
+	}))
+
+	cust, err := c.Customers.Upsert(context.Background(), monigo.CreateCustomerRequest{
+		ExternalID: "ext-1",
+		Name:       "Acme Corp",
+		Email:      "new@acme.example",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cust.Email != "new@acme.example" {
+		t.Errorf("expected updated email, got %q", cust.Email)
+	}
+	if len(calls) != 3 {
+		t.Errorf("expected create, lookup, update; got %v", calls)
+	}
+}
+
+func TestCustomers_Upsert_UpdateUsesDerivedIdempotencyKey(t *testing.T) {
+	keys := map[string]string{}
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys[r.Method] = r.Header.Get("Idempotency-Key")
+		switch r.Method {
+		case "POST":
+			respondJSON(t, w, 409, map[string]string{"error": "exists", "code": "duplicate_customer"})
+		case "GET":
+			respondJSON(t, w, 200, monigo.ListCustomersResponse{Customers: []monigo.Customer{sampleCustomer}, Count: 1})
+		case "PUT":
+			respondJSON(t, w, 200, map[string]any{"customer": sampleCustomer})
+		}
+	}))
+
+	_, err := c.Customers.Upsert(context.Background(), monigo.CreateCustomerRequest{ExternalID: "ext-1", Name: "Acme Corp"},
+		monigo.WithIdempotencyKey("provision-ext-1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keys["POST"] != "provision-ext-1" || keys["PUT"] != "provision-ext-1:update" {
+		t.Errorf("unexpected idempotency keys: %v", keys)
+	}
+}
+
+func TestCustomers_Upsert_DerivesKeyFromContextOptions(t *testing.T) {
+	keys := map[string]string{}
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys[r.Method] = r.Header.Get("Idempotency-Key")
+		switch r.Method {
+		case "POST":
+			respondJSON(t, w, 409, map[string]string{"error": "exists", "code": "duplicate_customer"})
+		case "GET":
+			respondJSON(t, w, 200, monigo.ListCustomersResponse{Customers: []monigo.Customer{sampleCustomer}, Count: 1})
+		case "PUT":
+			respondJSON(t, w, 200, map[string]any{"customer": sampleCustomer})
+		}
+	}))
+
+	ctx := monigo.ContextWithOptions(context.Background(), monigo.WithIdempotencyKey("provision-ext-1"))
+	if _, err := c.Customers.Upsert(ctx, monigo.CreateCustomerRequest{ExternalID: "ext-1", Name: "Acme Corp"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keys["POST"] != "provision-ext-1" || keys["PUT"] != "provision-ext-1:update" {
+		t.Errorf("unexpected idempotency keys: %v", keys)
+	}
+}
+
+func TestCustomers_Upsert_RequiresExternalID(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected")
+	}))
+
+	_, err := c.Customers.Upsert(context.Background(), monigo.CreateCustomerRequest{Name: "Acme"})
+	if !errors.Is(err, monigo.ErrInvalidRequest) {
+		t.Errorf("expected ErrInvalidRequest, got %v", err)
+	}
+}
+
+func TestCustomers_ListChildren(t *testing.T) {
+	child := sampleCustomer
+	child.ID = "cust-child"
+	child.ParentCustomerID = "cust-abc"
+
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/customers/cust-abc/children")
+		respondJSON(t, w, 200, monigo.ListCustomersResponse{Customers: []monigo.Customer{child}, Count: 1})
+	}))
+
+	resp, err := c.Customers.ListChildren(context.Background(), "cust-abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Count != 1 || resp.Customers[0].ParentCustomerID != "cust-abc" {
+		t.Errorf("unexpected children: %+v", resp.Customers)
+	}
+}
+
+func TestCustomers_Update_DetachParent(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		decodeBody(t, r, &body)
+		if v, ok := body["parent_customer_id"]; !ok || v != "" {
+			t.Errorf("expected parent_customer_id to be sent empty, got %v (present=%v)", v, ok)
+		}
+		respondJSON(t, w, 200, map[string]any{"customer": sampleCustomer})
+	}))
+
+	none := ""
+	if _, err := c.Customers.Update(context.Background(), "cust-child", monigo.UpdateCustomerRequest{ParentCustomerID: &none}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestCustomers_Delete(t *testing.T) {
 	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertMethod(t, r, "DELETE")
@@ -140,3 +381,165 @@ func TestCustomers_Delete_NotFound(t *testing.T) {
 		t.Errorf("expected IsNotFound=true; err=%v", err)
 	}
 }
+
+func TestCustomers_Restore(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/customers/cust-abc/restore")
+		respondJSON(t, w, 200, map[string]any{"customer": sampleCustomer})
+	}))
+
+	got, err := c.Customers.Restore(context.Background(), sampleCustomer.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.ID != sampleCustomer.ID {
+		t.Errorf("expected %s, got %s", sampleCustomer.ID, got.ID)
+	}
+}
+
+func TestCustomers_GetBillingSettings(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/customers/cust-abc/billing-settings")
+		respondJSON(t, w, 200, map[string]any{"billing_settings": monigo.BillingSettings{
+			CustomerID:      "cust-abc",
+			DeliveryChannel: monigo.InvoiceDeliveryEmail,
+		}})
+	}))
+
+	bs, err := c.Customers.GetBillingSettings(context.Background(), "cust-abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bs.DeliveryChannel != monigo.InvoiceDeliveryEmail {
+		t.Errorf("expected email delivery, got %s", bs.DeliveryChannel)
+	}
+}
+
+func TestCustomers_UpdateBillingSettings(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "PUT")
+		assertPath(t, r, "/v1/customers/cust-abc/billing-settings")
+
+		var req monigo.UpdateBillingSettingsRequest
+		decodeBody(t, r, &req)
+		if req.ConsolidateSubscriptions == nil || !*req.ConsolidateSubscriptions {
+			t.Error("expected consolidate_subscriptions=true")
+		}
+		if req.InvoiceDayOfMonth == nil || *req.InvoiceDayOfMonth != 1 {
+			t.Errorf("invoice_day_of_month: got %v, want 1", req.InvoiceDayOfMonth)
+		}
+		if req.CCEmails == nil || len(*req.CCEmails) != 1 {
+			t.Errorf("expected 1 cc email, got %v", req.CCEmails)
+		}
+		respondJSON(t, w, 200, map[string]any{"billing_settings": monigo.BillingSettings{
+			CustomerID:               "cust-abc",
+			ConsolidateSubscriptions: true,
+			InvoiceDayOfMonth:        1,
+			DeliveryChannel:          monigo.InvoiceDeliveryEmail,
+			CCEmails:                 *req.CCEmails,
+		}})
+	}))
+
+	consolidate := true
+	day := 1
+	cc := []string{"ap@acme.example"}
+	bs, err := c.Customers.UpdateBillingSettings(context.Background(), "cust-abc", monigo.UpdateBillingSettingsRequest{
+		ConsolidateSubscriptions: &consolidate,
+		InvoiceDayOfMonth:        &day,
+		CCEmails:                 &cc,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bs.ConsolidateSubscriptions || bs.InvoiceDayOfMonth != 1 {
+		t.Errorf("unexpected settings: %+v", bs)
+	}
+}
+
+func TestCustomers_UpdateBillingSettings_ClearsCCEmails(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		decodeBody(t, r, &body)
+		if cc, ok := body["cc_emails"].([]any); !ok || len(cc) != 0 {
+			t.Errorf("expected an empty cc_emails list, got %v", body["cc_emails"])
+		}
+		respondJSON(t, w, 200, map[string]any{"billing_settings": monigo.BillingSettings{CustomerID: "cust-abc"}})
+	}))
+
+	if _, err := c.Customers.UpdateBillingSettings(context.Background(), "cust-abc", monigo.UpdateBillingSettingsRequest{CCEmails: &[]string{}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCustomers_Archive(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/customers/cust-abc/archive")
+		cust := sampleCustomer
+		now := time.Now()
+		cust.Status = monigo.CustomerStatusArchived
+		cust.ArchivedAt = &now
+		respondJSON(t, w, 200, map[string]any{"customer": cust})
+	}))
+
+	cust, err := c.Customers.Archive(context.Background(), "cust-abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cust.Status != monigo.CustomerStatusArchived || cust.ArchivedAt == nil {
+		t.Errorf("expected archived customer, got status=%q archived_at=%v", cust.Status, cust.ArchivedAt)
+	}
+}
+
+func TestCustomers_Unarchive(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/customers/cust-abc/unarchive")
+		cust := sampleCustomer
+		cust.Status = monigo.CustomerStatusActive
+		respondJSON(t, w, 200, map[string]any{"customer": cust})
+	}))
+
+	cust, err := c.Customers.Unarchive(context.Background(), "cust-abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cust.Status != monigo.CustomerStatusActive {
+		t.Errorf("status: got %q, want active", cust.Status)
+	}
+}
+
+func TestCustomers_ListIncludeArchived(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("include_archived"); got != "true" {
+			t.Errorf("include_archived: got %q, want true", got)
+		}
+		respondJSON(t, w, 200, monigo.ListCustomersResponse{})
+	}))
+
+	if _, err := c.Customers.List(context.Background(), monigo.ListCustomersParams{IncludeArchived: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCustomers_GetByExternalID(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/customers")
+		q := r.URL.Query()
+		if q.Get("external_id") != "ext-1" || q.Get("include_archived") != "true" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		respondJSON(t, w, 200, monigo.ListCustomersResponse{Customers: []monigo.Customer{sampleCustomer}, Count: 1})
+	}))
+
+	cust, err := c.Customers.GetByExternalID(context.Background(), "ext-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cust.ID != sampleCustomer.ID {
+		t.Errorf("expected %s, got %s", sampleCustomer.ID, cust.ID)
+	}
+}
@@ -0,0 +1,128 @@
+package monigo
+
+import (
+	"context"
+	"net/url"
+)
+
+// DisputeService manages customer disputes raised against invoices,
+// including their comment threads and resolution.
+type DisputeService struct {
+	client *Client
+}
+
+// Open raises a dispute against an invoice on the customer's behalf.
+func (s *DisputeService) Open(ctx context.Context, invoiceID, reason string, opts ...RequestOption) (*Dispute, error) {
+	var wrapper struct {
+		Dispute Dispute `json:"dispute"`
+	}
+	body := OpenDisputeRequest{Reason: reason}
+	path, err := pathf("/v1/invoices/%s/disputes", invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, body, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Dispute, nil
+}
+
+// List returns disputes, optionally filtered by status, invoice, or customer.
+func (s *DisputeService) List(ctx context.Context, params ListDisputesParams) (*ListDisputesResponse, error) {
+	q := url.Values{}
+	if params.Status != "" {
+		q.Set("status", params.Status)
+	}
+	if params.InvoiceID != "" {
+		q.Set("invoice_id", params.InvoiceID)
+	}
+	if params.CustomerID != "" {
+		q.Set("customer_id", params.CustomerID)
+	}
+
+	path := "/v1/disputes"
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	var out ListDisputesResponse
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Get fetches a single dispute by its UUID.
+func (s *DisputeService) Get(ctx context.Context, disputeID string) (*Dispute, error) {
+	var wrapper struct {
+		Dispute Dispute `json:"dispute"`
+	}
+	path, err := pathf("/v1/disputes/%s", disputeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Dispute, nil
+}
+
+// AddComment appends a comment to the dispute's thread.
+func (s *DisputeService) AddComment(ctx context.Context, disputeID string, req AddDisputeCommentRequest, opts ...RequestOption) (*DisputeComment, error) {
+	var wrapper struct {
+		Comment DisputeComment `json:"comment"`
+	}
+	path, err := pathf("/v1/disputes/%s/comments", disputeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Comment, nil
+}
+
+// ListComments returns the dispute's comment thread, oldest first.
+func (s *DisputeService) ListComments(ctx context.Context, disputeID string) (*ListDisputeCommentsResponse, error) {
+	var out ListDisputeCommentsResponse
+	path, err := pathf("/v1/disputes/%s/comments", disputeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Adjust resolves an open dispute in the customer's favour, reducing the
+// invoice by req.Amount. The dispute moves to "adjusted".
+func (s *DisputeService) Adjust(ctx context.Context, disputeID string, req AdjustDisputeRequest, opts ...RequestOption) (*Dispute, error) {
+	var wrapper struct {
+		Dispute Dispute `json:"dispute"`
+	}
+	path, err := pathf("/v1/disputes/%s/adjust", disputeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Dispute, nil
+}
+
+// Uphold resolves an open dispute with the invoice standing as issued.
+// The dispute moves to "upheld".
+func (s *DisputeService) Uphold(ctx context.Context, disputeID string, req UpholdDisputeRequest, opts ...RequestOption) (*Dispute, error) {
+	var wrapper struct {
+		Dispute Dispute `json:"dispute"`
+	}
+	path, err := pathf("/v1/disputes/%s/uphold", disputeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Dispute, nil
+}
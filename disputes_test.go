@@ -0,0 +1,167 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+var sampleDispute = monigo.Dispute{
+	ID:         "disp-1",
+	OrgID:      "org-1",
+	InvoiceID:  "inv-1",
+	CustomerID: "cust-abc",
+	Status:     monigo.DisputeStatusOpen,
+	Reason:     "charged twice",
+	CreatedAt:  time.Now(),
+	UpdatedAt:  time.Now(),
+}
+
+func TestDisputes_Open(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/invoices/inv-1/disputes")
+		assertBearerToken(t, r)
+
+		var req monigo.OpenDisputeRequest
+		decodeBody(t, r, &req)
+		if req.Reason != "charged twice" {
+			t.Errorf("reason: got %q", req.Reason)
+		}
+		respondJSON(t, w, 201, map[string]any{"dispute": sampleDispute})
+	}))
+
+	d, err := c.Disputes.Open(context.Background(), "inv-1", "charged twice")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.ID != "disp-1" || d.Status != monigo.DisputeStatusOpen {
+		t.Errorf("unexpected dispute: %+v", d)
+	}
+}
+
+func TestDisputes_List(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/disputes")
+		if got := r.URL.Query().Get("status"); got != "open" {
+			t.Errorf("status: got %q, want open", got)
+		}
+		if got := r.URL.Query().Get("customer_id"); got != "cust-abc" {
+			t.Errorf("customer_id: got %q, want cust-abc", got)
+		}
+		respondJSON(t, w, 200, monigo.ListDisputesResponse{Disputes: []monigo.Dispute{sampleDispute}, Count: 1})
+	}))
+
+	resp, err := c.Disputes.List(context.Background(), monigo.ListDisputesParams{
+		Status:     monigo.DisputeStatusOpen,
+		CustomerID: "cust-abc",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Count != 1 {
+		t.Errorf("expected 1 dispute, got %d", resp.Count)
+	}
+}
+
+func TestDisputes_Get(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/disputes/disp-1")
+		respondJSON(t, w, 200, map[string]any{"dispute": sampleDispute})
+	}))
+
+	d, err := c.Disputes.Get(context.Background(), "disp-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.InvoiceID != "inv-1" {
+		t.Errorf("invoice_id: got %q", d.InvoiceID)
+	}
+}
+
+func TestDisputes_Comments(t *testing.T) {
+	comment := monigo.DisputeComment{ID: "cmt-1", DisputeID: "disp-1", Author: "ops@example.com", Body: "looking into it"}
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertPath(t, r, "/v1/disputes/disp-1/comments")
+		switch r.Method {
+		case "POST":
+			var req monigo.AddDisputeCommentRequest
+			decodeBody(t, r, &req)
+			if req.Body != "looking into it" {
+				t.Errorf("body: got %q", req.Body)
+			}
+			respondJSON(t, w, 201, map[string]any{"comment": comment})
+		case "GET":
+			respondJSON(t, w, 200, monigo.ListDisputeCommentsResponse{Comments: []monigo.DisputeComment{comment}, Count: 1})
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	}))
+
+	got, err := c.Disputes.AddComment(context.Background(), "disp-1", monigo.AddDisputeCommentRequest{
+		Author: "ops@example.com",
+		Body:   "looking into it",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.ID != "cmt-1" {
+		t.Errorf("expected cmt-1, got %s", got.ID)
+	}
+
+	thread, err := c.Disputes.ListComments(context.Background(), "disp-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if thread.Count != 1 {
+		t.Errorf("expected 1 comment, got %d", thread.Count)
+	}
+}
+
+func TestDisputes_Adjust(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/disputes/disp-1/adjust")
+
+		var req monigo.AdjustDisputeRequest
+		decodeBody(t, r, &req)
+		if req.Amount != "1500.00" {
+			t.Errorf("amount: got %q", req.Amount)
+		}
+		d := sampleDispute
+		d.Status = monigo.DisputeStatusAdjusted
+		d.AdjustmentAmount = req.Amount
+		respondJSON(t, w, 200, map[string]any{"dispute": d})
+	}))
+
+	d, err := c.Disputes.Adjust(context.Background(), "disp-1", monigo.AdjustDisputeRequest{Amount: "1500.00"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.Status != monigo.DisputeStatusAdjusted || d.AdjustmentAmount != "1500.00" {
+		t.Errorf("unexpected dispute: %+v", d)
+	}
+}
+
+func TestDisputes_Uphold(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/disputes/disp-1/uphold")
+		d := sampleDispute
+		d.Status = monigo.DisputeStatusUpheld
+		respondJSON(t, w, 200, map[string]any{"dispute": d})
+	}))
+
+	d, err := c.Disputes.Uphold(context.Background(), "disp-1", monigo.UpholdDisputeRequest{Note: "usage verified"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.Status != monigo.DisputeStatusUpheld {
+		t.Errorf("status: got %q, want upheld", d.Status)
+	}
+}
@@ -0,0 +1,58 @@
+package monigo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// DunningService configures how overdue invoices are chased: reminder
+// emails, automatic charge retries, and what happens when both run out.
+// Invoices report their progress in Invoice.DunningStatus.
+type DunningService struct {
+	client *Client
+}
+
+// GetPolicy returns the organisation's dunning policy.
+func (s *DunningService) GetPolicy(ctx context.Context) (*DunningPolicy, error) {
+	var wrapper struct {
+		Policy DunningPolicy `json:"policy"`
+	}
+	if err := s.client.do(ctx, "GET", "/v1/dunning/policy", nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Policy, nil
+}
+
+// UpdatePolicy changes the organisation's dunning policy. Invoices already
+// in dunning follow the new schedule from their next step.
+func (s *DunningService) UpdatePolicy(ctx context.Context, req UpdateDunningPolicyRequest, opts ...RequestOption) (*DunningPolicy, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	var wrapper struct {
+		Policy DunningPolicy `json:"policy"`
+	}
+	if err := s.client.do(ctx, "PUT", "/v1/dunning/policy", req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Policy, nil
+}
+
+// ParseDunningWebhook verifies and decodes the body of a dunning webhook
+// request. signature is the request's WebhookSignatureHeader header and
+// secret the endpoint's signing secret; see VerifyWebhook.
+func ParseDunningWebhook(body []byte, signature, secret string) (*DunningWebhookPayload, error) {
+	if err := VerifyWebhook(body, signature, secret); err != nil {
+		return nil, err
+	}
+	var p DunningWebhookPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, fmt.Errorf("monigo: decode dunning webhook: %w", err)
+	}
+	if !strings.HasPrefix(string(p.Type), "dunning.") {
+		return nil, fmt.Errorf("monigo: unexpected webhook type %q", p.Type)
+	}
+	return &p, nil
+}
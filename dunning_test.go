@@ -0,0 +1,128 @@
+package monigo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestDunning_GetPolicy(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/dunning/policy")
+		respondJSON(t, w, 200, map[string]any{"policy": monigo.DunningPolicy{
+			Enabled:         true,
+			ReminderDays:    []int{-3, 1, 7},
+			RetryDays:       []int{1, 3, 7},
+			ExhaustedAction: monigo.DunningExhaustedPause,
+		}})
+	}))
+
+	p, err := c.Dunning.GetPolicy(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(p.ReminderDays) != 3 || p.ReminderDays[0] != -3 {
+		t.Errorf("unexpected reminder days: %v", p.ReminderDays)
+	}
+}
+
+func TestDunning_UpdatePolicy(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "PUT")
+		assertPath(t, r, "/v1/dunning/policy")
+
+		var body map[string]any
+		decodeBody(t, r, &body)
+		if body["max_retry_attempts"] != float64(2) || body["escalation_webhook_url"] != "https://example.com/hooks/dunning" {
+			t.Errorf("unexpected body: %v", body)
+		}
+		if _, ok := body["reminder_days"]; ok {
+			t.Error("reminder_days should be left unchanged")
+		}
+		respondJSON(t, w, 200, map[string]any{"policy": monigo.DunningPolicy{MaxRetryAttempts: 2}})
+	}))
+
+	attempts := 2
+	hook := "https://example.com/hooks/dunning"
+	p, err := c.Dunning.UpdatePolicy(context.Background(), monigo.UpdateDunningPolicyRequest{
+		RetryDays:            &[]int{1, 3},
+		MaxRetryAttempts:     &attempts,
+		EscalationWebhookURL: &hook,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.MaxRetryAttempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", p.MaxRetryAttempts)
+	}
+}
+
+func TestDunning_UpdatePolicy_Invalid(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected")
+	}))
+
+	_, err := c.Dunning.UpdatePolicy(context.Background(), monigo.UpdateDunningPolicyRequest{
+		RetryDays:       &[]int{7, -1},
+		ExhaustedAction: "delete_customer",
+	})
+	var verr *monigo.RequestValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected RequestValidationError, got %v", err)
+	}
+	for _, field := range []string{"retry_days", "retry_days[1]", "exhausted_action"} {
+		if _, ok := verr.Fields[field]; !ok {
+			t.Errorf("expected an error for %s, got %v", field, verr.Fields)
+		}
+	}
+}
+
+func TestDunning_UpdatePolicy_ClearsReminders(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		decodeBody(t, r, &body)
+		if days, ok := body["reminder_days"].([]any); !ok || len(days) != 0 {
+			t.Errorf("expected an empty reminder_days list, got %v", body["reminder_days"])
+		}
+		if _, ok := body["retry_days"]; ok {
+			t.Error("retry_days should be left unchanged")
+		}
+		respondJSON(t, w, 200, map[string]any{"policy": monigo.DunningPolicy{}})
+	}))
+
+	if _, err := c.Dunning.UpdatePolicy(context.Background(), monigo.UpdateDunningPolicyRequest{ReminderDays: &[]int{}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestParseDunningWebhook(t *testing.T) {
+	body := []byte(`{
+		"type": "dunning.retry_failed",
+		"invoice_id": "inv-1",
+		"customer_id": "cust-abc",
+		"attempt": 2,
+		"amount_due": "15000.00",
+		"currency": "NGN",
+		"failure_reason": "insufficient_funds",
+		"next_attempt_at": "2026-03-27T09:00:00Z",
+		"occurred_at": "2026-03-20T09:00:00Z"
+	}`)
+
+	p, err := monigo.ParseDunningWebhook(body, monigo.SignWebhook(body, "whsec_test", time.Now()), "whsec_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.Type != monigo.DunningWebhookRetryFailed || p.Attempt != 2 || p.NextAttemptAt == nil {
+		t.Errorf("unexpected payload: %+v", p)
+	}
+
+	other := []byte(`{"type":"alert.triggered"}`)
+	if _, err := monigo.ParseDunningWebhook(other, monigo.SignWebhook(other, "whsec_test", time.Now()), "whsec_test"); err == nil {
+		t.Error("expected error for non-dunning webhook type")
+	}
+}
@@ -0,0 +1,279 @@
+// Package e2e runs end-to-end billing scenarios against a Monigo test-mode
+// organisation. Each scenario provisions a metric, plan, customer, and
+// subscription, ingests a known set of events, generates an invoice, and
+// checks that the invoice subtotal matches the expected amount.
+//
+// Use it before a rollout to confirm Monigo prices your usage the way you
+// expect:
+//
+//	client := monigo.New(os.Getenv("MONIGO_TEST_API_KEY"))
+//	r := e2e.NewRunner(client)
+//	for _, sc := range e2e.DefaultScenarios() {
+//		res, err := r.Run(ctx, sc)
+//		...
+//	}
+//
+// The runner refuses to run with a live API key.
+package e2e
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+// ErrLiveKey is returned by Runner.Run when the client's API key is not a
+// test-mode key.
+var ErrLiveKey = errors.New("e2e: refusing to run against a live-mode API key")
+
+// Scenario describes one end-to-end billing check.
+type Scenario struct {
+	// Name identifies the scenario in results and resource names.
+	Name string
+	// Price is the single price attached to the scenario's plan. MetricID is
+	// filled in by the runner.
+	Price monigo.CreatePriceRequest
+	// Quantities are ingested as one event each, summed by the metric.
+	Quantities []float64
+	// ExpectedSubtotal is the invoice subtotal (before tax, discounts, and
+	// credits) the scenario must produce, as a decimal string.
+	ExpectedSubtotal string
+	// Currency is the plan currency. Defaults to "NGN".
+	Currency string
+}
+
+// Result records what a scenario created and what it observed.
+type Result struct {
+	Scenario       string
+	MetricID       string
+	PlanID         string
+	CustomerID     string
+	SubscriptionID string
+	// Invoice is the generated draft invoice.
+	Invoice *monigo.Invoice
+	// Duration is how long the scenario took end to end.
+	Duration time.Duration
+}
+
+// MismatchError is returned when an invoice total differs from the
+// scenario's expectation.
+type MismatchError struct {
+	Scenario string
+	Want     string
+	Got      string
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf("e2e: scenario %q: invoice subtotal %s, want %s", e.Scenario, e.Got, e.Want)
+}
+
+// Runner executes scenarios with a Monigo client.
+type Runner struct {
+	client *monigo.Client
+
+	// Prefix is prepended to the names and external IDs of every resource the
+	// runner creates. Defaults to "e2e".
+	Prefix string
+	// SettleTimeout bounds how long the runner waits for ingested usage to
+	// appear in the invoice preview before generating. Defaults to one minute.
+	SettleTimeout time.Duration
+	// PollInterval is the delay between preview polls. Defaults to two seconds.
+	PollInterval time.Duration
+	// KeepResources leaves the created customer, subscription, plan, and
+	// metric in place for inspection instead of deleting them.
+	KeepResources bool
+	// Logf, if set, receives progress messages.
+	Logf func(format string, args ...any)
+}
+
+// NewRunner returns a Runner using client, which must be configured with a
+// test-mode API key.
+func NewRunner(client *monigo.Client) *Runner {
+	return &Runner{
+		client:        client,
+		Prefix:        "e2e",
+		SettleTimeout: time.Minute,
+		PollInterval:  2 * time.Second,
+	}
+}
+
+// Run provisions the scenario's catalog, ingests its events, generates an
+// invoice, and compares the subtotal with sc.ExpectedSubtotal. A
+// *MismatchError is returned, together with the Result, when they differ.
+func (r *Runner) Run(ctx context.Context, sc Scenario) (*Result, error) {
+	caps, err := r.client.Capabilities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("e2e: check API key: %w", err)
+	}
+	if !caps.IsTest {
+		return nil, ErrLiveKey
+	}
+	want, ok := new(big.Rat).SetString(sc.ExpectedSubtotal)
+	if !ok {
+		return nil, fmt.Errorf("e2e: scenario %q: invalid ExpectedSubtotal %q", sc.Name, sc.ExpectedSubtotal)
+	}
+
+	start := time.Now()
+	res := &Result{Scenario: sc.Name}
+	defer func() {
+		res.Duration = time.Since(start)
+		if !r.KeepResources {
+			r.cleanup(context.WithoutCancel(ctx), res)
+		}
+	}()
+
+	run := r.Prefix + "-" + sc.Name + "-" + randomSuffix()
+	eventName := run + ".units"
+
+	r.logf("%s: provisioning catalog", sc.Name)
+	metric, err := r.client.Metrics.Create(ctx, monigo.CreateMetricRequest{
+		Name:                run,
+		EventName:           eventName,
+		Aggregation:         monigo.AggregationSum,
+		AggregationProperty: "units",
+	})
+	if err != nil {
+		return res, fmt.Errorf("e2e: create metric: %w", err)
+	}
+	res.MetricID = metric.ID
+
+	price := sc.Price
+	price.MetricID = metric.ID
+	currency := sc.Currency
+	if currency == "" {
+		currency = "NGN"
+	}
+	plan, err := r.client.Plans.Create(ctx, monigo.CreatePlanRequest{
+		Name:          run,
+		Currency:      currency,
+		PlanType:      monigo.PlanTypeCollection,
+		BillingPeriod: monigo.BillingPeriodMonthly,
+		Prices:        []monigo.CreatePriceRequest{price},
+	})
+	if err != nil {
+		return res, fmt.Errorf("e2e: create plan: %w", err)
+	}
+	res.PlanID = plan.ID
+
+	customer, err := r.client.Customers.Create(ctx, monigo.CreateCustomerRequest{
+		ExternalID: run,
+		Name:       run,
+	})
+	if err != nil {
+		return res, fmt.Errorf("e2e: create customer: %w", err)
+	}
+	res.CustomerID = customer.ID
+
+	sub, err := r.client.Subscriptions.Create(ctx, monigo.CreateSubscriptionRequest{
+		CustomerID: customer.ID,
+		PlanID:     plan.ID,
+	})
+	if err != nil {
+		return res, fmt.Errorf("e2e: create subscription: %w", err)
+	}
+	res.SubscriptionID = sub.ID
+
+	r.logf("%s: ingesting %d events", sc.Name, len(sc.Quantities))
+	events := make([]monigo.IngestEvent, len(sc.Quantities))
+	now := time.Now().UTC()
+	for i, q := range sc.Quantities {
+		events[i] = monigo.IngestEvent{
+			EventName:      eventName,
+			CustomerID:     customer.ID,
+			IdempotencyKey: fmt.Sprintf("%s-%d", run, i),
+			Timestamp:      now,
+			Properties:     map[string]any{"units": q},
+		}
+	}
+	if len(events) > 0 {
+		if _, err := r.client.Events.Ingest(ctx, monigo.IngestRequest{Events: events}); err != nil {
+			return res, fmt.Errorf("e2e: ingest: %w", err)
+		}
+	}
+
+	r.logf("%s: waiting for usage to settle", sc.Name)
+	if err := r.waitForSubtotal(ctx, sub.ID, want); err != nil {
+		return res, err
+	}
+
+	inv, err := r.client.Invoices.Generate(ctx, sub.ID)
+	if err != nil {
+		return res, fmt.Errorf("e2e: generate invoice: %w", err)
+	}
+	res.Invoice = inv
+
+	got, ok := new(big.Rat).SetString(inv.Subtotal)
+	if !ok || got.Cmp(want) != 0 {
+		return res, &MismatchError{Scenario: sc.Name, Want: sc.ExpectedSubtotal, Got: inv.Subtotal}
+	}
+	r.logf("%s: ok, subtotal %s %s", sc.Name, inv.Subtotal, inv.Currency)
+	return res, nil
+}
+
+// waitForSubtotal polls the invoice preview until its subtotal reaches want
+// or SettleTimeout elapses. Timing out is not an error: the generated invoice
+// is what gets asserted.
+func (r *Runner) waitForSubtotal(ctx context.Context, subscriptionID string, want *big.Rat) error {
+	deadline := time.Now().Add(r.SettleTimeout)
+	for {
+		preview, err := r.client.Invoices.Preview(ctx, subscriptionID, time.Time{})
+		if err != nil {
+			return fmt.Errorf("e2e: preview invoice: %w", err)
+		}
+		if got, ok := new(big.Rat).SetString(preview.Subtotal); ok && got.Cmp(want) == 0 {
+			return nil
+		}
+		if !time.Now().Before(deadline) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.PollInterval):
+		}
+	}
+}
+
+// cleanup deletes whatever Run created, most dependent first. Errors are
+// logged and otherwise ignored.
+func (r *Runner) cleanup(ctx context.Context, res *Result) {
+	steps := []struct {
+		id  string
+		del func(context.Context, string) error
+	}{
+		{res.SubscriptionID, r.client.Subscriptions.Delete},
+		{res.CustomerID, r.client.Customers.Delete},
+		{res.PlanID, r.client.Plans.Delete},
+		{res.MetricID, r.client.Metrics.Delete},
+	}
+	for _, s := range steps {
+		if s.id == "" {
+			continue
+		}
+		if err := s.del(ctx, s.id); err != nil {
+			r.logf("%s: cleanup %s: %v", res.Scenario, s.id, err)
+		}
+	}
+}
+
+func (r *Runner) logf(format string, args ...any) {
+	if r.Logf != nil {
+		r.Logf(format, args...)
+	}
+}
+
+// randomSuffix returns a short random hex string so concurrent runs do not
+// collide on names or external IDs.
+func randomSuffix() string {
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic("e2e: crypto/rand unavailable: " + err.Error())
+	}
+	return hex.EncodeToString(b[:])
+}
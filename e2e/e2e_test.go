@@ -0,0 +1,178 @@
+package e2e_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+	"github.com/monigo-africa/go-monigo/e2e"
+)
+
+// fakeMonigo is a minimal in-memory API that bills subtotal for every
+// generated invoice and records deletions.
+type fakeMonigo struct {
+	isTest   bool
+	subtotal string
+
+	mu      sync.Mutex
+	deleted []string
+	events  int
+}
+
+func (f *fakeMonigo) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	respond := func(status int, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(v)
+	}
+	if r.Method == "DELETE" {
+		f.mu.Lock()
+		f.deleted = append(f.deleted, r.URL.Path)
+		f.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	switch r.URL.Path {
+	case "/v1/capabilities":
+		respond(200, map[string]any{"capabilities": monigo.Capabilities{
+			Scopes: []string{monigo.ScopeWrite},
+			IsTest: f.isTest,
+		}})
+	case "/v1/metrics":
+		respond(201, map[string]any{"metric": monigo.Metric{ID: "metric-1"}})
+	case "/v1/plans":
+		var req monigo.CreatePlanRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if len(req.Prices) != 1 || req.Prices[0].MetricID != "metric-1" {
+			respond(400, map[string]string{"error": "price must reference the metric"})
+			return
+		}
+		respond(201, map[string]any{"plan": monigo.Plan{ID: "plan-1"}})
+	case "/v1/customers":
+		respond(201, map[string]any{"customer": monigo.Customer{ID: "cust-1"}})
+	case "/v1/subscriptions":
+		respond(201, map[string]any{"subscription": monigo.Subscription{ID: "sub-1"}})
+	case "/v1/ingest":
+		var req monigo.IngestRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		f.mu.Lock()
+		f.events += len(req.Events)
+		f.mu.Unlock()
+		respond(202, monigo.IngestResponse{})
+	case "/v1/invoices/preview", "/v1/invoices/generate":
+		respond(200, map[string]any{"invoice": monigo.Invoice{ID: "inv-1", Subtotal: f.subtotal, Currency: "NGN"}})
+	default:
+		respond(404, map[string]string{"error": "not found: " + r.URL.Path})
+	}
+}
+
+func newRunner(t *testing.T, f *fakeMonigo) *e2e.Runner {
+	t.Helper()
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	r := e2e.NewRunner(monigo.New("sk_test_abc", monigo.WithBaseURL(srv.URL)))
+	r.SettleTimeout = 0
+	r.PollInterval = time.Millisecond
+	return r
+}
+
+func TestRunner_Pass(t *testing.T) {
+	f := &fakeMonigo{isTest: true, subtotal: "120.000000"}
+	r := newRunner(t, f)
+
+	sc := e2e.DefaultScenarios()[0]
+	res, err := r.Run(context.Background(), sc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Invoice == nil || res.SubscriptionID != "sub-1" {
+		t.Errorf("unexpected result: %+v", res)
+	}
+	if f.events != len(sc.Quantities) {
+		t.Errorf("expected %d events ingested, got %d", len(sc.Quantities), f.events)
+	}
+	want := []string{"/v1/subscriptions/sub-1", "/v1/customers/cust-1", "/v1/plans/plan-1", "/v1/metrics/metric-1"}
+	if strings.Join(f.deleted, ",") != strings.Join(want, ",") {
+		t.Errorf("cleanup order: got %v, want %v", f.deleted, want)
+	}
+}
+
+func TestRunner_Mismatch(t *testing.T) {
+	r := newRunner(t, &fakeMonigo{isTest: true, subtotal: "100.00"})
+
+	_, err := r.Run(context.Background(), e2e.DefaultScenarios()[0])
+	var mismatch *e2e.MismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("expected MismatchError, got %v", err)
+	}
+	if mismatch.Got != "100.00" || mismatch.Want != "120" {
+		t.Errorf("unexpected mismatch: %+v", mismatch)
+	}
+}
+
+func TestRunner_KeepResources(t *testing.T) {
+	f := &fakeMonigo{isTest: true, subtotal: "120"}
+	r := newRunner(t, f)
+	r.KeepResources = true
+
+	if _, err := r.Run(context.Background(), e2e.DefaultScenarios()[0]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(f.deleted) != 0 {
+		t.Errorf("expected no deletions, got %v", f.deleted)
+	}
+}
+
+func TestRunner_RefusesLiveKey(t *testing.T) {
+	r := newRunner(t, &fakeMonigo{isTest: false})
+
+	if _, err := r.Run(context.Background(), e2e.DefaultScenarios()[0]); !errors.Is(err, e2e.ErrLiveKey) {
+		t.Errorf("expected ErrLiveKey, got %v", err)
+	}
+}
+
+func TestDefaultScenarios(t *testing.T) {
+	models := map[monigo.PricingModel]bool{}
+	for _, sc := range e2e.DefaultScenarios() {
+		models[sc.Price.Model] = true
+		if sc.ExpectedSubtotal == "" || len(sc.Quantities) == 0 {
+			t.Errorf("scenario %s is incomplete", sc.Name)
+		}
+	}
+	for _, m := range []monigo.PricingModel{monigo.PricingModelFlat, monigo.PricingModelTiered, monigo.PricingModelVolume, monigo.PricingModelWeightedTiered, monigo.PricingModelPackage, monigo.PricingModelOverage} {
+		if !models[m] {
+			t.Errorf("no default scenario for %s", m)
+		}
+	}
+}
+
+// TestDefaultScenarios_Live runs every default scenario against a real
+// test-mode organisation when MONIGO_E2E_API_KEY is set.
+func TestDefaultScenarios_Live(t *testing.T) {
+	key := os.Getenv("MONIGO_E2E_API_KEY")
+	if key == "" {
+		t.Skip("MONIGO_E2E_API_KEY not set")
+	}
+	var opts []monigo.Option
+	if u := os.Getenv("MONIGO_E2E_BASE_URL"); u != "" {
+		opts = append(opts, monigo.WithBaseURL(u))
+	}
+	r := e2e.NewRunner(monigo.New(key, opts...))
+	r.Logf = t.Logf
+
+	for _, sc := range e2e.DefaultScenarios() {
+		t.Run(sc.Name, func(t *testing.T) {
+			if _, err := r.Run(context.Background(), sc); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
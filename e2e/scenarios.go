@@ -0,0 +1,97 @@
+package e2e
+
+import (
+	"encoding/json"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+// DefaultScenarios returns one scenario per pricing model, each ingesting
+// 10 + 20 + 30 = 60 units:
+//
+//	flat             60 × 2.00                          = 120.00
+//	tiered           50 × 1.00 + 10 × 0.50              =  55.00
+//	volume           60 × 0.50                          =  30.00
+//	weighted_tiered  as tiered, one line at 0.916667    =  55.00
+//	package          ceil(60 / 25) = 3 bundles × 100.00 = 300.00
+//	overage          20.00 base + (60 − 50) × 1.50      =  35.00
+func DefaultScenarios() []Scenario {
+	quantities := []float64{10, 20, 30}
+	tiers := []monigo.PriceTier{
+		{UpTo: ptr(int64(50)), UnitAmount: "1.000000"},
+		{UpTo: nil, UnitAmount: "0.500000"},
+	}
+	return []Scenario{
+		{
+			Name:             "flat",
+			Price:            monigo.CreatePriceRequest{Model: monigo.PricingModelFlat, UnitPrice: "2.000000"},
+			Quantities:       quantities,
+			ExpectedSubtotal: "120",
+		},
+		{
+			Name: "tiered",
+			Price: monigo.CreatePriceRequest{
+				Model: monigo.PricingModelTiered,
+				Tiers: mustMarshal(tiers),
+			},
+			Quantities:       quantities,
+			ExpectedSubtotal: "55",
+		},
+		{
+			Name: "volume",
+			Price: monigo.CreatePriceRequest{
+				Model: monigo.PricingModelVolume,
+				Tiers: mustMarshal(monigo.VolumeConfig(tiers)),
+			},
+			Quantities:       quantities,
+			ExpectedSubtotal: "30",
+		},
+		{
+			Name: "weighted_tiered",
+			Price: monigo.CreatePriceRequest{
+				Model: monigo.PricingModelWeightedTiered,
+				Tiers: mustMarshal(monigo.WeightedTieredConfig(tiers)),
+			},
+			Quantities:       quantities,
+			ExpectedSubtotal: "55",
+		},
+		{
+			Name: "package",
+			Price: monigo.CreatePriceRequest{
+				Model: monigo.PricingModelPackage,
+				Tiers: mustMarshal(monigo.PackageConfig{
+					PackageSize:  25,
+					PackagePrice: "100.000000",
+					// RoundUpPartialBlock defaults to true.
+				}),
+			},
+			Quantities:       quantities,
+			ExpectedSubtotal: "300",
+		},
+		{
+			Name: "overage",
+			Price: monigo.CreatePriceRequest{
+				Model: monigo.PricingModelOverage,
+				Tiers: mustMarshal(monigo.OverageConfig{
+					IncludedUnits: 50,
+					BasePrice:     "20.000000",
+					OveragePrice:  "1.500000",
+				}),
+			},
+			Quantities:       quantities,
+			ExpectedSubtotal: "35",
+		},
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
+
+// mustMarshal marshals one of the SDK's price configuration types, which
+// cannot fail.
+func mustMarshal(v any) json.RawMessage {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic("e2e: marshal price config: " + err.Error())
+	}
+	return b
+}
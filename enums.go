@@ -0,0 +1,281 @@
+package monigo
+
+// The enum types below are plain strings on the wire. Values the SDK does
+// not know yet decode without error, so check Valid before relying on a
+// switch being exhaustive.
+
+// Aggregation is how a metric combines the events it counts. Use the AggregationXxx constants.
+type Aggregation string
+
+// Values returns every Aggregation the SDK knows, in declaration order.
+func (Aggregation) Values() []Aggregation {
+	return []Aggregation{
+		AggregationCount,
+		AggregationSum,
+		AggregationMax,
+		AggregationMin,
+		AggregationAverage,
+		AggregationUnique,
+		AggregationLatest,
+		AggregationTimeWeighted,
+	}
+}
+
+// Valid reports whether a is one of Values.
+func (a Aggregation) Valid() bool {
+	for _, v := range a.Values() {
+		if a == v {
+			return true
+		}
+	}
+	return false
+}
+
+// PricingModel is how a price turns a quantity into an amount. Use the PricingModelXxx constants.
+type PricingModel string
+
+// Values returns every PricingModel the SDK knows, in declaration order.
+func (PricingModel) Values() []PricingModel {
+	return []PricingModel{
+		PricingModelFlat,
+		PricingModelPerUnit,
+		PricingModelTiered,
+		PricingModelPackage,
+		PricingModelOverage,
+		PricingModelVolume,
+		PricingModelWeightedTiered,
+	}
+}
+
+// Valid reports whether m is one of Values.
+func (m PricingModel) Valid() bool {
+	for _, v := range m.Values() {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// BillingPeriod is the invoice cadence of a plan. Use the BillingPeriodXxx constants.
+type BillingPeriod string
+
+// Values returns every BillingPeriod the SDK knows, in declaration order.
+func (BillingPeriod) Values() []BillingPeriod {
+	return []BillingPeriod{
+		BillingPeriodDaily,
+		BillingPeriodWeekly,
+		BillingPeriodMonthly,
+		BillingPeriodQuarterly,
+		BillingPeriodAnnually,
+	}
+}
+
+// Valid reports whether p is one of Values.
+func (p BillingPeriod) Valid() bool {
+	for _, v := range p.Values() {
+		if p == v {
+			return true
+		}
+	}
+	return false
+}
+
+// PlanType is whether a plan bills customers or pays out to them. Use the PlanTypeXxx constants.
+type PlanType string
+
+// Values returns every PlanType the SDK knows, in declaration order.
+func (PlanType) Values() []PlanType {
+	return []PlanType{
+		PlanTypeCollection,
+		PlanTypePayout,
+	}
+}
+
+// Valid reports whether t is one of Values.
+func (t PlanType) Valid() bool {
+	for _, v := range t.Values() {
+		if t == v {
+			return true
+		}
+	}
+	return false
+}
+
+// SubscriptionStatus is the lifecycle state of a subscription. Use the SubscriptionStatusXxx constants.
+type SubscriptionStatus string
+
+// Values returns every SubscriptionStatus the SDK knows, in declaration order.
+func (SubscriptionStatus) Values() []SubscriptionStatus {
+	return []SubscriptionStatus{
+		SubscriptionStatusActive,
+		SubscriptionStatusPaused,
+		SubscriptionStatusCanceled,
+		SubscriptionStatusScheduled,
+	}
+}
+
+// Valid reports whether s is one of Values.
+func (s SubscriptionStatus) Valid() bool {
+	for _, v := range s.Values() {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// InvoiceStatus is the lifecycle state of an invoice. Use the InvoiceStatusXxx constants.
+type InvoiceStatus string
+
+// Values returns every InvoiceStatus the SDK knows, in declaration order.
+func (InvoiceStatus) Values() []InvoiceStatus {
+	return []InvoiceStatus{
+		InvoiceStatusDraft,
+		InvoiceStatusFinalized,
+		InvoiceStatusPaid,
+		InvoiceStatusVoid,
+	}
+}
+
+// Valid reports whether s is one of Values.
+func (s InvoiceStatus) Valid() bool {
+	for _, v := range s.Values() {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// PayoutMethod is how money reaches a payout account. Use the PayoutMethodXxx constants.
+type PayoutMethod string
+
+// Values returns every PayoutMethod the SDK knows, in declaration order.
+func (PayoutMethod) Values() []PayoutMethod {
+	return []PayoutMethod{
+		PayoutMethodBankTransfer,
+		PayoutMethodMobileMoney,
+	}
+}
+
+// Valid reports whether m is one of Values.
+func (m PayoutMethod) Valid() bool {
+	for _, v := range m.Values() {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// PaymentMethodType is the kind of a payment method. Use the PaymentMethodTypeXxx constants.
+type PaymentMethodType string
+
+// Values returns every PaymentMethodType the SDK knows, in declaration order.
+func (PaymentMethodType) Values() []PaymentMethodType {
+	return []PaymentMethodType{
+		PaymentMethodTypeCard,
+		PaymentMethodTypeBankDebit,
+	}
+}
+
+// Valid reports whether t is one of Values.
+func (t PaymentMethodType) Valid() bool {
+	for _, v := range t.Values() {
+		if t == v {
+			return true
+		}
+	}
+	return false
+}
+
+// DunningExhaustedAction is what happens to a subscription once dunning of
+// its invoice is exhausted. Use the DunningExhaustedXxx constants.
+type DunningExhaustedAction string
+
+// Values returns every DunningExhaustedAction the SDK knows, in declaration order.
+func (DunningExhaustedAction) Values() []DunningExhaustedAction {
+	return []DunningExhaustedAction{
+		DunningExhaustedNone,
+		DunningExhaustedPause,
+		DunningExhaustedCancel,
+	}
+}
+
+// Valid reports whether a is one of Values.
+func (a DunningExhaustedAction) Valid() bool {
+	for _, v := range a.Values() {
+		if a == v {
+			return true
+		}
+	}
+	return false
+}
+
+// DunningWebhookType is the type of a dunning webhook. Use the DunningWebhookXxx constants.
+type DunningWebhookType string
+
+// Values returns every DunningWebhookType the SDK knows, in declaration order.
+func (DunningWebhookType) Values() []DunningWebhookType {
+	return []DunningWebhookType{
+		DunningWebhookReminderSent,
+		DunningWebhookRetryFailed,
+		DunningWebhookRetrySucceeded,
+		DunningWebhookExhausted,
+	}
+}
+
+// Valid reports whether t is one of Values.
+func (t DunningWebhookType) Valid() bool {
+	for _, v := range t.Values() {
+		if t == v {
+			return true
+		}
+	}
+	return false
+}
+
+// QuotaBehavior is what happens to events past a quota's limit. Use the QuotaBehaviorXxx constants.
+type QuotaBehavior string
+
+// Values returns every QuotaBehavior the SDK knows, in declaration order.
+func (QuotaBehavior) Values() []QuotaBehavior {
+	return []QuotaBehavior{
+		QuotaBehaviorBlock,
+		QuotaBehaviorNotify,
+		QuotaBehaviorBillOverage,
+	}
+}
+
+// Valid reports whether b is one of Values.
+func (b QuotaBehavior) Valid() bool {
+	for _, v := range b.Values() {
+		if b == v {
+			return true
+		}
+	}
+	return false
+}
+
+// ProrationBehavior is how the partial first period of an anchored
+// subscription is billed. Use the ProrationBehaviorXxx constants.
+type ProrationBehavior string
+
+// Values returns every ProrationBehavior the SDK knows, in declaration order.
+func (ProrationBehavior) Values() []ProrationBehavior {
+	return []ProrationBehavior{
+		ProrationBehaviorProrate,
+		ProrationBehaviorNone,
+	}
+}
+
+// Valid reports whether b is one of Values.
+func (b ProrationBehavior) Valid() bool {
+	for _, v := range b.Values() {
+		if b == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package monigo_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestEnums_Valid(t *testing.T) {
+	if !monigo.AggregationTimeWeighted.Valid() || monigo.Aggregation("median").Valid() {
+		t.Error("Aggregation.Valid disagrees with the declared constants")
+	}
+	if got := len(monigo.PlanType("").Values()); got != 2 {
+		t.Errorf("PlanType.Values: got %d values, want 2", got)
+	}
+	for _, m := range monigo.PricingModel("").Values() {
+		if !m.Valid() {
+			t.Errorf("%s listed by Values but not Valid", m)
+		}
+	}
+}
+
+func TestEnums_JSON(t *testing.T) {
+	var sub monigo.Subscription
+	if err := json.Unmarshal([]byte(`{"status":"past_due"}`), &sub); err != nil {
+		t.Fatalf("unknown values must decode: %v", err)
+	}
+	if sub.Status != "past_due" || sub.Status.Valid() {
+		t.Errorf("unexpected status %q", sub.Status)
+	}
+
+	b, err := json.Marshal(monigo.CreatePlanRequest{Name: "Pro", BillingPeriod: monigo.BillingPeriodMonthly})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw map[string]any
+	_ = json.Unmarshal(b, &raw)
+	if raw["billing_period"] != "monthly" {
+		t.Errorf("billing_period: got %v, want monthly", raw["billing_period"])
+	}
+}
@@ -3,23 +3,95 @@ package monigo
 import (
 	"errors"
 	"fmt"
+	"time"
+)
+
+// ErrInsufficientScope is returned, without contacting the API, when a
+// mutating call is made with a key whose cached Capabilities do not grant
+// the required scope. Check for it with errors.Is.
+var ErrInsufficientScope = errors.New("monigo: API key lacks the required scope")
+
+// ErrorCode is a machine-readable error code returned by the API alongside
+// the HTTP status. Codes distinguish failures that share a status, such as a
+// 409 for a duplicate customer versus a plan with active subscriptions.
+//
+// ErrorCode implements error so codes can be matched with errors.Is:
+//
+//	if errors.Is(err, monigo.ErrCodeDuplicateCustomer) { ... }
+type ErrorCode string
+
+func (c ErrorCode) Error() string {
+	return "monigo: " + string(c)
+}
+
+// Error codes returned in APIError.Code.
+const (
+	ErrCodeValidationFailed           ErrorCode = "validation_failed"
+	ErrCodeNotFound                   ErrorCode = "not_found"
+	ErrCodeInsufficientScope          ErrorCode = "insufficient_scope"
+	ErrCodeRateLimited                ErrorCode = "rate_limited"
+	ErrCodeQuotaExceeded              ErrorCode = "quota_exceeded"
+	ErrCodeDuplicateCustomer          ErrorCode = "duplicate_customer"
+	ErrCodeSubscriptionExists         ErrorCode = "subscription_exists"
+	ErrCodePlanHasActiveSubscriptions ErrorCode = "plan_has_active_subscriptions"
+	ErrCodeMetricInUse                ErrorCode = "metric_in_use"
+	ErrCodeInvalidCurrency            ErrorCode = "invalid_currency"
+	ErrCodeInvalidTier                ErrorCode = "invalid_tier"
+	ErrCodeInvoiceNotDraft            ErrorCode = "invoice_not_draft"
+	ErrCodeInsufficientBalance        ErrorCode = "insufficient_balance"
+	ErrCodeIdempotencyConflict        ErrorCode = "idempotency_conflict"
+	ErrCodePeriodClosed               ErrorCode = "period_closed"
 )
 
 // APIError is returned when the Monigo API responds with an HTTP 4xx or 5xx status.
 type APIError struct {
 	// StatusCode is the HTTP status code (e.g. 404, 422).
 	StatusCode int `json:"-"`
+	// Code is the machine-readable error code, when the API provides one.
+	// Compare against the ErrCodeXxx constants or use errors.Is.
+	Code ErrorCode `json:"code,omitempty"`
 	// Message is the human-readable error description from the API.
 	Message string `json:"error"`
 	// Details contains field-level validation errors when present.
 	Details map[string]string `json:"details,omitempty"`
+	// RequestID is the server-assigned ID of the failed request, taken from
+	// the X-Request-Id header. Include it when contacting support.
+	RequestID string `json:"-"`
+	// RetryAfter is how long the server asked the client to wait before
+	// retrying, parsed from the Retry-After header. Zero when not sent.
+	RetryAfter time.Duration `json:"-"`
+	// RateLimit is the rate-limit state reported with the error, or nil.
+	RateLimit *RateLimit `json:"-"`
 }
 
 func (e *APIError) Error() string {
+	msg := fmt.Sprintf("monigo: HTTP %d: %s", e.StatusCode, e.Message)
+	if e.Code != "" {
+		msg = fmt.Sprintf("monigo: HTTP %d %s: %s", e.StatusCode, e.Code, e.Message)
+	}
 	if len(e.Details) > 0 {
-		return fmt.Sprintf("monigo: HTTP %d: %s (%v)", e.StatusCode, e.Message, e.Details)
+		msg = fmt.Sprintf("%s (%v)", msg, e.Details)
+	}
+	if e.RequestID != "" {
+		msg = fmt.Sprintf("%s [request_id=%s]", msg, e.RequestID)
+	}
+	return msg
+}
+
+// Is reports whether target is the ErrorCode carried by e, so that
+// errors.Is(err, monigo.ErrCodeXxx) works on wrapped API errors. A server
+// "insufficient_scope" error also matches ErrInsufficientScope.
+func (e *APIError) Is(target error) bool {
+	if code, ok := target.(ErrorCode); ok {
+		return e.Code != "" && e.Code == code
 	}
-	return fmt.Sprintf("monigo: HTTP %d: %s", e.StatusCode, e.Message)
+	return target == ErrInsufficientScope && e.Code == ErrCodeInsufficientScope
+}
+
+// HasErrorCode returns true if err is an APIError carrying code.
+func HasErrorCode(err error, code ErrorCode) bool {
+	var e *APIError
+	return errors.As(err, &e) && e.Code == code
 }
 
 // IsNotFound returns true if err is an APIError with status 404.
@@ -61,8 +133,34 @@ func IsQuotaExceeded(err error) bool {
 }
 
 // IsValidationError returns true if err is an APIError with status 400
-// that includes field-level Details.
+// that includes field-level Details, or a *RequestValidationError found
+// before the request was sent.
 func IsValidationError(err error) bool {
 	var e *APIError
-	return errors.As(err, &e) && e.StatusCode == 400 && len(e.Details) > 0
+	return errors.As(err, &e) && e.StatusCode == 400 && len(e.Details) > 0 ||
+		errors.Is(err, ErrInvalidRequest)
+}
+
+// ErrInvalidID is matched, via errors.Is, by the *InvalidIDError returned
+// without contacting the API when an ID passed to a service method could
+// not name a resource.
+var ErrInvalidID = errors.New("monigo: invalid ID")
+
+// InvalidIDError reports an ID rejected before it was placed in a request
+// path, such as an empty string or "..", which would otherwise address a
+// different endpoint.
+type InvalidIDError struct {
+	// ID is the rejected value.
+	ID string
+	// Reason says why it was rejected.
+	Reason string
+}
+
+func (e *InvalidIDError) Error() string {
+	return fmt.Sprintf("monigo: invalid ID %q: %s", e.ID, e.Reason)
+}
+
+// Unwrap returns ErrInvalidID.
+func (e *InvalidIDError) Unwrap() error {
+	return ErrInvalidID
 }
@@ -2,6 +2,8 @@ package monigo_test
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
 	monigo "github.com/monigo-africa/go-monigo"
@@ -104,3 +106,40 @@ func TestIsValidationError(t *testing.T) {
 		t.Error("IsValidationError should be false when Details is nil")
 	}
 }
+
+func TestAPIError_ErrorCode(t *testing.T) {
+	e := &monigo.APIError{
+		StatusCode: 409,
+		Code:       monigo.ErrCodePlanHasActiveSubscriptions,
+		Message:    "plan has active subscriptions",
+	}
+	if !errors.Is(e, monigo.ErrCodePlanHasActiveSubscriptions) {
+		t.Error("errors.Is should match the carried code")
+	}
+	if errors.Is(e, monigo.ErrCodeInvalidCurrency) {
+		t.Error("errors.Is should not match a different code")
+	}
+	wrapped := fmt.Errorf("delete plan: %w", e)
+	if !monigo.HasErrorCode(wrapped, monigo.ErrCodePlanHasActiveSubscriptions) {
+		t.Error("HasErrorCode should see through wrapping")
+	}
+	if monigo.HasErrorCode(errors.New("plain"), monigo.ErrCodeNotFound) {
+		t.Error("HasErrorCode should be false for non-APIError")
+	}
+	if !strings.Contains(e.Error(), "plan_has_active_subscriptions") {
+		t.Errorf("Error() should include the code, got %q", e.Error())
+	}
+}
+
+func TestAPIError_NoCodeMatchesNothing(t *testing.T) {
+	if errors.Is(apiErr(400, "bad request"), monigo.ErrorCode("")) {
+		t.Error("an error without a code should not match the empty code")
+	}
+}
+
+func TestAPIError_InsufficientScopeCode(t *testing.T) {
+	e := &monigo.APIError{StatusCode: 403, Code: monigo.ErrCodeInsufficientScope, Message: "forbidden"}
+	if !errors.Is(e, monigo.ErrInsufficientScope) {
+		t.Error("server insufficient_scope errors should match ErrInsufficientScope")
+	}
+}
@@ -0,0 +1,151 @@
+// Package eventqueue keeps usage events on local disk while the Monigo API
+// cannot be reached and delivers them once it can, so usage is not lost
+// during network or Monigo outages.
+//
+// Wrap the client's EventService in a Queue and send events through it:
+//
+//	store, err := eventqueue.NewFileStore("/var/lib/myapp/monigo-queue", 512<<20)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	q := eventqueue.New(client.Events, store)
+//	go q.Run(ctx, 30*time.Second) // redeliver in the background
+//
+//	resp, queued, err := q.Send(ctx, events)
+//
+// Events keep their idempotency keys on disk, so a batch that reached the
+// API before a failure was reported is de-duplicated when it is redelivered.
+package eventqueue
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+// Ingester sends events to Monigo. *monigo.EventService and
+// *billingtest.Recorder implement it.
+type Ingester interface {
+	Ingest(ctx context.Context, req monigo.IngestRequest, opts ...monigo.RequestOption) (*monigo.IngestResponse, error)
+}
+
+// Queue delivers events through an Ingester, spilling them to a Store when
+// delivery fails for a reason that may resolve on its own: a network error,
+// a 5xx response, or a 429. Other API errors, such as validation failures,
+// are returned to the caller because retrying would not help.
+//
+// A Queue is safe for concurrent use.
+type Queue struct {
+	ingester Ingester
+	store    Store
+
+	// flushing serialises Flush so batches are delivered in order.
+	flushing chan struct{}
+}
+
+// New returns a Queue sending through ingester and spilling to store.
+func New(ingester Ingester, store Store) *Queue {
+	return &Queue{ingester: ingester, store: store, flushing: make(chan struct{}, 1)}
+}
+
+// Send ingests events. When the API is unreachable the events are stored
+// instead and Send returns queued true with a nil error; they are delivered
+// by a later Flush or Run. Earlier stored batches are flushed first so
+// delivery stays roughly in order.
+func (q *Queue) Send(ctx context.Context, events []monigo.IngestEvent) (resp *monigo.IngestResponse, queued bool, err error) {
+	if n, err := q.store.Len(); err == nil && n > 0 {
+		if _, err := q.Flush(ctx); err != nil && Retryable(err) && ctx.Err() == nil {
+			// Still unreachable: queue behind the stored batches.
+			if err := q.store.Append(events); err != nil {
+				return nil, false, err
+			}
+			return nil, true, nil
+		}
+	}
+
+	resp, err = q.ingester.Ingest(ctx, monigo.IngestRequest{Events: events})
+	if err == nil {
+		return resp, false, nil
+	}
+	if !Retryable(err) || ctx.Err() != nil {
+		return nil, false, err
+	}
+	if err := q.store.Append(events); err != nil {
+		return nil, false, err
+	}
+	return nil, true, nil
+}
+
+// Flush delivers stored batches, oldest first, until the store is empty or a
+// delivery fails. It returns the number of batches delivered. A batch the API
+// rejects with a non-retryable error is dropped so it cannot block the
+// queue; the error is returned after the remaining batches are attempted.
+func (q *Queue) Flush(ctx context.Context) (int, error) {
+	select {
+	case q.flushing <- struct{}{}:
+		defer func() { <-q.flushing }()
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+
+	var (
+		delivered int
+		rejected  error
+	)
+	for {
+		id, events, err := q.store.Oldest()
+		if err != nil {
+			return delivered, err
+		}
+		if id == "" {
+			return delivered, rejected
+		}
+		if _, err := q.ingester.Ingest(ctx, monigo.IngestRequest{Events: events}); err != nil {
+			if Retryable(err) || ctx.Err() != nil {
+				return delivered, err
+			}
+			rejected = errors.Join(rejected, err)
+		} else {
+			delivered++
+		}
+		if err := q.store.Remove(id); err != nil {
+			return delivered, err
+		}
+	}
+}
+
+// Run calls Flush every interval until ctx is done. Errors are left for the
+// next attempt.
+func (q *Queue) Run(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			q.Flush(ctx)
+		}
+	}
+}
+
+// Len returns the number of batches waiting to be delivered.
+func (q *Queue) Len() (int, error) {
+	return q.store.Len()
+}
+
+// Retryable reports whether a delivery error may succeed if retried later:
+// an API response with status 429 or 5xx, or any other error that is not an
+// API response, a client-side validation failure, or a missing scope.
+func Retryable(err error) bool {
+	var apiErr *monigo.APIError
+	if !errors.As(err, &apiErr) {
+		return !errors.Is(err, monigo.ErrInsufficientScope) &&
+			!errors.Is(err, monigo.ErrInvalidRequest) &&
+			!errors.Is(err, monigo.ErrInvalidID)
+	}
+	return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+}
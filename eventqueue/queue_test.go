@@ -0,0 +1,162 @@
+package eventqueue_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+	"github.com/monigo-africa/go-monigo/eventqueue"
+)
+
+// flakyIngester fails with err while it is set and records delivered batches.
+type flakyIngester struct {
+	mu        sync.Mutex
+	err       error
+	delivered [][]monigo.IngestEvent
+}
+
+func (f *flakyIngester) Ingest(ctx context.Context, req monigo.IngestRequest, opts ...monigo.RequestOption) (*monigo.IngestResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.delivered = append(f.delivered, req.Events)
+	return &monigo.IngestResponse{}, nil
+}
+
+func (f *flakyIngester) setErr(err error) {
+	f.mu.Lock()
+	f.err = err
+	f.mu.Unlock()
+}
+
+func batch(keys ...string) []monigo.IngestEvent {
+	events := make([]monigo.IngestEvent, len(keys))
+	for i, k := range keys {
+		events[i] = monigo.IngestEvent{EventName: "api_call", CustomerID: "cust-1", IdempotencyKey: k}
+	}
+	return events
+}
+
+func newQueue(t *testing.T, ing *flakyIngester, maxBytes int64) (*eventqueue.Queue, string) {
+	t.Helper()
+	dir := t.TempDir()
+	store, err := eventqueue.NewFileStore(dir, maxBytes)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	return eventqueue.New(ing, store), dir
+}
+
+func TestQueue_SpillsAndFlushes(t *testing.T) {
+	ing := &flakyIngester{err: errors.New("dial tcp: connection refused")}
+	q, _ := newQueue(t, ing, 0)
+	ctx := context.Background()
+
+	for _, k := range []string{"a", "b"} {
+		_, queued, err := q.Send(ctx, batch(k))
+		if err != nil || !queued {
+			t.Fatalf("Send(%s): queued=%v err=%v", k, queued, err)
+		}
+	}
+	if n, _ := q.Len(); n != 2 {
+		t.Fatalf("expected 2 queued batches, got %d", n)
+	}
+
+	ing.setErr(nil)
+	n, err := q.Flush(ctx)
+	if err != nil || n != 2 {
+		t.Fatalf("Flush: delivered %d, err %v", n, err)
+	}
+	if got := ing.delivered[0][0].IdempotencyKey + ing.delivered[1][0].IdempotencyKey; got != "ab" {
+		t.Errorf("delivery order: %s", got)
+	}
+	if n, _ := q.Len(); n != 0 {
+		t.Errorf("expected empty queue, got %d", n)
+	}
+}
+
+func TestQueue_SendFlushesBacklogFirst(t *testing.T) {
+	ing := &flakyIngester{err: &monigo.APIError{StatusCode: 503}}
+	q, _ := newQueue(t, ing, 0)
+	ctx := context.Background()
+
+	q.Send(ctx, batch("a"))
+	ing.setErr(nil)
+	_, queued, err := q.Send(ctx, batch("b"))
+	if err != nil || queued {
+		t.Fatalf("Send: queued=%v err=%v", queued, err)
+	}
+	if len(ing.delivered) != 2 || ing.delivered[0][0].IdempotencyKey != "a" {
+		t.Errorf("expected backlog delivered first, got %v", ing.delivered)
+	}
+}
+
+func TestQueue_DoesNotQueueRejectedEvents(t *testing.T) {
+	ing := &flakyIngester{err: &monigo.APIError{StatusCode: 422, Message: "invalid event"}}
+	q, _ := newQueue(t, ing, 0)
+
+	_, queued, err := q.Send(context.Background(), batch("a"))
+	if err == nil || queued {
+		t.Fatalf("expected the validation error, got queued=%v err=%v", queued, err)
+	}
+	if n, _ := q.Len(); n != 0 {
+		t.Errorf("expected nothing queued, got %d", n)
+	}
+}
+
+func TestQueue_SurvivesRestart(t *testing.T) {
+	ing := &flakyIngester{err: errors.New("network unreachable")}
+	q, dir := newQueue(t, ing, 0)
+	q.Send(context.Background(), batch("a", "b"))
+
+	store, err := eventqueue.NewFileStore(dir, 0)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	ing2 := &flakyIngester{}
+	if n, err := eventqueue.New(ing2, store).Flush(context.Background()); err != nil || n != 1 {
+		t.Fatalf("Flush after restart: delivered %d, err %v", n, err)
+	}
+	if len(ing2.delivered[0]) != 2 {
+		t.Errorf("expected the stored batch of 2, got %v", ing2.delivered)
+	}
+}
+
+func TestFileStore_MaxBytes(t *testing.T) {
+	ing := &flakyIngester{err: errors.New("offline")}
+	q, _ := newQueue(t, ing, 200)
+	ctx := context.Background()
+
+	if _, _, err := q.Send(ctx, batch("a")); err != nil {
+		t.Fatalf("first batch: %v", err)
+	}
+	_, queued, err := q.Send(ctx, batch("b", "c", "d"))
+	if !errors.Is(err, eventqueue.ErrQueueFull) || queued {
+		t.Errorf("expected ErrQueueFull, got queued=%v err=%v", queued, err)
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{errors.New("connection reset"), true},
+		{&monigo.APIError{StatusCode: 429}, true},
+		{&monigo.APIError{StatusCode: 502}, true},
+		{&monigo.APIError{StatusCode: 400}, false},
+		{&monigo.APIError{StatusCode: 401}, false},
+		{monigo.ErrInsufficientScope, false},
+		{monigo.IngestRequest{Events: []monigo.IngestEvent{{}}}.Validate(), false},
+		{&monigo.InvalidIDError{ID: "a/b"}, false},
+	}
+	for _, c := range cases {
+		if got := eventqueue.Retryable(c.err); got != c.want {
+			t.Errorf("Retryable(%v) = %v, want %v", c.err, got, c.want)
+		}
+	}
+}
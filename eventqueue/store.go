@@ -0,0 +1,170 @@
+package eventqueue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+// ErrQueueFull is returned when appending a batch would take the store past
+// its size limit.
+var ErrQueueFull = errors.New("eventqueue: queue is full")
+
+// Store persists batches of events until they are delivered.
+// Implementations must be safe for concurrent use and return batches from
+// Oldest in the order they were appended.
+type Store interface {
+	// Append durably stores a batch.
+	Append(events []monigo.IngestEvent) error
+	// Oldest returns the oldest stored batch and an ID to Remove it by. It
+	// returns an empty ID when the store is empty.
+	Oldest() (id string, events []monigo.IngestEvent, err error)
+	// Remove deletes a delivered batch.
+	Remove(id string) error
+	// Len returns the number of stored batches.
+	Len() (int, error)
+}
+
+// batchExt is the file extension of stored batches. Files are written under
+// a temporary name and renamed, so a crash never leaves a partial batch.
+const batchExt = ".json"
+
+// FileStore is a Store that keeps one JSON file per batch in a directory.
+// Batches survive process restarts; pass the same directory to NewFileStore
+// to pick them up again.
+type FileStore struct {
+	dir      string
+	maxBytes int64
+
+	mu   sync.Mutex
+	seq  int64
+	size int64
+}
+
+// NewFileStore opens or creates a FileStore in dir. maxBytes limits the total
+// size of stored batches; zero means no limit.
+func NewFileStore(dir string, maxBytes int64) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("eventqueue: create %s: %w", dir, err)
+	}
+	s := &FileStore{dir: dir, maxBytes: maxBytes}
+	names, err := s.list()
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if info, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			s.size += info.Size()
+		}
+	}
+	return s, nil
+}
+
+// Append implements Store.
+func (s *FileStore) Append(events []monigo.IngestEvent) error {
+	b, err := json.Marshal(monigo.IngestRequest{Events: events})
+	if err != nil {
+		return fmt.Errorf("eventqueue: encode batch: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.maxBytes > 0 && s.size+int64(len(b)) > s.maxBytes {
+		return ErrQueueFull
+	}
+	s.seq++
+	// Names sort in append order: nanosecond clock, then a tie-breaking
+	// sequence for batches appended within the same tick.
+	name := fmt.Sprintf("%020d-%06d%s", time.Now().UnixNano(), s.seq%1000000, batchExt)
+
+	tmp, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("eventqueue: write batch: %w", err)
+	}
+	_, werr := tmp.Write(b)
+	if werr == nil {
+		werr = tmp.Sync()
+	}
+	if cerr := tmp.Close(); werr == nil {
+		werr = cerr
+	}
+	if werr == nil {
+		werr = os.Rename(tmp.Name(), filepath.Join(s.dir, name))
+	}
+	if werr != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("eventqueue: write batch: %w", werr)
+	}
+	s.size += int64(len(b))
+	return nil
+}
+
+// Oldest implements Store.
+func (s *FileStore) Oldest() (string, []monigo.IngestEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names, err := s.list()
+	if err != nil || len(names) == 0 {
+		return "", nil, err
+	}
+	b, err := os.ReadFile(filepath.Join(s.dir, names[0]))
+	if err != nil {
+		return "", nil, fmt.Errorf("eventqueue: read batch: %w", err)
+	}
+	var req monigo.IngestRequest
+	if err := json.Unmarshal(b, &req); err != nil {
+		return "", nil, fmt.Errorf("eventqueue: decode batch %s: %w", names[0], err)
+	}
+	return names[0], req.Events, nil
+}
+
+// Remove implements Store.
+func (s *FileStore) Remove(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	path := filepath.Join(s.dir, filepath.Base(id))
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("eventqueue: remove batch: %w", err)
+	}
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("eventqueue: remove batch: %w", err)
+	}
+	s.size -= info.Size()
+	return nil
+}
+
+// Len implements Store.
+func (s *FileStore) Len() (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names, err := s.list()
+	return len(names), err
+}
+
+// list returns the stored batch file names, oldest first.
+func (s *FileStore) list() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("eventqueue: list %s: %w", s.dir, err)
+	}
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), batchExt) && !strings.HasPrefix(e.Name(), ".") {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
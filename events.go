@@ -2,7 +2,11 @@ package monigo
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -17,40 +21,166 @@ type EventService struct {
 // Each event must have a unique IdempotencyKey — resending the same key is
 // safe and will be de-duplicated server-side.
 //
+// Batches larger than the server's limits are split transparently into
+// several requests and the responses merged. The limits come from
+// Client.Capabilities when it has been called, and otherwise default to
+// 1,000 events and 5 MiB per request. If one of the requests fails, the
+// merged response so far is returned together with the error; its Ingested
+// and Duplicates list the events that were accepted. A key given with
+// WithIdempotencyKey gets a "-<n>" suffix per request when a batch is split.
+//
+// The request is checked with IngestRequest.Validate before anything is
+// sent.
+//
+// When the client is in test mode (see WithTestMode), every event is sent
+// with IsTest set; req is not modified.
+//
 // Requires an API key with the "ingest" scope.
 func (s *EventService) Ingest(ctx context.Context, req IngestRequest, opts ...RequestOption) (*IngestResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if s.client.testMode {
+		events := make([]IngestEvent, len(req.Events))
+		for i, e := range req.Events {
+			e.IsTest = true
+			events[i] = e
+		}
+		req.Events = events
+	}
+	maxEvents, maxBytes := s.client.ingestLimits()
+	batches, err := splitIngestBatch(req.Events, maxEvents, maxBytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(batches) <= 1 {
+		return s.ingest(ctx, req, opts...)
+	}
+
+	cfg := s.client.requestConfig(ctx, opts)
+	out := &IngestResponse{Ingested: []string{}, Duplicates: []string{}}
+	for i, events := range batches {
+		batchOpts := opts
+		if cfg.idempotencyKey != "" {
+			batchOpts = append(opts[:len(opts):len(opts)], WithIdempotencyKey(fmt.Sprintf("%s-%d", cfg.idempotencyKey, i)))
+		}
+		resp, err := s.ingest(ctx, IngestRequest{Events: events}, batchOpts...)
+		if err != nil {
+			return out, err
+		}
+		out.Ingested = append(out.Ingested, resp.Ingested...)
+		out.Duplicates = append(out.Duplicates, resp.Duplicates...)
+		out.OverQuota = append(out.OverQuota, resp.OverQuota...)
+	}
+	return out, nil
+}
+
+// ingest sends req in a single POST /v1/ingest request.
+func (s *EventService) ingest(ctx context.Context, req IngestRequest, opts ...RequestOption) (*IngestResponse, error) {
 	var wrapper struct {
-		Ingested   []string `json:"ingested"`
-		Duplicates []string `json:"duplicates"`
+		Ingested   []string         `json:"ingested"`
+		Duplicates []string         `json:"duplicates"`
+		OverQuota  []OverQuotaEvent `json:"over_quota"`
+	}
+	start := time.Now()
+	err := s.client.do(ctx, "POST", "/v1/ingest", req, &wrapper, opts...)
+	var resp *IngestResponse
+	if err == nil {
+		resp = &IngestResponse{
+			Ingested:   wrapper.Ingested,
+			Duplicates: wrapper.Duplicates,
+			OverQuota:  wrapper.OverQuota,
+		}
+	}
+	if s.client.stats != nil {
+		s.client.stats.observeIngest(len(req.Events), resp, err, time.Since(start))
 	}
-	if err := s.client.do(ctx, "POST", "/v1/ingest", req, &wrapper, opts...); err != nil {
+	return resp, err
+}
+
+// ingestEnvelopeBytes is the size of the {"events":[]} wrapper around a batch.
+const ingestEnvelopeBytes = len(`{"events":[]}`)
+
+// splitIngestBatch partitions events, in order, into batches of at most
+// maxEvents events whose encoded request body stays within maxBytes. An event
+// that is too large on its own is placed in a batch by itself and left for
+// the server to reject.
+func splitIngestBatch(events []IngestEvent, maxEvents, maxBytes int) ([][]IngestEvent, error) {
+	if len(events) <= maxEvents {
+		b, err := json.Marshal(IngestRequest{Events: events})
+		if err != nil {
+			return nil, fmt.Errorf("monigo: marshal request body: %w", err)
+		}
+		if len(b) <= maxBytes {
+			return [][]IngestEvent{events}, nil
+		}
+	}
+
+	var (
+		batches [][]IngestEvent
+		start   int
+		size    = ingestEnvelopeBytes
+	)
+	for i := range events {
+		b, err := json.Marshal(&events[i])
+		if err != nil {
+			return nil, fmt.Errorf("monigo: marshal request body: %w", err)
+		}
+		n := len(b)
+		if i > start {
+			n++ // separating comma
+		}
+		if i > start && (i-start >= maxEvents || size+n > maxBytes) {
+			batches = append(batches, events[start:i])
+			start, n, size = i, len(b), ingestEnvelopeBytes
+		}
+		size += n
+	}
+	return append(batches, events[start:]), nil
+}
+
+// Validate runs events through the same checks as Ingest — customer lookup,
+// metric matching, property rules, and the replay window — without storing
+// them, and reports what would happen to each one. Use it when wiring up a
+// new event type.
+func (s *EventService) Validate(ctx context.Context, req IngestRequest, opts ...RequestOption) (*ValidateEventsResponse, error) {
+	var out ValidateEventsResponse
+	if err := s.client.do(ctx, "POST", "/v1/ingest/validate", req, &out, opts...); err != nil {
 		return nil, err
 	}
-	return &IngestResponse{
-		Ingested:   wrapper.Ingested,
-		Duplicates: wrapper.Duplicates,
-	}, nil
+	return &out, nil
 }
 
-// StartReplay initiates an asynchronous replay of all raw events in the
-// given time window through the current processing pipeline.
+// StartReplay initiates an asynchronous replay of the raw events in
+// [req.From, req.To) through the current processing pipeline, optionally
+// narrowed to one event name, customer, or metric:
 //
-// eventName is optional; pass nil to replay all event types in the window.
+//	job, err := client.Events.StartReplay(ctx, monigo.ReplayRequest{
+//		From:       from,
+//		To:         to,
+//		CustomerID: customerID,
+//	})
 //
 // Returns a job record immediately — poll GetReplay to track progress.
-func (s *EventService) StartReplay(ctx context.Context, from, to time.Time, eventName *string, opts ...RequestOption) (*EventReplayJob, error) {
-	body := map[string]any{
-		"from": from.Format(time.RFC3339),
-		"to":   to.Format(time.RFC3339),
+func (s *EventService) StartReplay(ctx context.Context, req ReplayRequest, opts ...RequestOption) (*EventReplayJob, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.CustomerID != "" {
+		if err := checkID(req.CustomerID); err != nil {
+			return nil, err
+		}
 	}
-	if eventName != nil {
-		body["event_name"] = *eventName
+	if req.MetricID != "" {
+		if err := checkID(req.MetricID); err != nil {
+			return nil, err
+		}
 	}
 
 	var wrapper struct {
 		Job EventReplayJob `json:"job"`
 	}
-	if err := s.client.do(ctx, "POST", "/v1/events/replay", body, &wrapper, opts...); err != nil {
+	if err := s.client.do(ctx, "POST", "/v1/events/replay", req, &wrapper, opts...); err != nil {
 		return nil, err
 	}
 	return &wrapper.Job, nil
@@ -61,8 +191,223 @@ func (s *EventService) GetReplay(ctx context.Context, jobID string) (*EventRepla
 	var wrapper struct {
 		Job EventReplayJob `json:"job"`
 	}
-	if err := s.client.do(ctx, "GET", fmt.Sprintf("/v1/events/replay/%s", jobID), nil, &wrapper); err != nil {
+	path, err := pathf("/v1/events/replay/%s", jobID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Job, nil
+}
+
+// WaitForReplay polls a replay job until it completes, fails, or is
+// canceled, and returns its final state. A job that does not complete is
+// returned together with an error wrapping ErrJobFailed. If opts.Timeout or
+// ctx expires first, the last observed job is returned with the context
+// error.
+//
+//	job, err := client.Events.WaitForReplay(ctx, job.ID, monigo.PollOptions{
+//	    Timeout: 10 * time.Minute,
+//	    Progress: func(done, total int64) {
+//	        log.Printf("replayed %d/%d", done, total)
+//	    },
+//	})
+func (s *EventService) WaitForReplay(ctx context.Context, jobID string, opts PollOptions) (*EventReplayJob, error) {
+	var job *EventReplayJob
+	err := poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		j, err := s.GetReplay(ctx, jobID)
+		if err != nil {
+			return false, err
+		}
+		job = j
+		if opts.Progress != nil {
+			opts.Progress(j.EventsReplayed, j.EventsTotal)
+		}
+		switch j.Status {
+		case JobStatusCompleted:
+			return true, nil
+		case JobStatusFailed, JobStatusCanceled:
+			msg := j.Status
+			if j.ErrorMessage != nil {
+				msg += ": " + *j.ErrorMessage
+			}
+			return true, fmt.Errorf("%w: replay %s %s", ErrJobFailed, jobID, msg)
+		}
+		return false, nil
+	})
+	return job, err
+}
+
+// ListReplays returns past and running replay jobs, newest first.
+func (s *EventService) ListReplays(ctx context.Context, params ListReplaysParams) (*ListReplaysResponse, error) {
+	q := url.Values{}
+	if params.Status != "" {
+		q.Set("status", params.Status)
+	}
+	if params.CreatedAfter != nil {
+		q.Set("created_after", params.CreatedAfter.UTC().Format(time.RFC3339))
+	}
+	if params.CreatedBefore != nil {
+		q.Set("created_before", params.CreatedBefore.UTC().Format(time.RFC3339))
+	}
+	if params.Limit > 0 {
+		q.Set("limit", strconv.Itoa(params.Limit))
+	}
+
+	path := "/v1/events/replay"
+	if len(q) > 0 {
+		path = path + "?" + q.Encode()
+	}
+
+	var out ListReplaysResponse
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelReplay stops a pending or running replay job. Events already
+// replayed stay processed; the job ends with status JobStatusCanceled.
+func (s *EventService) CancelReplay(ctx context.Context, jobID string, opts ...RequestOption) (*EventReplayJob, error) {
+	var wrapper struct {
+		Job EventReplayJob `json:"job"`
+	}
+	path, err := pathf("/v1/events/replay/%s/cancel", jobID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, nil, &wrapper, opts...); err != nil {
 		return nil, err
 	}
 	return &wrapper.Job, nil
 }
+
+// ListSequenceGaps returns the ranges of per-customer sequence numbers that
+// are missing from ingested events. Only events sent with
+// IngestEvent.Sequence set take part in gap detection. Backfill a gap by
+// re-ingesting the missing events with their original sequence numbers.
+func (s *EventService) ListSequenceGaps(ctx context.Context, params SequenceGapsParams) (*ListSequenceGapsResponse, error) {
+	q := url.Values{}
+	if params.CustomerID != "" {
+		q.Set("customer_id", params.CustomerID)
+	}
+	if params.From != nil {
+		q.Set("from", params.From.UTC().Format(time.RFC3339))
+	}
+	if params.To != nil {
+		q.Set("to", params.To.UTC().Format(time.RFC3339))
+	}
+
+	path := "/v1/events/sequence-gaps"
+	if len(q) > 0 {
+		path = path + "?" + q.Encode()
+	}
+
+	var out ListSequenceGapsResponse
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// List returns raw ingested events, oldest first, one page at a time. Use it
+// to inspect exactly what was received when a usage rollup is not what you
+// expect. Follow NextCursor to fetch further pages.
+func (s *EventService) List(ctx context.Context, params ListEventsParams) (*ListEventsResponse, error) {
+	q := url.Values{}
+	if params.CustomerID != "" {
+		q.Set("customer_id", params.CustomerID)
+	}
+	if params.EventName != "" {
+		q.Set("event_name", params.EventName)
+	}
+	if params.From != nil {
+		q.Set("from", params.From.UTC().Format(time.RFC3339))
+	}
+	if params.To != nil {
+		q.Set("to", params.To.UTC().Format(time.RFC3339))
+	}
+	if !params.Filter.IsZero() {
+		q.Set("filter", params.Filter.String())
+	}
+	if params.IsTest != nil {
+		q.Set("is_test", strconv.FormatBool(*params.IsTest))
+	}
+	if params.Cursor != "" {
+		q.Set("cursor", params.Cursor)
+	}
+	if params.Limit > 0 {
+		q.Set("limit", strconv.Itoa(params.Limit))
+	}
+
+	path := "/v1/events"
+	if len(q) > 0 {
+		path = path + "?" + q.Encode()
+	}
+
+	var out ListEventsResponse
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Export writes every event matching params to w as newline-delimited JSON,
+// one Event per line, following cursors until the last page. It starts at
+// params.Cursor and returns the number of events written. Events are written
+// as each page arrives, so a failure part-way leaves the earlier pages in w.
+func (s *EventService) Export(ctx context.Context, params ListEventsParams, w io.Writer) (int, error) {
+	enc := json.NewEncoder(w)
+	n := 0
+	seen := cursorGuard{}
+	for {
+		page, err := s.List(ctx, params)
+		if err != nil {
+			return n, err
+		}
+		for i := range page.Events {
+			if err := enc.Encode(&page.Events[i]); err != nil {
+				return n, fmt.Errorf("monigo: export events: %w", err)
+			}
+			n++
+		}
+		if page.NextCursor == "" {
+			return n, nil
+		}
+		if err := seen.follow(page.NextCursor); err != nil {
+			return n, err
+		}
+		params.Cursor = page.NextCursor
+	}
+}
+
+// Delete removes a single ingested event, identified by the idempotency key
+// it was sent with, so it no longer counts towards usage. Events in a
+// billing period that has already been invoiced cannot be deleted; the API
+// answers with ErrCodePeriodClosed.
+func (s *EventService) Delete(ctx context.Context, idempotencyKey string) error {
+	path, err := pathf("/v1/events/%s", idempotencyKey)
+	if err != nil {
+		return err
+	}
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// Amend replaces the properties of a single ingested event, e.g. to correct
+// a wrongly reported quantity, and re-aggregates the affected usage. Like
+// Delete, it fails with ErrCodePeriodClosed once the period is invoiced.
+func (s *EventService) Amend(ctx context.Context, idempotencyKey string, properties map[string]any, opts ...RequestOption) (*Event, error) {
+	var wrapper struct {
+		Event Event `json:"event"`
+	}
+	body := AmendEventRequest{Properties: properties}
+	path, err := pathf("/v1/events/%s", idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "PATCH", path, body, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Event, nil
+}
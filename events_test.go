@@ -1,8 +1,13 @@
 package monigo_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -83,6 +88,68 @@ func TestEvents_Ingest_QuotaExceeded(t *testing.T) {
 	}
 }
 
+func TestEvents_Ingest_OverQuota(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, 202, map[string]any{
+			"ingested":   []string{"key-1"},
+			"duplicates": []string{},
+			"over_quota": []monigo.OverQuotaEvent{
+				{IdempotencyKey: "key-2", QuotaID: "quota-1", Behavior: monigo.QuotaBehaviorBlock, Limit: 100, Usage: 101},
+			},
+		})
+	}))
+
+	now := time.Now()
+	resp, err := c.Events.Ingest(context.Background(), monigo.IngestRequest{
+		Events: []monigo.IngestEvent{
+			{EventName: "sms_sent", CustomerID: "c1", IdempotencyKey: "key-1", Timestamp: now},
+			{EventName: "sms_sent", CustomerID: "c1", IdempotencyKey: "key-2", Timestamp: now},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Blocked("key-2") {
+		t.Error("expected key-2 to be blocked")
+	}
+	if resp.Blocked("key-1") {
+		t.Error("key-1 should not be blocked")
+	}
+}
+
+func TestEvents_Validate(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/ingest/validate")
+		var req monigo.IngestRequest
+		decodeBody(t, r, &req)
+		if len(req.Events) != 2 {
+			t.Fatalf("expected 2 events, got %d", len(req.Events))
+		}
+		respondJSON(t, w, 200, monigo.ValidateEventsResponse{
+			Results: []monigo.EventDiagnostic{
+				{Index: 0, IdempotencyKey: "k1", Valid: true, MatchedMetricIDs: []string{"metric-1"}},
+				{Index: 1, IdempotencyKey: "k2", Issues: []monigo.EventIssue{
+					{Code: monigo.EventDiagnosticUnmatchedMetric, Message: "no metric counts api_cal"},
+				}},
+			},
+			ValidCount:   1,
+			InvalidCount: 1,
+		})
+	}))
+
+	resp, err := c.Events.Validate(context.Background(), monigo.IngestRequest{Events: []monigo.IngestEvent{
+		{EventName: "api_call", CustomerID: "cust-abc", IdempotencyKey: "k1"},
+		{EventName: "api_cal", CustomerID: "cust-abc", IdempotencyKey: "k2"},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.InvalidCount != 1 || resp.Results[1].Issues[0].Code != monigo.EventDiagnosticUnmatchedMetric {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
+
 func TestEvents_StartReplay(t *testing.T) {
 	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertMethod(t, r, "POST")
@@ -93,8 +160,10 @@ func TestEvents_StartReplay(t *testing.T) {
 		if body["from"] == nil || body["to"] == nil {
 			t.Error("expected from and to in body")
 		}
-		if _, ok := body["event_name"]; ok {
-			t.Error("event_name should not be set when nil")
+		for _, k := range []string{"event_name", "customer_id", "metric_id", "dry_run"} {
+			if _, ok := body[k]; ok {
+				t.Errorf("%s should not be set when empty", k)
+			}
 		}
 
 		respondJSON(t, w, 202, map[string]any{
@@ -107,7 +176,7 @@ func TestEvents_StartReplay(t *testing.T) {
 
 	from := time.Now().Add(-24 * time.Hour)
 	to := time.Now()
-	job, err := c.Events.StartReplay(context.Background(), from, to, nil)
+	job, err := c.Events.StartReplay(context.Background(), monigo.ReplayRequest{From: from, To: to})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -119,21 +188,42 @@ func TestEvents_StartReplay(t *testing.T) {
 	}
 }
 
-func TestEvents_StartReplay_WithEventName(t *testing.T) {
+func TestEvents_StartReplay_Scoped(t *testing.T) {
 	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		var body map[string]any
+		var body monigo.ReplayRequest
 		decodeBody(t, r, &body)
-		if body["event_name"] != "api_call" {
-			t.Errorf("expected event_name=api_call, got %v", body["event_name"])
+		if body.EventName != "api_call" || body.CustomerID != "cust-abc" || body.MetricID != "metric-1" || !body.DryRun {
+			t.Errorf("unexpected scope: %+v", body)
 		}
-		respondJSON(t, w, 202, map[string]any{"job": monigo.EventReplayJob{ID: "job-2", Status: "pending"}})
+		respondJSON(t, w, 202, map[string]any{"job": monigo.EventReplayJob{ID: "job-2", Status: "pending", CustomerID: body.CustomerID, DryRun: true}})
 	}))
 
-	name := "api_call"
-	_, err := c.Events.StartReplay(context.Background(), time.Now().Add(-time.Hour), time.Now(), &name)
+	job, err := c.Events.StartReplay(context.Background(), monigo.ReplayRequest{
+		From:       time.Now().Add(-time.Hour),
+		To:         time.Now(),
+		EventName:  "api_call",
+		CustomerID: "cust-abc",
+		MetricID:   "metric-1",
+		DryRun:     true,
+	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+	if job.CustomerID != "cust-abc" || !job.DryRun {
+		t.Errorf("unexpected job: %+v", job)
+	}
+}
+
+func TestEvents_StartReplay_Validates(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected")
+	}))
+
+	now := time.Now()
+	_, err := c.Events.StartReplay(context.Background(), monigo.ReplayRequest{From: now, To: now.Add(-time.Hour)})
+	if !errors.Is(err, monigo.ErrInvalidRequest) {
+		t.Errorf("expected a validation error, got %v", err)
+	}
 }
 
 func TestEvents_GetReplay(t *testing.T) {
@@ -171,3 +261,401 @@ func TestEvents_GetReplay_NotFound(t *testing.T) {
 		t.Errorf("expected IsNotFound=true; err=%v", err)
 	}
 }
+
+func TestEvents_ListReplays(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/events/replay")
+		q := r.URL.Query()
+		if q.Get("status") != monigo.JobStatusRunning {
+			t.Errorf("status: got %q", q.Get("status"))
+		}
+		if q.Get("limit") != "10" {
+			t.Errorf("limit: got %q", q.Get("limit"))
+		}
+		respondJSON(t, w, 200, monigo.ListReplaysResponse{
+			Jobs:  []monigo.EventReplayJob{{ID: "job-99", Status: monigo.JobStatusRunning}},
+			Count: 1,
+		})
+	}))
+
+	resp, err := c.Events.ListReplays(context.Background(), monigo.ListReplaysParams{
+		Status: monigo.JobStatusRunning,
+		Limit:  10,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Count != 1 || resp.Jobs[0].ID != "job-99" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
+
+func TestEvents_CancelReplay(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/events/replay/job-99/cancel")
+		respondJSON(t, w, 200, map[string]any{
+			"job": monigo.EventReplayJob{ID: "job-99", Status: monigo.JobStatusCanceled, EventsReplayed: 40},
+		})
+	}))
+
+	job, err := c.Events.CancelReplay(context.Background(), "job-99")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.Status != monigo.JobStatusCanceled {
+		t.Errorf("status: got %q", job.Status)
+	}
+}
+
+func TestEvents_Ingest_SendsSequence(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body monigo.IngestRequest
+		decodeBody(t, r, &body)
+		if body.Events[0].Sequence != 42 {
+			t.Errorf("sequence: got %d, want 42", body.Events[0].Sequence)
+		}
+		respondJSON(t, w, 202, map[string]any{"ingested": []string{"key-1"}, "duplicates": []string{}})
+	}))
+
+	_, err := c.Events.Ingest(context.Background(), monigo.IngestRequest{
+		Events: []monigo.IngestEvent{
+			{EventName: "ledger.post", CustomerID: "cust-1", IdempotencyKey: "key-1", Timestamp: time.Now(), Sequence: 42},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestEvents_ListSequenceGaps(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/events/sequence-gaps")
+		if r.URL.Query().Get("customer_id") != "cust-1" {
+			t.Errorf("customer_id: got %q, want cust-1", r.URL.Query().Get("customer_id"))
+		}
+		respondJSON(t, w, 200, monigo.ListSequenceGapsResponse{
+			Gaps:  []monigo.SequenceGap{{CustomerID: "cust-1", FromSequence: 101, ToSequence: 105}},
+			Count: 1,
+		})
+	}))
+
+	resp, err := c.Events.ListSequenceGaps(context.Background(), monigo.SequenceGapsParams{CustomerID: "cust-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Count != 1 || resp.Gaps[0].FromSequence != 101 || resp.Gaps[0].ToSequence != 105 {
+		t.Errorf("unexpected gaps: %+v", resp.Gaps)
+	}
+}
+
+func TestEvents_WaitForReplay(t *testing.T) {
+	var polls int
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/events/replay/job-99")
+		polls++
+		job := monigo.EventReplayJob{ID: "job-99", Status: monigo.JobStatusRunning, EventsTotal: 100, EventsReplayed: int64(polls * 40)}
+		if polls == 3 {
+			job.Status = monigo.JobStatusCompleted
+			job.EventsReplayed = 100
+		}
+		respondJSON(t, w, 200, map[string]any{"job": job})
+	}))
+
+	var progress []int64
+	job, err := c.Events.WaitForReplay(context.Background(), "job-99", monigo.PollOptions{
+		Interval: time.Millisecond,
+		Progress: func(done, total int64) { progress = append(progress, done) },
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.Status != monigo.JobStatusCompleted || polls != 3 {
+		t.Errorf("status %q after %d polls", job.Status, polls)
+	}
+	if len(progress) != 3 || progress[2] != 100 {
+		t.Errorf("progress: got %v", progress)
+	}
+}
+
+func TestEvents_WaitForReplay_Failed(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		msg := "pipeline error"
+		respondJSON(t, w, 200, map[string]any{
+			"job": monigo.EventReplayJob{ID: "job-99", Status: monigo.JobStatusFailed, ErrorMessage: &msg},
+		})
+	}))
+
+	job, err := c.Events.WaitForReplay(context.Background(), "job-99", monigo.PollOptions{Interval: time.Millisecond})
+	if !errors.Is(err, monigo.ErrJobFailed) {
+		t.Fatalf("expected ErrJobFailed, got %v", err)
+	}
+	if job == nil || job.Status != monigo.JobStatusFailed {
+		t.Errorf("expected the failed job, got %+v", job)
+	}
+}
+
+func TestEvents_WaitForReplay_Timeout(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, 200, map[string]any{
+			"job": monigo.EventReplayJob{ID: "job-99", Status: monigo.JobStatusRunning},
+		})
+	}))
+
+	job, err := c.Events.WaitForReplay(context.Background(), "job-99", monigo.PollOptions{
+		Interval: time.Millisecond,
+		Timeout:  20 * time.Millisecond,
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected DeadlineExceeded, got %v", err)
+	}
+	if job == nil || job.Status != monigo.JobStatusRunning {
+		t.Errorf("expected the last observed job, got %+v", job)
+	}
+}
+
+func TestEvents_List(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/events")
+		q := r.URL.Query()
+		if q.Get("customer_id") != "cust-abc" || q.Get("event_name") != "api_call" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		if q.Get("from") != "2026-03-01T00:00:00Z" {
+			t.Errorf("from: got %q", q.Get("from"))
+		}
+		if q.Get("filter") != `properties.region eq "lagos"` {
+			t.Errorf("filter: got %q", q.Get("filter"))
+		}
+		respondJSON(t, w, 200, monigo.ListEventsResponse{
+			Events: []monigo.Event{{
+				ID:             "evt-1",
+				CustomerID:     "cust-abc",
+				EventName:      "api_call",
+				IdempotencyKey: "req-1",
+				Properties:     map[string]any{"region": "lagos"},
+			}},
+			Count:      1,
+			NextCursor: "c2",
+		})
+	}))
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	resp, err := c.Events.List(context.Background(), monigo.ListEventsParams{
+		CustomerID: "cust-abc",
+		EventName:  "api_call",
+		From:       &from,
+		Filter:     monigo.Where("properties.region").Eq("lagos"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Events[0].IdempotencyKey != "req-1" || resp.NextCursor != "c2" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
+
+func TestEvents_Export(t *testing.T) {
+	pages := map[string]monigo.ListEventsResponse{
+		"":   {Events: []monigo.Event{{ID: "evt-1"}, {ID: "evt-2"}}, Count: 2, NextCursor: "c2"},
+		"c2": {Events: []monigo.Event{{ID: "evt-3"}}, Count: 1},
+	}
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertPath(t, r, "/v1/events")
+		respondJSON(t, w, 200, pages[r.URL.Query().Get("cursor")])
+	}))
+
+	var buf bytes.Buffer
+	n, err := c.Events.Export(context.Background(), monigo.ListEventsParams{}, &buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("expected 3 events, got %d", n)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d: %q", len(lines), buf.String())
+	}
+	var last monigo.Event
+	if err := json.Unmarshal([]byte(lines[2]), &last); err != nil || last.ID != "evt-3" {
+		t.Errorf("last line: %q (%v)", lines[2], err)
+	}
+}
+
+func TestEvents_Export_CursorCycle(t *testing.T) {
+	pages := map[string]monigo.ListEventsResponse{
+		"":   {Events: []monigo.Event{{ID: "evt-1"}}, Count: 1, NextCursor: "c2"},
+		"c2": {Events: []monigo.Event{{ID: "evt-2"}}, Count: 1, NextCursor: "c3"},
+		"c3": {Events: []monigo.Event{{ID: "evt-3"}}, Count: 1, NextCursor: "c2"},
+	}
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, 200, pages[r.URL.Query().Get("cursor")])
+	}))
+
+	var buf bytes.Buffer
+	n, err := c.Events.Export(context.Background(), monigo.ListEventsParams{}, &buf)
+	if err == nil {
+		t.Fatal("expected an error for a cursor returned twice")
+	}
+	if n != 3 {
+		t.Errorf("expected the 3 events before the cycle, got %d", n)
+	}
+}
+
+func TestEvents_Delete(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "DELETE")
+		if r.URL.EscapedPath() != "/v1/events/order%2F42" {
+			t.Errorf("path: got %q", r.URL.EscapedPath())
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	if err := c.Events.Delete(context.Background(), "order/42"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestEvents_Amend(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "PATCH")
+		assertPath(t, r, "/v1/events/req-1")
+		var req monigo.AmendEventRequest
+		decodeBody(t, r, &req)
+		if req.Properties["quantity"] != float64(3) {
+			t.Errorf("quantity: got %v", req.Properties["quantity"])
+		}
+		respondJSON(t, w, 200, map[string]any{"event": monigo.Event{
+			ID:             "evt-1",
+			IdempotencyKey: "req-1",
+			Properties:     req.Properties,
+		}})
+	}))
+
+	evt, err := c.Events.Amend(context.Background(), "req-1", map[string]any{"quantity": 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if evt.Properties["quantity"] != float64(3) {
+		t.Errorf("unexpected event: %+v", evt)
+	}
+}
+
+func TestEvents_Amend_PeriodClosed(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, 409, map[string]string{"code": "period_closed", "error": "billing period already invoiced"})
+	}))
+
+	_, err := c.Events.Amend(context.Background(), "req-1", map[string]any{"quantity": 3})
+	if !errors.Is(err, monigo.ErrCodePeriodClosed) {
+		t.Errorf("expected ErrCodePeriodClosed, got %v", err)
+	}
+}
+
+// ingestLimitServer serves capabilities with the given ingest limits and
+// records the batches posted to /v1/ingest.
+func ingestLimitServer(t *testing.T, maxEvents, maxBytes int, batches *[]monigo.IngestRequest, keys *[]string) *monigo.Client {
+	t.Helper()
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/capabilities":
+			respondJSON(t, w, 200, map[string]any{"capabilities": monigo.Capabilities{
+				Scopes:             []string{monigo.ScopeIngest},
+				MaxIngestBatchSize: maxEvents,
+				MaxIngestBodyBytes: maxBytes,
+			}})
+		case "/v1/ingest":
+			var req monigo.IngestRequest
+			decodeBody(t, r, &req)
+			*batches = append(*batches, req)
+			*keys = append(*keys, r.Header.Get("Idempotency-Key"))
+			ingested := make([]string, len(req.Events))
+			for i, e := range req.Events {
+				ingested[i] = e.IdempotencyKey
+			}
+			respondJSON(t, w, 202, monigo.IngestResponse{Ingested: ingested, Duplicates: []string{}})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	if _, err := c.Capabilities(context.Background()); err != nil {
+		t.Fatalf("Capabilities: %v", err)
+	}
+	return c
+}
+
+func makeEvents(n int) []monigo.IngestEvent {
+	events := make([]monigo.IngestEvent, n)
+	for i := range events {
+		events[i] = monigo.IngestEvent{
+			EventName:      "api_call",
+			CustomerID:     "cust-abc",
+			IdempotencyKey: fmt.Sprintf("evt-%03d", i),
+			Properties:     map[string]any{},
+		}
+	}
+	return events
+}
+
+func TestEvents_Ingest_SplitsByCount(t *testing.T) {
+	var batches []monigo.IngestRequest
+	var keys []string
+	c := ingestLimitServer(t, 2, 0, &batches, &keys)
+
+	resp, err := c.Events.Ingest(context.Background(), monigo.IngestRequest{Events: makeEvents(5)},
+		monigo.WithIdempotencyKey("batch-7"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(batches) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(batches))
+	}
+	if len(resp.Ingested) != 5 || resp.Ingested[4] != "evt-004" {
+		t.Errorf("merged response: %+v", resp)
+	}
+	if strings.Join(keys, ",") != "batch-7-0,batch-7-1,batch-7-2" {
+		t.Errorf("idempotency keys: got %v", keys)
+	}
+}
+
+func TestEvents_Ingest_SplitsByBytes(t *testing.T) {
+	var batches []monigo.IngestRequest
+	var keys []string
+	one, _ := json.Marshal(makeEvents(1)[0])
+	// Room for exactly three events per request.
+	c := ingestLimitServer(t, 0, len(`{"events":[]}`)+3*len(one)+2, &batches, &keys)
+
+	resp, err := c.Events.Ingest(context.Background(), monigo.IngestRequest{Events: makeEvents(7)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var sizes []int
+	for _, b := range batches {
+		sizes = append(sizes, len(b.Events))
+	}
+	if fmt.Sprint(sizes) != "[3 3 1]" {
+		t.Errorf("batch sizes: got %v, want [3 3 1]", sizes)
+	}
+	if len(resp.Ingested) != 7 {
+		t.Errorf("expected 7 ingested, got %d", len(resp.Ingested))
+	}
+}
+
+func TestEvents_Ingest_NoSplitWithinLimits(t *testing.T) {
+	var batches []monigo.IngestRequest
+	var keys []string
+	c := ingestLimitServer(t, 10, 0, &batches, &keys)
+
+	if _, err := c.Events.Ingest(context.Background(), monigo.IngestRequest{Events: makeEvents(10)},
+		monigo.WithIdempotencyKey("batch-8")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(batches) != 1 || keys[0] != "batch-8" {
+		t.Errorf("expected one unsplit request keyed batch-8, got %d requests %v", len(batches), keys)
+	}
+}
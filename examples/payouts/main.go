@@ -3,7 +3,7 @@
 //  1. Create a payout account for an existing customer
 //  2. List all payout accounts for that customer
 //  3. Start an event replay for the last 24 hours
-//  4. Poll the replay job until it completes
+//  4. Wait for the replay job to finish
 //
 // Run:
 //
@@ -12,6 +12,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -79,29 +80,24 @@ func main() {
 	fmt.Println("\n→ Starting event replay for last 24 hours...")
 	to := time.Now().UTC()
 	from := to.Add(-24 * time.Hour)
-	job, err := client.Events.StartReplay(ctx, from, to, nil)
+	job, err := client.Events.StartReplay(ctx, monigo.ReplayRequest{From: from, To: to})
 	if err != nil {
 		log.Fatalf("start replay: %v", err)
 	}
 	fmt.Printf("  ✓ Replay job started: %s (status: %s)\n", job.ID, job.Status)
 
 	// -----------------------------------------------------------------------
-	// 4. Poll until complete (with a timeout)
+	// 4. Wait until complete (with a timeout)
 	// -----------------------------------------------------------------------
-	fmt.Println("\n→ Polling replay job status...")
-	deadline := time.Now().Add(2 * time.Minute)
-	for time.Now().Before(deadline) {
-		time.Sleep(3 * time.Second)
-		updated, err := client.Events.GetReplay(ctx, job.ID)
-		if err != nil {
-			log.Fatalf("get replay: %v", err)
-		}
-		fmt.Printf("  Status: %-12s  replayed=%d/%d\n",
-			updated.Status, updated.EventsReplayed, updated.EventsTotal)
-		if updated.Status == "completed" || updated.Status == "failed" {
-			job = updated
-			break
-		}
+	fmt.Println("\n→ Waiting for replay job...")
+	job, err = client.Events.WaitForReplay(ctx, job.ID, monigo.PollOptions{
+		Timeout: 2 * time.Minute,
+		Progress: func(done, total int64) {
+			fmt.Printf("  replayed=%d/%d\n", done, total)
+		},
+	})
+	if err != nil && !errors.Is(err, monigo.ErrJobFailed) {
+		log.Fatalf("wait for replay: %v", err)
 	}
 
 	fmt.Printf("\n✅ Replay finished with status: %s\n", job.Status)
@@ -172,7 +172,7 @@ func main() {
 	packageTiers := mustMarshal(monigo.PackageConfig{
 		PackageSize:         1000,         // 1 000 SMS per bundle
 		PackagePrice:        "500.000000", // ₦500 per bundle
-		RoundUpPartialBlock: true,         // partial bundle rounds up
+		// RoundUpPartialBlock defaults to true: a partial bundle rounds up
 	})
 	packagePlan, err := client.Plans.Create(ctx, monigo.CreatePlanRequest{
 		Name:          "Package – SMS Bundle",
@@ -282,5 +282,5 @@ func pricingModelSummary(p *monigo.Plan) string {
 	if len(p.Prices) == 0 {
 		return "—"
 	}
-	return p.Prices[0].Model
+	return string(p.Prices[0].Model)
 }
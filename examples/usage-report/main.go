@@ -92,7 +92,7 @@ func main() {
 	w.Flush()
 
 	// Total value per aggregation type
-	totals := map[string]float64{}
+	totals := map[monigo.Aggregation]float64{}
 	for _, r := range result.Rollups {
 		totals[r.Aggregation] += r.Value
 	}
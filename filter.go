@@ -0,0 +1,125 @@
+package monigo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Filter is a composable property filter accepted by UsageService.Query and
+// EventService.List. Build one with Where and combine filters with And/Or:
+//
+//	f := monigo.Where("properties.region").Eq("lagos").
+//		And(monigo.Where("properties.tier").In("pro", "enterprise"))
+//
+// Filters serialize to the API's filter syntax via String, e.g.
+//
+//	properties.region eq "lagos" and properties.tier in ["pro","enterprise"]
+//
+// The zero Filter matches everything.
+type Filter struct {
+	expr string
+	op   string // top-level boolean operator ("and", "or") or "" for a single condition
+}
+
+// FilterField names the event field or property a condition applies to.
+// Properties are addressed as "properties.<key>".
+type FilterField string
+
+// Where starts a filter condition on field, e.g. Where("properties.region").
+func Where(field string) FilterField {
+	return FilterField(field)
+}
+
+// Eq matches when the field equals v.
+func (f FilterField) Eq(v any) Filter { return f.cond("eq", v) }
+
+// Neq matches when the field does not equal v.
+func (f FilterField) Neq(v any) Filter { return f.cond("neq", v) }
+
+// Gt matches when the field is greater than v.
+func (f FilterField) Gt(v any) Filter { return f.cond("gt", v) }
+
+// Gte matches when the field is greater than or equal to v.
+func (f FilterField) Gte(v any) Filter { return f.cond("gte", v) }
+
+// Lt matches when the field is less than v.
+func (f FilterField) Lt(v any) Filter { return f.cond("lt", v) }
+
+// Lte matches when the field is less than or equal to v.
+func (f FilterField) Lte(v any) Filter { return f.cond("lte", v) }
+
+// In matches when the field equals any of vs.
+func (f FilterField) In(vs ...any) Filter {
+	if vs == nil {
+		vs = []any{}
+	}
+	return f.cond("in", vs)
+}
+
+// Exists matches when the field is present on the event.
+func (f FilterField) Exists() Filter {
+	return Filter{expr: string(f) + " exists"}
+}
+
+func (f FilterField) cond(op string, v any) Filter {
+	return Filter{expr: string(f) + " " + op + " " + formatFilterValue(v)}
+}
+
+// And returns a filter matching when f and every one of others match.
+func (f Filter) And(others ...Filter) Filter {
+	return combineFilters("and", append([]Filter{f}, others...))
+}
+
+// Or returns a filter matching when f or any one of others match.
+func (f Filter) Or(others ...Filter) Filter {
+	return combineFilters("or", append([]Filter{f}, others...))
+}
+
+// IsZero reports whether f is the empty filter.
+func (f Filter) IsZero() bool {
+	return f.expr == ""
+}
+
+// String returns the filter in the API's filter syntax.
+func (f Filter) String() string {
+	return f.expr
+}
+
+// combineFilters joins the non-empty filters with op, parenthesizing any
+// operand whose own top-level operator differs from op.
+func combineFilters(op string, filters []Filter) Filter {
+	parts := make([]string, 0, len(filters))
+	for _, f := range filters {
+		if f.IsZero() {
+			continue
+		}
+		if f.op != "" && f.op != op {
+			parts = append(parts, "("+f.expr+")")
+		} else {
+			parts = append(parts, f.expr)
+		}
+	}
+	switch len(parts) {
+	case 0:
+		return Filter{}
+	case 1:
+		for _, f := range filters {
+			if !f.IsZero() {
+				return f
+			}
+		}
+	}
+	return Filter{expr: strings.Join(parts, " "+op+" "), op: op}
+}
+
+// formatFilterValue renders v as a JSON literal, falling back to a quoted
+// string for values JSON cannot represent.
+func formatFilterValue(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return strconv.Quote(fmt.Sprint(v))
+	}
+	return string(b)
+}
@@ -0,0 +1,45 @@
+package monigo_test
+
+import (
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestFilter_String(t *testing.T) {
+	cases := []struct {
+		name string
+		f    monigo.Filter
+		want string
+	}{
+		{"zero", monigo.Filter{}, ""},
+		{"eq string", monigo.Where("properties.region").Eq("lagos"), `properties.region eq "lagos"`},
+		{"gt number", monigo.Where("properties.tokens").Gt(1000), `properties.tokens gt 1000`},
+		{"in", monigo.Where("properties.tier").In("pro", "enterprise"), `properties.tier in ["pro","enterprise"]`},
+		{"exists", monigo.Where("properties.model").Exists(), `properties.model exists`},
+		{
+			"and",
+			monigo.Where("properties.region").Eq("lagos").And(monigo.Where("properties.tokens").Gte(10)),
+			`properties.region eq "lagos" and properties.tokens gte 10`,
+		},
+		{
+			"and of or is parenthesized",
+			monigo.Where("properties.region").Eq("lagos").
+				And(monigo.Where("properties.tier").Eq("pro").Or(monigo.Where("properties.tier").Eq("team"))),
+			`properties.region eq "lagos" and (properties.tier eq "pro" or properties.tier eq "team")`,
+		},
+		{
+			"zero operands are dropped",
+			monigo.Filter{}.And(monigo.Where("event_name").Neq("ping")),
+			`event_name neq "ping"`,
+		},
+		{"quotes are escaped", monigo.Where("properties.name").Eq(`a"b`), `properties.name eq "a\"b"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.f.String(); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
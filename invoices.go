@@ -2,8 +2,9 @@ package monigo
 
 import (
 	"context"
-	"fmt"
 	"net/url"
+	"strconv"
+	"time"
 )
 
 // InvoiceService manages invoice generation, retrieval, finalization, and voiding.
@@ -24,18 +25,131 @@ func (s *InvoiceService) Generate(ctx context.Context, subscriptionID string, op
 	return &wrapper.Invoice, nil
 }
 
-// List returns invoices, optionally filtered by status or customer.
-func (s *InvoiceService) List(ctx context.Context, params ListInvoicesParams) (*ListInvoicesResponse, error) {
+// GenerateWithOptions creates a draft invoice for an explicit period of the
+// subscription instead of its current one, so that missed or historical
+// periods can be billed:
+//
+//	inv, err := client.Invoices.GenerateWithOptions(ctx, subID, monigo.GenerateInvoiceOptions{
+//		PeriodStart: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+//		PeriodEnd:   time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+//	})
+func (s *InvoiceService) GenerateWithOptions(ctx context.Context, subscriptionID string, gio GenerateInvoiceOptions, opts ...RequestOption) (*Invoice, error) {
+	body := GenerateInvoiceRequest{SubscriptionID: subscriptionID, CustomFields: gio.CustomFields}
+	if !gio.PeriodStart.IsZero() {
+		body.PeriodStart = &gio.PeriodStart
+	}
+	if !gio.PeriodEnd.IsZero() {
+		body.PeriodEnd = &gio.PeriodEnd
+	}
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+	var wrapper struct {
+		Invoice Invoice `json:"invoice"`
+	}
+	if err := s.client.do(ctx, "POST", "/v1/invoices/generate", body, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Invoice, nil
+}
+
+// GenerateAsync queues draft invoice generation for the subscription and
+// returns immediately with a job record. Prefer it over Generate for
+// subscriptions with very large event volumes, where the synchronous call can
+// time out. Poll GetGenerationJob until the job reaches "completed", then
+// fetch the invoice with Get.
+func (s *InvoiceService) GenerateAsync(ctx context.Context, subscriptionID string, opts ...RequestOption) (*InvoiceGenerationJob, error) {
+	var wrapper struct {
+		Job InvoiceGenerationJob `json:"job"`
+	}
+	body := GenerateInvoiceRequest{SubscriptionID: subscriptionID}
+	if err := s.client.do(ctx, "POST", "/v1/invoices/generate/jobs", body, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Job, nil
+}
+
+// GetGenerationJob fetches the current status of an invoice generation job.
+func (s *InvoiceService) GetGenerationJob(ctx context.Context, jobID string) (*InvoiceGenerationJob, error) {
+	var wrapper struct {
+		Job InvoiceGenerationJob `json:"job"`
+	}
+	path, err := pathf("/v1/invoices/generate/jobs/%s", jobID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Job, nil
+}
+
+// GenerateBatch queues draft invoice generation for every subscription whose
+// current period has ended, optionally limited to one plan. It returns
+// immediately; poll GetBatch for progress. Set DryRun to see how many
+// subscriptions would be invoiced.
+func (s *InvoiceService) GenerateBatch(ctx context.Context, req GenerateBatchRequest, opts ...RequestOption) (*InvoiceBatchJob, error) {
+	var wrapper struct {
+		Batch InvoiceBatchJob `json:"batch"`
+	}
+	if err := s.client.do(ctx, "POST", "/v1/invoices/generate/batches", req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Batch, nil
+}
+
+// GetBatch fetches the current progress of a batch started by GenerateBatch.
+func (s *InvoiceService) GetBatch(ctx context.Context, batchID string) (*InvoiceBatchJob, error) {
+	var wrapper struct {
+		Batch InvoiceBatchJob `json:"batch"`
+	}
+	path, err := pathf("/v1/invoices/generate/batches/%s", batchID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Batch, nil
+}
+
+// Progress returns the fraction of the batch processed so far, between 0
+// and 1. An empty batch reports 1.
+func (j *InvoiceBatchJob) Progress() float64 {
+	if j.Total == 0 {
+		return 1
+	}
+	return float64(j.Processed) / float64(j.Total)
+}
+
+// Preview returns a projected invoice for the subscription's current period
+// as of asOf, without persisting anything. Use it to show customers their
+// bill so far; no draft invoice is created. Pass the zero time to preview as
+// of now.
+func (s *InvoiceService) Preview(ctx context.Context, subscriptionID string, asOf time.Time) (*Invoice, error) {
+	if err := checkID(subscriptionID); err != nil {
+		return nil, err
+	}
 	q := url.Values{}
-	if params.Status != "" {
-		q.Set("status", params.Status)
+	q.Set("subscription_id", subscriptionID)
+	if !asOf.IsZero() {
+		q.Set("as_of", asOf.UTC().Format(time.RFC3339))
 	}
-	if params.CustomerID != "" {
-		q.Set("customer_id", params.CustomerID)
+
+	var wrapper struct {
+		Invoice Invoice `json:"invoice"`
+	}
+	if err := s.client.do(ctx, "GET", "/v1/invoices/preview?"+q.Encode(), nil, &wrapper); err != nil {
+		return nil, err
 	}
+	return &wrapper.Invoice, nil
+}
 
+// List returns invoices matching params. All filters are optional and
+// combine with AND; use Page and PerPage to walk large result sets.
+func (s *InvoiceService) List(ctx context.Context, params ListInvoicesParams) (*ListInvoicesResponse, error) {
 	path := "/v1/invoices"
-	if len(q) > 0 {
+	if q := params.values(); len(q) > 0 {
 		path = path + "?" + q.Encode()
 	}
 
@@ -51,7 +165,77 @@ func (s *InvoiceService) Get(ctx context.Context, invoiceID string) (*Invoice, e
 	var wrapper struct {
 		Invoice Invoice `json:"invoice"`
 	}
-	if err := s.client.do(ctx, "GET", fmt.Sprintf("/v1/invoices/%s", invoiceID), nil, &wrapper); err != nil {
+	path, err := pathf("/v1/invoices/%s", invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Invoice, nil
+}
+
+// AddLineItem adds a manual adjustment line, such as a setup fee or one-off
+// discount, to a draft invoice and returns the invoice with recomputed
+// totals. Returns a 409 error with ErrCodeInvoiceNotDraft once the invoice
+// is finalized.
+func (s *InvoiceService) AddLineItem(ctx context.Context, invoiceID string, req AddLineItemRequest, opts ...RequestOption) (*Invoice, error) {
+	var wrapper struct {
+		Invoice Invoice `json:"invoice"`
+	}
+	path, err := pathf("/v1/invoices/%s/line-items", invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Invoice, nil
+}
+
+// UpdateLineItem changes a manual line on a draft invoice and returns the
+// invoice with recomputed totals.
+func (s *InvoiceService) UpdateLineItem(ctx context.Context, invoiceID, lineItemID string, req UpdateLineItemRequest, opts ...RequestOption) (*Invoice, error) {
+	var wrapper struct {
+		Invoice Invoice `json:"invoice"`
+	}
+	path, err := pathf("/v1/invoices/%s/line-items/%s", invoiceID, lineItemID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "PUT", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Invoice, nil
+}
+
+// RemoveLineItem deletes a line from a draft invoice and returns the invoice
+// with recomputed totals.
+func (s *InvoiceService) RemoveLineItem(ctx context.Context, invoiceID, lineItemID string) (*Invoice, error) {
+	var wrapper struct {
+		Invoice Invoice `json:"invoice"`
+	}
+	path, err := pathf("/v1/invoices/%s/line-items/%s", invoiceID, lineItemID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "DELETE", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Invoice, nil
+}
+
+// SetTaxOverride replaces the tax rates applied to a draft invoice, or
+// exempts it from tax, and returns the invoice with recomputed totals.
+func (s *InvoiceService) SetTaxOverride(ctx context.Context, invoiceID string, override InvoiceTaxOverride, opts ...RequestOption) (*Invoice, error) {
+	var wrapper struct {
+		Invoice Invoice `json:"invoice"`
+	}
+	path, err := pathf("/v1/invoices/%s/tax", invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "PUT", path, override, &wrapper, opts...); err != nil {
 		return nil, err
 	}
 	return &wrapper.Invoice, nil
@@ -63,7 +247,27 @@ func (s *InvoiceService) Finalize(ctx context.Context, invoiceID string, opts ..
 	var wrapper struct {
 		Invoice Invoice `json:"invoice"`
 	}
-	if err := s.client.do(ctx, "POST", fmt.Sprintf("/v1/invoices/%s/finalize", invoiceID), nil, &wrapper, opts...); err != nil {
+	path, err := pathf("/v1/invoices/%s/finalize", invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, nil, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Invoice, nil
+}
+
+// FinalizeWithOptions finalizes a draft invoice like Finalize, setting its
+// payment terms, an explicit due date, or its custom fields.
+func (s *InvoiceService) FinalizeWithOptions(ctx context.Context, invoiceID string, fo FinalizeInvoiceOptions, opts ...RequestOption) (*Invoice, error) {
+	var wrapper struct {
+		Invoice Invoice `json:"invoice"`
+	}
+	path, err := pathf("/v1/invoices/%s/finalize", invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, fo, &wrapper, opts...); err != nil {
 		return nil, err
 	}
 	return &wrapper.Invoice, nil
@@ -74,8 +278,136 @@ func (s *InvoiceService) Void(ctx context.Context, invoiceID string, opts ...Req
 	var wrapper struct {
 		Invoice Invoice `json:"invoice"`
 	}
-	if err := s.client.do(ctx, "POST", fmt.Sprintf("/v1/invoices/%s/void", invoiceID), nil, &wrapper, opts...); err != nil {
+	path, err := pathf("/v1/invoices/%s/void", invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, nil, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Invoice, nil
+}
+
+// CreatePaymentLink returns a hosted checkout URL for paying a finalized
+// invoice, e.g. for the "Pay now" button in an invoice email. Creating a
+// link for a draft, paid, or void invoice returns a 409 error (use
+// IsConflict).
+func (s *InvoiceService) CreatePaymentLink(ctx context.Context, invoiceID string, lo PaymentLinkOptions, opts ...RequestOption) (*PaymentLink, error) {
+	var wrapper struct {
+		PaymentLink PaymentLink `json:"payment_link"`
+	}
+	path, err := pathf("/v1/invoices/%s/payment-link", invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, lo, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.PaymentLink, nil
+}
+
+// UpdateMetadata replaces the invoice's metadata. It works on invoices in
+// any status, since metadata is not part of the billed amount.
+func (s *InvoiceService) UpdateMetadata(ctx context.Context, invoiceID string, metadata Metadata, opts ...RequestOption) (*Invoice, error) {
+	var wrapper struct {
+		Invoice Invoice `json:"invoice"`
+	}
+	path, err := pathf("/v1/invoices/%s/metadata", invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	body := map[string]Metadata{"metadata": metadata}
+	if err := s.client.do(ctx, "PUT", path, body, &wrapper, opts...); err != nil {
 		return nil, err
 	}
 	return &wrapper.Invoice, nil
 }
+
+// ListCreditApplications returns the prepaid credit applied to an invoice,
+// one entry per grant it was drawn from.
+func (s *InvoiceService) ListCreditApplications(ctx context.Context, invoiceID string) (*ListCreditApplicationsResponse, error) {
+	var out ListCreditApplicationsResponse
+	path, err := pathf("/v1/invoices/%s/credit-applications", invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetNumbering returns the organisation's invoice numbering scheme.
+func (s *InvoiceService) GetNumbering(ctx context.Context) (*InvoiceNumbering, error) {
+	var wrapper struct {
+		Numbering InvoiceNumbering `json:"numbering"`
+	}
+	if err := s.client.do(ctx, "GET", "/v1/invoices/numbering", nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Numbering, nil
+}
+
+// UpdateNumbering changes the organisation's invoice numbering scheme. It
+// applies to invoices finalized afterwards; existing numbers never change.
+func (s *InvoiceService) UpdateNumbering(ctx context.Context, req UpdateInvoiceNumberingRequest, opts ...RequestOption) (*InvoiceNumbering, error) {
+	var wrapper struct {
+		Numbering InvoiceNumbering `json:"numbering"`
+	}
+	if err := s.client.do(ctx, "PUT", "/v1/invoices/numbering", req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Numbering, nil
+}
+
+// values encodes p as query parameters.
+func (p ListInvoicesParams) values() url.Values {
+	q := url.Values{}
+	if p.Status != "" {
+		q.Set("status", string(p.Status))
+	}
+	if p.CustomerID != "" {
+		q.Set("customer_id", p.CustomerID)
+	}
+	if p.SubscriptionID != "" {
+		q.Set("subscription_id", p.SubscriptionID)
+	}
+	setMetadataFilter(q, p.MetadataFilter)
+	if p.Currency != "" {
+		q.Set("currency", p.Currency)
+	}
+	if p.IsTest != nil {
+		q.Set("is_test", strconv.FormatBool(*p.IsTest))
+	}
+	if p.PeriodStartFrom != nil {
+		q.Set("period_start_from", p.PeriodStartFrom.UTC().Format(time.RFC3339))
+	}
+	if p.PeriodStartTo != nil {
+		q.Set("period_start_to", p.PeriodStartTo.UTC().Format(time.RFC3339))
+	}
+	if p.MinTotal != "" {
+		q.Set("min_total", p.MinTotal)
+	}
+	if p.MaxTotal != "" {
+		q.Set("max_total", p.MaxTotal)
+	}
+	if p.Overdue {
+		q.Set("overdue", "true")
+	}
+	if p.DueBefore != nil {
+		q.Set("due_before", p.DueBefore.UTC().Format(time.RFC3339))
+	}
+	if p.DunningStatus != "" {
+		q.Set("dunning_status", p.DunningStatus)
+	}
+	if p.Sort != "" {
+		q.Set("sort", p.Sort)
+	}
+	if p.Page > 0 {
+		q.Set("page", strconv.Itoa(p.Page))
+	}
+	if p.PerPage > 0 {
+		q.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	return q
+}
@@ -2,6 +2,7 @@ package monigo_test
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"testing"
 	"time"
@@ -65,6 +66,128 @@ func TestInvoices_Generate(t *testing.T) {
 	}
 }
 
+func TestInvoices_GenerateAsync(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/invoices/generate/jobs")
+
+		var req monigo.GenerateInvoiceRequest
+		decodeBody(t, r, &req)
+		if req.SubscriptionID != "sub-1" {
+			t.Errorf("subscription_id: got %q, want sub-1", req.SubscriptionID)
+		}
+		respondJSON(t, w, 202, map[string]any{"job": monigo.InvoiceGenerationJob{
+			ID:             "job-1",
+			SubscriptionID: "sub-1",
+			Status:         monigo.JobStatusPending,
+		}})
+	}))
+
+	job, err := c.Invoices.GenerateAsync(context.Background(), "sub-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.ID != "job-1" || job.Status != monigo.JobStatusPending {
+		t.Errorf("unexpected job: %+v", job)
+	}
+}
+
+func TestInvoices_GetGenerationJob(t *testing.T) {
+	invoiceID := "inv-1"
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/invoices/generate/jobs/job-1")
+		respondJSON(t, w, 200, map[string]any{"job": monigo.InvoiceGenerationJob{
+			ID:        "job-1",
+			Status:    monigo.JobStatusCompleted,
+			InvoiceID: &invoiceID,
+		}})
+	}))
+
+	job, err := c.Invoices.GetGenerationJob(context.Background(), "job-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.InvoiceID == nil || *job.InvoiceID != "inv-1" {
+		t.Errorf("expected invoice_id inv-1, got %v", job.InvoiceID)
+	}
+}
+
+func TestInvoices_GenerateBatch(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/invoices/generate/batches")
+
+		var req monigo.GenerateBatchRequest
+		decodeBody(t, r, &req)
+		if req.PlanID != "plan-1" || !req.DryRun {
+			t.Errorf("unexpected request: %+v", req)
+		}
+		respondJSON(t, w, 202, map[string]any{"batch": monigo.InvoiceBatchJob{
+			ID: "batch-1", Status: monigo.JobStatusPending, DryRun: true, Total: 40,
+		}})
+	}))
+
+	batch, err := c.Invoices.GenerateBatch(context.Background(), monigo.GenerateBatchRequest{PlanID: "plan-1", DryRun: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if batch.ID != "batch-1" || batch.Total != 40 {
+		t.Errorf("unexpected batch: %+v", batch)
+	}
+}
+
+func TestInvoices_GetBatch(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/invoices/generate/batches/batch-1")
+		respondJSON(t, w, 200, map[string]any{"batch": monigo.InvoiceBatchJob{
+			ID: "batch-1", Status: monigo.JobStatusRunning, Total: 40, Processed: 10, Succeeded: 9, Failed: 1,
+			Errors: []monigo.InvoiceBatchError{{SubscriptionID: "sub-9", Message: "customer archived"}},
+		}})
+	}))
+
+	batch, err := c.Invoices.GetBatch(context.Background(), "batch-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := batch.Progress(); got != 0.25 {
+		t.Errorf("progress: got %v, want 0.25", got)
+	}
+	if len(batch.Errors) != 1 || batch.Errors[0].SubscriptionID != "sub-9" {
+		t.Errorf("unexpected errors: %+v", batch.Errors)
+	}
+}
+
+func TestInvoices_Preview(t *testing.T) {
+	asOf := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/invoices/preview")
+		q := r.URL.Query()
+		if q.Get("subscription_id") != "sub-1" {
+			t.Errorf("subscription_id: got %q, want sub-1", q.Get("subscription_id"))
+		}
+		if q.Get("as_of") != "2026-03-15T12:00:00Z" {
+			t.Errorf("as_of: got %q, want 2026-03-15T12:00:00Z", q.Get("as_of"))
+		}
+		preview := sampleInvoice
+		preview.ID = ""
+		respondJSON(t, w, 200, map[string]any{"invoice": preview})
+	}))
+
+	inv, err := c.Invoices.Preview(context.Background(), "sub-1", asOf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inv.ID != "" {
+		t.Errorf("expected preview to have no ID, got %s", inv.ID)
+	}
+	if inv.Total != "10000.00" {
+		t.Errorf("expected total 10000.00, got %s", inv.Total)
+	}
+}
+
 func TestInvoices_List_NoFilters(t *testing.T) {
 	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertMethod(t, r, "GET")
@@ -111,6 +234,49 @@ func TestInvoices_List_WithFilters(t *testing.T) {
 	}
 }
 
+func TestInvoices_List_RangeAndPagination(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := map[string]string{
+			"subscription_id":   "sub-1",
+			"currency":          "USD",
+			"period_start_from": "2026-01-01T00:00:00Z",
+			"period_start_to":   "2026-04-01T00:00:00Z",
+			"min_total":         "1000.00",
+			"max_total":         "5000.00",
+			"sort":              "-total",
+			"page":              "3",
+			"per_page":          "100",
+		}
+		q := r.URL.Query()
+		for k, v := range want {
+			if got := q.Get(k); got != v {
+				t.Errorf("%s: got %q, want %q", k, got, v)
+			}
+		}
+		respondJSON(t, w, 200, monigo.ListInvoicesResponse{Invoices: []monigo.Invoice{sampleInvoice}, Count: 1, Total: 201})
+	}))
+
+	resp, err := c.Invoices.List(context.Background(), monigo.ListInvoicesParams{
+		SubscriptionID:  "sub-1",
+		Currency:        "USD",
+		PeriodStartFrom: &from,
+		PeriodStartTo:   &to,
+		MinTotal:        "1000.00",
+		MaxTotal:        "5000.00",
+		Sort:            "-total",
+		Page:            3,
+		PerPage:         100,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Total != 201 {
+		t.Errorf("expected total 201, got %d", resp.Total)
+	}
+}
+
 func TestInvoices_Get(t *testing.T) {
 	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertMethod(t, r, "GET")
@@ -127,6 +293,42 @@ func TestInvoices_Get(t *testing.T) {
 	}
 }
 
+func TestInvoices_Get_TaxBreakdown(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"invoice": {
+			"id": "inv-1",
+			"vat_amount": "750.00",
+			"withholding_tax_amount": "500.00",
+			"line_items": [{
+				"id": "li-1",
+				"amount": "10000.00",
+				"tax_rate_id": "tax-vat",
+				"tax_rate": "7.5",
+				"tax_amount": "750.00",
+				"withholding_rate": "5",
+				"withholding_amount": "500.00"
+			}],
+			"tax_summary": [
+				{"tax_rate_id": "tax-vat", "name": "VAT", "type": "vat", "percent": "7.5", "taxable_amount": "10000.00", "tax_amount": "750.00"},
+				{"tax_rate_id": "tax-wht", "name": "WHT", "type": "withholding", "percent": "5", "taxable_amount": "10000.00", "tax_amount": "500.00"}
+			]
+		}}`))
+	}))
+
+	inv, err := c.Invoices.Get(context.Background(), "inv-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	li := inv.LineItems[0]
+	if li.TaxRate != "7.5" || li.TaxAmount != "750.00" || li.WithholdingAmount != "500.00" {
+		t.Errorf("unexpected line tax: %+v", li)
+	}
+	if len(inv.TaxSummary) != 2 || inv.TaxSummary[1].Type != monigo.TaxTypeWithholding {
+		t.Errorf("unexpected tax summary: %+v", inv.TaxSummary)
+	}
+}
+
 func TestInvoices_Get_NotFound(t *testing.T) {
 	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		respondError(t, w, 404, "invoice not found")
@@ -161,6 +363,263 @@ func TestInvoices_Finalize(t *testing.T) {
 	}
 }
 
+func TestInvoices_FinalizeWithOptions(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/invoices/inv-1/finalize")
+
+		var req monigo.FinalizeInvoiceOptions
+		decodeBody(t, r, &req)
+		if req.PaymentTerms != monigo.PaymentTermsNet30 {
+			t.Errorf("payment_terms: got %q, want net_30", req.PaymentTerms)
+		}
+		inv := sampleInvoice
+		inv.Status = monigo.InvoiceStatusFinalized
+		inv.PaymentTerms = req.PaymentTerms
+		due := time.Now().AddDate(0, 0, 30)
+		inv.DueAt = &due
+		inv.DunningStatus = monigo.DunningStatusNone
+		respondJSON(t, w, 200, map[string]any{"invoice": inv})
+	}))
+
+	inv, err := c.Invoices.FinalizeWithOptions(context.Background(), "inv-1", monigo.FinalizeInvoiceOptions{
+		PaymentTerms: monigo.PaymentTermsNet30,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inv.DueAt == nil || inv.PaymentTerms != monigo.PaymentTermsNet30 {
+		t.Errorf("expected due date and terms, got due=%v terms=%q", inv.DueAt, inv.PaymentTerms)
+	}
+}
+
+func TestInvoices_List_Overdue(t *testing.T) {
+	dueBefore := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("overdue") != "true" {
+			t.Errorf("overdue: got %q, want true", q.Get("overdue"))
+		}
+		if q.Get("due_before") != "2026-06-01T00:00:00Z" {
+			t.Errorf("due_before: got %q", q.Get("due_before"))
+		}
+		if q.Get("dunning_status") != "active" {
+			t.Errorf("dunning_status: got %q", q.Get("dunning_status"))
+		}
+		respondJSON(t, w, 200, monigo.ListInvoicesResponse{})
+	}))
+
+	_, err := c.Invoices.List(context.Background(), monigo.ListInvoicesParams{
+		Overdue:       true,
+		DueBefore:     &dueBefore,
+		DunningStatus: monigo.DunningStatusActive,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestInvoices_List_ExcludeTest(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("is_test"); got != "false" {
+			t.Errorf("is_test: got %q, want false", got)
+		}
+		respondJSON(t, w, 200, monigo.ListInvoicesResponse{})
+	}))
+
+	live := false
+	if _, err := c.Invoices.List(context.Background(), monigo.ListInvoicesParams{IsTest: &live}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestInvoices_AddLineItem(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/invoices/inv-1/line-items")
+
+		var req monigo.AddLineItemRequest
+		decodeBody(t, r, &req)
+		if req.Description != "Setup fee" || req.Amount != "25000.00" {
+			t.Errorf("unexpected request: %+v", req)
+		}
+		inv := sampleInvoice
+		inv.LineItems = append(inv.LineItems, monigo.InvoiceLineItem{
+			ID: "li-2", Type: monigo.InvoiceLineItemTypeAdjustment, Description: req.Description, Amount: req.Amount,
+		})
+		inv.Total = "35000.00"
+		respondJSON(t, w, 200, map[string]any{"invoice": inv})
+	}))
+
+	inv, err := c.Invoices.AddLineItem(context.Background(), "inv-1", monigo.AddLineItemRequest{
+		Description: "Setup fee",
+		Amount:      "25000.00",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inv.LineItems) != 2 || inv.Total != "35000.00" {
+		t.Errorf("unexpected invoice: %+v", inv)
+	}
+}
+
+func TestInvoices_UpdateLineItem(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "PUT")
+		assertPath(t, r, "/v1/invoices/inv-1/line-items/li-2")
+
+		var req monigo.UpdateLineItemRequest
+		decodeBody(t, r, &req)
+		if req.Amount != "-500.00" {
+			t.Errorf("amount: got %q", req.Amount)
+		}
+		respondJSON(t, w, 200, map[string]any{"invoice": sampleInvoice})
+	}))
+
+	if _, err := c.Invoices.UpdateLineItem(context.Background(), "inv-1", "li-2", monigo.UpdateLineItemRequest{Amount: "-500.00"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestInvoices_RemoveLineItem_NotDraft(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "DELETE")
+		assertPath(t, r, "/v1/invoices/inv-1/line-items/li-1")
+		respondJSON(t, w, 409, map[string]string{"error": "invoice is finalized", "code": "invoice_not_draft"})
+	}))
+
+	_, err := c.Invoices.RemoveLineItem(context.Background(), "inv-1", "li-1")
+	if !errors.Is(err, monigo.ErrCodeInvoiceNotDraft) {
+		t.Errorf("expected ErrCodeInvoiceNotDraft, got %v", err)
+	}
+}
+
+func TestInvoices_CreatePaymentLink(t *testing.T) {
+	expires := time.Now().Add(7 * 24 * time.Hour).UTC().Truncate(time.Second)
+
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/invoices/inv-1/payment-link")
+
+		var body monigo.PaymentLinkOptions
+		decodeBody(t, r, &body)
+		if body.RedirectURL != "https://example.com/thanks" || body.ExpiresInSeconds != 3600 {
+			t.Errorf("unexpected body: %+v", body)
+		}
+		respondJSON(t, w, 201, map[string]any{"payment_link": monigo.PaymentLink{
+			ID:        "plink-1",
+			InvoiceID: "inv-1",
+			URL:       "https://pay.monigo.co/plink-1",
+			ExpiresAt: expires,
+		}})
+	}))
+
+	link, err := c.Invoices.CreatePaymentLink(context.Background(), "inv-1", monigo.PaymentLinkOptions{
+		ExpiresInSeconds: 3600,
+		RedirectURL:      "https://example.com/thanks",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if link.URL != "https://pay.monigo.co/plink-1" || !link.ExpiresAt.Equal(expires) {
+		t.Errorf("unexpected link: %+v", link)
+	}
+}
+
+func TestInvoices_GenerateWithOptions_CustomFields(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/invoices/generate")
+
+		var body monigo.GenerateInvoiceRequest
+		decodeBody(t, r, &body)
+		if body.SubscriptionID != "sub-1" || len(body.CustomFields) != 1 || body.CustomFields[0].Value != "PO-7781" {
+			t.Errorf("unexpected body: %+v", body)
+		}
+		inv := sampleInvoice
+		inv.CustomFields = body.CustomFields
+		respondJSON(t, w, 201, map[string]any{"invoice": inv})
+	}))
+
+	inv, err := c.Invoices.GenerateWithOptions(context.Background(), "sub-1", monigo.GenerateInvoiceOptions{
+		CustomFields: []monigo.InvoiceCustomField{{Name: "PO number", Value: "PO-7781"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inv.CustomFields) != 1 {
+		t.Errorf("expected custom fields on invoice, got %+v", inv.CustomFields)
+	}
+}
+
+func TestInvoices_GenerateWithOptions_Period(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertPath(t, r, "/v1/invoices/generate")
+
+		var body monigo.GenerateInvoiceRequest
+		decodeBody(t, r, &body)
+		if body.PeriodStart == nil || !body.PeriodStart.Equal(start) || body.PeriodEnd == nil || !body.PeriodEnd.Equal(end) {
+			t.Errorf("unexpected period: %v – %v", body.PeriodStart, body.PeriodEnd)
+		}
+		inv := sampleInvoice
+		inv.PeriodStart, inv.PeriodEnd = *body.PeriodStart, *body.PeriodEnd
+		respondJSON(t, w, 201, map[string]any{"invoice": inv})
+	}))
+
+	inv, err := c.Invoices.GenerateWithOptions(context.Background(), "sub-1", monigo.GenerateInvoiceOptions{
+		PeriodStart: start,
+		PeriodEnd:   end,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !inv.PeriodStart.Equal(start) {
+		t.Errorf("expected the requested period, got %v", inv.PeriodStart)
+	}
+
+	_, err = c.Invoices.GenerateWithOptions(context.Background(), "sub-1", monigo.GenerateInvoiceOptions{PeriodStart: end, PeriodEnd: start})
+	var verr *monigo.RequestValidationError
+	if !errors.As(err, &verr) || verr.Fields["period_end"] == "" {
+		t.Errorf("expected a period_end validation error, got %v", err)
+	}
+}
+
+func TestInvoices_UpdateNumbering(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "PUT")
+		assertPath(t, r, "/v1/invoices/numbering")
+
+		var body map[string]any
+		decodeBody(t, r, &body)
+		if body["prefix"] != "INV-{YYYY}-" || body["padding"] != float64(6) {
+			t.Errorf("unexpected body: %v", body)
+		}
+		if _, ok := body["next_number"]; ok {
+			t.Error("next_number should be left unchanged")
+		}
+		respondJSON(t, w, 200, map[string]any{"numbering": monigo.InvoiceNumbering{
+			Prefix:     "INV-{YYYY}-",
+			NextNumber: 142,
+			Padding:    6,
+		}})
+	}))
+
+	prefix := "INV-{YYYY}-"
+	padding := 6
+	n, err := c.Invoices.UpdateNumbering(context.Background(), monigo.UpdateInvoiceNumberingRequest{
+		Prefix:  &prefix,
+		Padding: &padding,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n.NextNumber != 142 {
+		t.Errorf("expected next number 142, got %d", n.NextNumber)
+	}
+}
+
 func TestInvoices_Void(t *testing.T) {
 	voided := sampleInvoice
 	voided.Status = monigo.InvoiceStatusVoid
@@ -0,0 +1,89 @@
+package monigo
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// requestIDHeader is the response header carrying the server-assigned request ID.
+const requestIDHeader = "X-Request-Id"
+
+// logRequest writes one log record for a completed (or failed) API request.
+// It is a no-op unless the client was configured with WithLogger.
+func (c *Client) logRequest(ctx context.Context, req *http.Request, resp *http.Response, latency time.Duration, reqBody, respBody []byte, err error) {
+	if c.logger == nil {
+		return
+	}
+
+	level := slog.LevelDebug
+	if err != nil || (resp != nil && resp.StatusCode >= 400) {
+		level = slog.LevelWarn
+	}
+	if !c.logger.Enabled(ctx, level) {
+		return
+	}
+
+	// Only the path is logged: query strings carry filters such as
+	// external_id and email that should not end up in logs.
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Duration("latency", latency),
+	}
+	if resp != nil {
+		attrs = append(attrs,
+			slog.Int("status", resp.StatusCode),
+			slog.String("request_id", resp.Header.Get(requestIDHeader)),
+		)
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	if c.logBodies && c.logger.Enabled(ctx, slog.LevelDebug) {
+		if len(reqBody) > 0 {
+			attrs = append(attrs, slog.String("request_body", c.redact(reqBody)))
+		}
+		if len(respBody) > 0 {
+			attrs = append(attrs, slog.String("response_body", c.redact(respBody)))
+		}
+	}
+	c.logger.LogAttrs(ctx, level, "monigo: request", attrs...)
+}
+
+// redact returns body with the values of the configured redact keys masked.
+// Bodies that are not valid JSON are returned unchanged.
+func (c *Client) redact(body []byte) string {
+	if len(c.redactKeys) == 0 {
+		return string(body)
+	}
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+	b, err := json.Marshal(redactValue(v, c.redactKeys))
+	if err != nil {
+		return string(body)
+	}
+	return string(b)
+}
+
+func redactValue(v any, keys map[string]bool) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, inner := range t {
+			if keys[k] {
+				t[k] = "[REDACTED]"
+			} else {
+				t[k] = redactValue(inner, keys)
+			}
+		}
+	case []any:
+		for i, inner := range t {
+			t[i] = redactValue(inner, keys)
+		}
+	}
+	return v
+}
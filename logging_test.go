@@ -0,0 +1,124 @@
+package monigo_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestWithLogger_LogsRequest(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		respondJSON(t, w, 200, map[string]any{"customer": sampleCustomer})
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c := monigo.New("test_key_abc", monigo.WithBaseURL(srv.URL), monigo.WithLogger(logger))
+
+	if _, err := c.Customers.Get(context.Background(), "cust-abc"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var rec map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+		t.Fatalf("decode log record: %v (%s)", err, buf.String())
+	}
+	if rec["method"] != "GET" || rec["path"] != "/v1/customers/cust-abc" {
+		t.Errorf("unexpected method/path: %v %v", rec["method"], rec["path"])
+	}
+	if rec["status"] != float64(200) {
+		t.Errorf("status: got %v, want 200", rec["status"])
+	}
+	if rec["request_id"] != "req-123" {
+		t.Errorf("request_id: got %v, want req-123", rec["request_id"])
+	}
+	if _, ok := rec["response_body"]; ok {
+		t.Error("bodies should not be logged without WithLogBodies")
+	}
+}
+
+func TestWithLogger_OmitsQuery(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, 200, monigo.ListCustomersResponse{Customers: []monigo.Customer{sampleCustomer}, Count: 1})
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c := monigo.New("test_key_abc", monigo.WithBaseURL(srv.URL), monigo.WithLogger(logger))
+
+	c.Customers.GetByExternalID(context.Background(), "user-001@example.com")
+	if buf.Len() == 0 {
+		t.Fatal("expected a log record")
+	}
+	if strings.Contains(buf.String(), "user-001") {
+		t.Errorf("query values should not be logged: %s", buf.String())
+	}
+}
+
+func TestWithLogger_RespectsLevel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/customers/missing" {
+			respondError(t, w, 404, "not found")
+			return
+		}
+		respondJSON(t, w, 200, map[string]any{"customer": sampleCustomer})
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	c := monigo.New("test_key_abc", monigo.WithBaseURL(srv.URL), monigo.WithLogger(logger))
+
+	_, _ = c.Customers.Get(context.Background(), "cust-abc")
+	if buf.Len() != 0 {
+		t.Errorf("successful request should not be logged at warn level: %s", buf.String())
+	}
+	_, _ = c.Customers.Get(context.Background(), "missing")
+	if !strings.Contains(buf.String(), "status=404") {
+		t.Errorf("expected failed request to be logged, got %q", buf.String())
+	}
+}
+
+func TestWithLogBodies_Redacts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, 201, map[string]any{"customer": sampleCustomer})
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c := monigo.New("test_key_abc",
+		monigo.WithBaseURL(srv.URL),
+		monigo.WithLogger(logger),
+		monigo.WithLogBodies("email"),
+	)
+
+	_, err := c.Customers.Create(context.Background(), monigo.CreateCustomerRequest{
+		ExternalID: "ext-1",
+		Name:       "Acme Corp",
+		Email:      "secret@acme.example",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := buf.String()
+	if strings.Contains(out, "secret@acme.example") || strings.Contains(out, "acme@example.com") {
+		t.Errorf("email was not redacted: %s", out)
+	}
+	if !strings.Contains(out, "[REDACTED]") || !strings.Contains(out, "ext-1") {
+		t.Errorf("expected redacted body to be logged: %s", out)
+	}
+	if strings.Contains(out, "test_key_abc") {
+		t.Error("API key must never be logged")
+	}
+}
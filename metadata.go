@@ -0,0 +1,71 @@
+package monigo
+
+import (
+	"net/url"
+	"sort"
+)
+
+// Metadata is arbitrary key-value data attached to a resource. Values are
+// whatever JSON decodes to: string, float64, bool, nil, []any, or
+// map[string]any. A nil Metadata is omitted from requests.
+type Metadata map[string]any
+
+// Get returns the value stored under key.
+func (m Metadata) Get(key string) (any, bool) {
+	v, ok := m[key]
+	return v, ok
+}
+
+// GetString returns the value under key if it is a string.
+func (m Metadata) GetString(key string) (string, bool) {
+	v, ok := m[key].(string)
+	return v, ok
+}
+
+// GetFloat returns the value under key if it is a number.
+func (m Metadata) GetFloat(key string) (float64, bool) {
+	switch v := m[key].(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+// GetBool returns the value under key if it is a boolean.
+func (m Metadata) GetBool(key string) (bool, bool) {
+	v, ok := m[key].(bool)
+	return v, ok
+}
+
+// Set stores v under key, allocating m if needed.
+func (m *Metadata) Set(key string, v any) {
+	if *m == nil {
+		*m = Metadata{}
+	}
+	(*m)[key] = v
+}
+
+// Merge copies every key of other into m, overwriting existing values.
+func (m *Metadata) Merge(other Metadata) {
+	for k, v := range other {
+		m.Set(k, v)
+	}
+}
+
+// setMetadataFilter adds filter to q as metadata[key]=value parameters, in
+// key order.
+func setMetadataFilter(q url.Values, filter map[string]string) {
+	keys := make([]string, 0, len(filter))
+	for k := range filter {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		q.Set("metadata["+k+"]", filter[k])
+	}
+}
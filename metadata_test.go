@@ -0,0 +1,84 @@
+package monigo_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestMetadata_Accessors(t *testing.T) {
+	var m monigo.Metadata
+	m.Set("tier", "enterprise")
+	m.Merge(monigo.Metadata{"seats": 25, "tier": "growth"})
+
+	if v, ok := m.GetString("tier"); !ok || v != "growth" {
+		t.Errorf("tier: got %q, %v", v, ok)
+	}
+	if v, ok := m.GetFloat("seats"); !ok || v != 25 {
+		t.Errorf("seats: got %v, %v", v, ok)
+	}
+	if _, ok := m.GetBool("tier"); ok {
+		t.Error("expected GetBool to reject a string")
+	}
+
+	var c monigo.Customer
+	if err := json.Unmarshal([]byte(`{"metadata":{"vip":true,"seats":3}}`), &c); err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := c.Metadata.GetBool("vip"); !ok || !v {
+		t.Errorf("vip: got %v, %v", v, ok)
+	}
+	if v, _ := c.Metadata.GetFloat("seats"); v != 3 {
+		t.Errorf("seats: got %v, want 3", v)
+	}
+}
+
+func TestPlans_List_MetadataFilter(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertPath(t, r, "/v1/plans")
+		if got := r.URL.Query().Get("metadata[segment]"); got != "smb" {
+			t.Errorf("metadata[segment]: got %q, want smb", got)
+		}
+		respondJSON(t, w, 200, monigo.ListPlansResponse{Plans: []monigo.Plan{samplePlan}, Count: 1})
+	}))
+	if _, err := c.Plans.List(context.Background(), monigo.ListPlansParams{MetadataFilter: map[string]string{"segment": "smb"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSubscriptions_List_MetadataFilter(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("metadata[crm_id]"); got != "0061x" {
+			t.Errorf("metadata[crm_id]: got %q, want 0061x", got)
+		}
+		respondJSON(t, w, 200, monigo.ListSubscriptionsResponse{})
+	}))
+	if _, err := c.Subscriptions.List(context.Background(), monigo.ListSubscriptionsParams{MetadataFilter: map[string]string{"crm_id": "0061x"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestInvoices_UpdateMetadata(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "PUT")
+		assertPath(t, r, "/v1/invoices/inv-1/metadata")
+		var body struct {
+			Metadata monigo.Metadata `json:"metadata"`
+		}
+		decodeBody(t, r, &body)
+		if v, _ := body.Metadata.GetString("po_number"); v != "PO-778" {
+			t.Errorf("po_number: got %q", v)
+		}
+		respondJSON(t, w, 200, map[string]any{"invoice": monigo.Invoice{ID: "inv-1", Metadata: body.Metadata}})
+	}))
+	inv, err := c.Invoices.UpdateMetadata(context.Background(), "inv-1", monigo.Metadata{"po_number": "PO-778"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v, _ := inv.Metadata.GetString("po_number"); v != "PO-778" {
+		t.Errorf("expected metadata on the invoice, got %v", inv.Metadata)
+	}
+}
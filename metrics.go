@@ -3,6 +3,8 @@ package monigo
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 )
 
 // MetricService manages billing metrics — the definitions of what gets counted.
@@ -12,6 +14,9 @@ type MetricService struct {
 
 // Create defines a new billing metric.
 func (s *MetricService) Create(ctx context.Context, req CreateMetricRequest, opts ...RequestOption) (*Metric, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	var wrapper struct {
 		Metric Metric `json:"metric"`
 	}
@@ -21,6 +26,21 @@ func (s *MetricService) Create(ctx context.Context, req CreateMetricRequest, opt
 	return &wrapper.Metric, nil
 }
 
+// Preview evaluates a prospective metric definition against the raw events
+// ingested over the last window and returns sample rollup values, without
+// creating the metric. Use it to check an aggregation, property, or filter
+// choice before billing on it.
+func (s *MetricService) Preview(ctx context.Context, req CreateMetricRequest, window time.Duration, opts ...RequestOption) (*MetricPreview, error) {
+	body := PreviewMetricRequest{Metric: req, WindowSeconds: int64(window / time.Second)}
+	var wrapper struct {
+		Preview MetricPreview `json:"preview"`
+	}
+	if err := s.client.do(ctx, "POST", "/v1/metrics/preview", body, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Preview, nil
+}
+
 // List returns all metrics for the authenticated organisation.
 func (s *MetricService) List(ctx context.Context) (*ListMetricsResponse, error) {
 	var out ListMetricsResponse
@@ -35,26 +55,113 @@ func (s *MetricService) Get(ctx context.Context, metricID string) (*Metric, erro
 	var wrapper struct {
 		Metric Metric `json:"metric"`
 	}
-	if err := s.client.do(ctx, "GET", fmt.Sprintf("/v1/metrics/%s", metricID), nil, &wrapper); err != nil {
+	path, err := pathf("/v1/metrics/%s", metricID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
 		return nil, err
 	}
 	return &wrapper.Metric, nil
 }
 
+// GetByName fetches the metric with the given name. Names are not unique
+// server-side, so it returns a 409 error (use IsConflict) when several
+// metrics share the name, and a 404 error (use IsNotFound) when none has it.
+// It lets tools address metrics by a stable, human-chosen key, e.g. to
+// import existing metrics into a Terraform state.
+func (s *MetricService) GetByName(ctx context.Context, name string) (*Metric, error) {
+	list, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var found []Metric
+	for _, m := range list.Metrics {
+		if m.Name == name {
+			found = append(found, m)
+		}
+	}
+	return pickByName("metric", name, found)
+}
+
+// CreateRequest returns the request that would create m as it is now. Use
+// it to import a live metric into a declarative configuration, or to
+// compare one with the desired definition.
+func (m Metric) CreateRequest() CreateMetricRequest {
+	return CreateMetricRequest{
+		Name:                m.Name,
+		EventName:           m.EventName,
+		Aggregation:         m.Aggregation,
+		Description:         m.Description,
+		AggregationProperty: m.AggregationProperty,
+		PropertyRules:       m.PropertyRules,
+		Filters:             m.Filters,
+		GroupBy:             m.GroupBy,
+	}
+}
+
+// pickByName returns the only element of found, or the not-found or
+// ambiguous-name error for a lookup of kind by name.
+func pickByName[T any](kind, name string, found []T) (*T, error) {
+	switch len(found) {
+	case 1:
+		return &found[0], nil
+	case 0:
+		return nil, &APIError{
+			StatusCode: http.StatusNotFound,
+			Code:       ErrCodeNotFound,
+			Message:    fmt.Sprintf("no %s named %q", kind, name),
+		}
+	default:
+		return nil, &APIError{
+			StatusCode: http.StatusConflict,
+			Message:    fmt.Sprintf("%d %ss are named %q", len(found), kind, name),
+		}
+	}
+}
+
 // Update modifies an existing metric's configuration.
 // Note: metrics that have already been used for billing may be immutable on
 // certain fields — the server will return a 400 in those cases.
 func (s *MetricService) Update(ctx context.Context, metricID string, req UpdateMetricRequest, opts ...RequestOption) (*Metric, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	var wrapper struct {
 		Metric Metric `json:"metric"`
 	}
-	if err := s.client.do(ctx, "PUT", fmt.Sprintf("/v1/metrics/%s", metricID), req, &wrapper, opts...); err != nil {
+	path, err := pathf("/v1/metrics/%s", metricID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "PUT", path, req, &wrapper, opts...); err != nil {
 		return nil, err
 	}
 	return &wrapper.Metric, nil
 }
 
-// Delete permanently removes a metric record.
+// Delete removes a metric record. Deleted metrics can be recovered with Restore
+// within the retention window; after that they are purged.
 func (s *MetricService) Delete(ctx context.Context, metricID string) error {
-	return s.client.do(ctx, "DELETE", fmt.Sprintf("/v1/metrics/%s", metricID), nil, nil)
+	path, err := pathf("/v1/metrics/%s", metricID)
+	if err != nil {
+		return err
+	}
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// Restore recovers a recently deleted metric. Returns a 404 error (use
+// IsNotFound) once the retention window has passed.
+func (s *MetricService) Restore(ctx context.Context, metricID string, opts ...RequestOption) (*Metric, error) {
+	var wrapper struct {
+		Metric Metric `json:"metric"`
+	}
+	path, err := pathf("/v1/metrics/%s/restore", metricID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, nil, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Metric, nil
 }
@@ -45,6 +45,64 @@ func TestMetrics_Create(t *testing.T) {
 	}
 }
 
+func TestMetrics_Create_PropertyRules(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req monigo.CreateMetricRequest
+		decodeBody(t, r, &req)
+		if len(req.PropertyRules) != 1 {
+			t.Fatalf("expected 1 property rule, got %d", len(req.PropertyRules))
+		}
+		rule := req.PropertyRules[0]
+		if rule.Property != "duration" || rule.Type != monigo.PropertyTypeNumber || !rule.Coerce || rule.Scale != 0.001 {
+			t.Errorf("unexpected rule: %+v", rule)
+		}
+		respondJSON(t, w, 201, map[string]any{"metric": sampleMetric})
+	}))
+
+	_, err := c.Metrics.Create(context.Background(), monigo.CreateMetricRequest{
+		Name:                "Compute Seconds",
+		EventName:           "job.finished",
+		Aggregation:         monigo.AggregationSum,
+		AggregationProperty: "duration",
+		PropertyRules: []monigo.PropertyRule{
+			{Property: "duration", Type: monigo.PropertyTypeNumber, Coerce: true, Scale: 0.001},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestMetrics_Create_FiltersAndGroupBy(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req monigo.CreateMetricRequest
+		decodeBody(t, r, &req)
+		if len(req.Filters) != 1 || req.Filters[0].Property != "region" || req.Filters[0].Operator != monigo.MetricFilterEq || req.Filters[0].Value != "eu" {
+			t.Errorf("unexpected filters: %+v", req.Filters)
+		}
+		if len(req.GroupBy) != 1 || req.GroupBy[0] != "endpoint" {
+			t.Errorf("unexpected group_by: %v", req.GroupBy)
+		}
+		m := sampleMetric
+		m.Filters, m.GroupBy = req.Filters, req.GroupBy
+		respondJSON(t, w, 201, map[string]any{"metric": m})
+	}))
+
+	m, err := c.Metrics.Create(context.Background(), monigo.CreateMetricRequest{
+		Name:        "EU API Calls",
+		EventName:   "api_call",
+		Aggregation: monigo.AggregationCount,
+		Filters:     []monigo.MetricFilter{{Property: "region", Operator: monigo.MetricFilterEq, Value: "eu"}},
+		GroupBy:     []string{"endpoint"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(m.Filters) != 1 || len(m.GroupBy) != 1 {
+		t.Errorf("expected filters and group_by on the metric, got %+v", m)
+	}
+}
+
 func TestMetrics_List(t *testing.T) {
 	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertMethod(t, r, "GET")
@@ -122,3 +180,78 @@ func TestMetrics_Delete(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestMetrics_Restore(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/metrics/metric-1/restore")
+		respondJSON(t, w, 200, map[string]any{"metric": sampleMetric})
+	}))
+
+	got, err := c.Metrics.Restore(context.Background(), sampleMetric.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.ID != sampleMetric.ID {
+		t.Errorf("expected %s, got %s", sampleMetric.ID, got.ID)
+	}
+}
+
+func TestMetrics_Preview(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/metrics/preview")
+
+		var req monigo.PreviewMetricRequest
+		decodeBody(t, r, &req)
+		if req.Metric.AggregationProperty != "bytes" || req.WindowSeconds != 86400 {
+			t.Errorf("unexpected request: %+v", req)
+		}
+		respondJSON(t, w, 200, map[string]any{"preview": monigo.MetricPreview{
+			EventsScanned: 120,
+			EventsMatched: 100,
+			Samples:       []monigo.MetricPreviewSample{{CustomerID: "cust-abc", Value: 2048, EventCount: 12}},
+		}})
+	}))
+
+	p, err := c.Metrics.Preview(context.Background(), monigo.CreateMetricRequest{
+		EventName:           "data_transfer",
+		Aggregation:         monigo.AggregationSum,
+		AggregationProperty: "bytes",
+	}, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.EventsMatched != 100 || len(p.Samples) != 1 || p.Samples[0].Value != 2048 {
+		t.Errorf("unexpected preview: %+v", p)
+	}
+}
+
+func TestMetrics_GetByName(t *testing.T) {
+	other := sampleMetric
+	other.ID, other.Name = "metric-2", "Storage"
+	dup := sampleMetric
+	dup.ID = "metric-3"
+	metrics := []monigo.Metric{sampleMetric, other}
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertPath(t, r, "/v1/metrics")
+		respondJSON(t, w, 200, map[string]any{"metrics": metrics, "count": len(metrics)})
+	}))
+
+	m, err := c.Metrics.GetByName(context.Background(), "Storage")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.ID != "metric-2" {
+		t.Errorf("expected metric-2, got %s", m.ID)
+	}
+
+	if _, err := c.Metrics.GetByName(context.Background(), "Bandwidth"); !monigo.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	metrics = append(metrics, dup)
+	if _, err := c.Metrics.GetByName(context.Background(), "API Calls"); !monigo.IsConflict(err) {
+		t.Errorf("expected conflict for a duplicated name, got %v", err)
+	}
+}
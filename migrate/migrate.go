@@ -0,0 +1,584 @@
+// Package migrate moves billing data from Stripe to Monigo, so a team can
+// switch providers without writing the mapping code themselves.
+//
+// Import maps a StripeExport onto Monigo objects and creates them:
+//
+//	exp, err := migrate.FetchStripe(ctx, os.Getenv("STRIPE_SECRET_KEY"), migrate.FetchOptions{})
+//	report, err := migrate.Import(ctx, client, exp, migrate.Options{})
+//	for _, s := range report.Skipped {
+//		log.Println(s)
+//	}
+//
+// The mapping is:
+//
+//   - Each customer becomes a Monigo customer whose ExternalID is the Stripe
+//     customer ID, unless Options.ExternalID says otherwise.
+//   - Each product with recurring prices becomes a metric named after the
+//     product, counting the "quantity" property of its events. Metered
+//     prices aggregate it the way the price's aggregate_usage does; licensed
+//     (per-seat) prices use a separate "<product> seats" metric that takes
+//     the latest reported quantity, so seat counts must be reported as
+//     events from then on.
+//   - Each recurring price becomes a Monigo price: per-unit prices map to
+//     PricingModelPerUnit, prices with transform_quantity to
+//     PricingModelPackage, graduated tiers to PricingModelTiered and volume
+//     tiers to PricingModelVolume. Amounts are converted from the
+//     currency's minor unit.
+//   - Each distinct set of prices a subscription is on becomes a plan, and
+//     every active price on no subscription becomes a single-price plan.
+//   - Each active, trialing or past-due subscription becomes a Monigo
+//     subscription on the matching plan. It starts when the current Stripe
+//     period ends, so the period Stripe has already billed is not billed
+//     twice; cancel the Stripe subscription at period end to hand over.
+//
+// Import is safe to re-run: customers are upserted by ExternalID, metrics
+// are matched by name, plans by the Stripe price IDs recorded in their
+// metadata, and subscriptions are created with an idempotency key derived
+// from the Stripe subscription ID. Objects that cannot be mapped are listed
+// in Report.Skipped rather than failing the import.
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+// Metadata keys Import records on the objects it creates.
+const (
+	MetadataStripeCustomerID     = "stripe_customer_id"
+	MetadataStripePrices         = "stripe_prices"
+	MetadataStripeSubscriptionID = "stripe_subscription_id"
+)
+
+// Options configures Import.
+type Options struct {
+	// ExternalID returns the Monigo ExternalID for a Stripe customer.
+	// Defaults to the Stripe customer ID; return an ID stored in the
+	// customer's metadata to keep using your own IDs.
+	ExternalID func(StripeCustomer) string
+	// EventName returns the event name of the metric for a product.
+	// Defaults to the product name in snake_case. Per-seat metrics add a
+	// "_seats" suffix.
+	EventName func(StripeProduct) string
+	// StartImmediately starts migrated subscriptions now instead of at the
+	// end of their current Stripe period.
+	StartImmediately bool
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// Report maps every migrated Stripe ID to the ID of the Monigo object
+// created or matched for it.
+type Report struct {
+	// Customers maps Stripe customer IDs to Monigo customer IDs.
+	Customers map[string]string
+	// Metrics maps Stripe price IDs to the ID of the metric they charge on.
+	Metrics map[string]string
+	// Plans maps the Stripe price IDs of a subscription, sorted and joined
+	// with commas, to the Monigo plan ID. A single-price plan is keyed by
+	// its price ID.
+	Plans map[string]string
+	// Subscriptions maps Stripe subscription IDs to Monigo subscription IDs.
+	Subscriptions map[string]string
+	// Skipped lists the objects that were not migrated, and why.
+	Skipped []Skipped
+}
+
+// Skipped is a Stripe object Import did not migrate.
+type Skipped struct {
+	// Kind is "customer", "price" or "subscription".
+	Kind     string
+	StripeID string
+	Reason   string
+}
+
+func (s Skipped) String() string {
+	return fmt.Sprintf("%s %s: %s", s.Kind, s.StripeID, s.Reason)
+}
+
+func (r *Report) skip(kind, id, format string, args ...any) {
+	r.Skipped = append(r.Skipped, Skipped{Kind: kind, StripeID: id, Reason: fmt.Sprintf(format, args...)})
+}
+
+// Import creates the Monigo equivalents of the objects in exp. It stops at
+// the first API error, returning the report of what was migrated so far.
+func Import(ctx context.Context, client *monigo.Client, exp *StripeExport, opts Options) (*Report, error) {
+	if opts.ExternalID == nil {
+		opts.ExternalID = func(c StripeCustomer) string { return c.ID }
+	}
+	if opts.EventName == nil {
+		opts.EventName = func(p StripeProduct) string { return snakeCase(p.Name) }
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	im := &importer{
+		client: client,
+		opts:   opts,
+		report: &Report{
+			Customers:     make(map[string]string),
+			Metrics:       make(map[string]string),
+			Plans:         make(map[string]string),
+			Subscriptions: make(map[string]string),
+		},
+		products: make(map[string]StripeProduct),
+		prices:   make(map[string]StripePrice),
+		mapped:   make(map[string]mappedPrice),
+		metrics:  make(map[string]string),
+	}
+	err := im.run(ctx, exp)
+	return im.report, err
+}
+
+type importer struct {
+	client *monigo.Client
+	opts   Options
+	report *Report
+
+	products map[string]StripeProduct
+	prices   map[string]StripePrice
+	mapped   map[string]mappedPrice // by Stripe price ID
+	metrics  map[string]string      // metric name → Monigo metric ID
+	plans    map[string]string      // MetadataStripePrices value → existing plan ID
+}
+
+// mappedPrice is a Stripe price converted to a Monigo price.
+type mappedPrice struct {
+	price  monigo.CreatePriceRequest
+	period monigo.BillingPeriod
+	name   string
+}
+
+func (im *importer) run(ctx context.Context, exp *StripeExport) error {
+	for _, p := range exp.Products {
+		im.products[p.ID] = p
+	}
+	for _, p := range exp.Prices {
+		im.prices[p.ID] = p
+	}
+	for _, s := range exp.Subscriptions {
+		for _, item := range s.Items.Data {
+			if _, ok := im.prices[item.Price.ID]; !ok {
+				im.prices[item.Price.ID] = item.Price
+			}
+		}
+	}
+
+	if err := im.customers(ctx, exp.Customers); err != nil {
+		return err
+	}
+
+	// Work out every plan before creating anything, so that prices no
+	// subscription uses can be given plans of their own.
+	type bundle struct {
+		key    string
+		prices []string
+	}
+	var bundles []bundle
+	seen := make(map[string]bool)
+	subscribed := make(map[string]bool)
+	var subs []StripeSubscription
+	for _, s := range exp.Subscriptions {
+		key, ids, ok := im.subscriptionBundle(s)
+		if !ok {
+			continue
+		}
+		subs = append(subs, s)
+		for _, id := range ids {
+			subscribed[id] = true
+		}
+		if !seen[key] {
+			seen[key] = true
+			bundles = append(bundles, bundle{key, ids})
+		}
+	}
+	for _, p := range exp.Prices {
+		if !p.Active || p.Type == "one_time" || subscribed[p.ID] || seen[p.ID] {
+			continue
+		}
+		if _, err := im.mapPrice(p.ID); err != nil {
+			im.report.skip("price", p.ID, "%v", err)
+			continue
+		}
+		seen[p.ID] = true
+		bundles = append(bundles, bundle{p.ID, []string{p.ID}})
+	}
+
+	if err := im.loadPlans(ctx); err != nil {
+		return err
+	}
+	for _, b := range bundles {
+		if err := im.plan(ctx, b.key, b.prices); err != nil {
+			return err
+		}
+	}
+	for _, s := range subs {
+		if err := im.subscription(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (im *importer) customers(ctx context.Context, customers []StripeCustomer) error {
+	for _, c := range customers {
+		if c.Deleted {
+			im.report.skip("customer", c.ID, "customer is deleted")
+			continue
+		}
+		md := monigo.Metadata{MetadataStripeCustomerID: c.ID}
+		for k, v := range c.Metadata {
+			md[k] = v
+		}
+		name := c.Name
+		if name == "" {
+			name = c.Email
+		}
+		cust, err := im.client.Customers.Upsert(ctx, monigo.CreateCustomerRequest{
+			ExternalID: im.opts.ExternalID(c),
+			Name:       name,
+			Email:      c.Email,
+			Phone:      c.Phone,
+			Metadata:   md,
+		})
+		if err != nil {
+			return fmt.Errorf("migrate: customer %s: %w", c.ID, err)
+		}
+		im.report.Customers[c.ID] = cust.ID
+	}
+	return nil
+}
+
+// subscriptionBundle returns the plan key and sorted price IDs for s, or
+// ok false if s is not migrated.
+func (im *importer) subscriptionBundle(s StripeSubscription) (key string, ids []string, ok bool) {
+	switch s.Status {
+	case "active", "trialing", "past_due":
+	default:
+		im.report.skip("subscription", s.ID, "status %q is not migrated", s.Status)
+		return "", nil, false
+	}
+	if _, ok := im.report.Customers[s.Customer]; !ok {
+		im.report.skip("subscription", s.ID, "customer %s was not migrated", s.Customer)
+		return "", nil, false
+	}
+	if len(s.Items.Data) == 0 {
+		im.report.skip("subscription", s.ID, "subscription has no items")
+		return "", nil, false
+	}
+
+	var period monigo.BillingPeriod
+	for _, item := range s.Items.Data {
+		m, err := im.mapPrice(item.Price.ID)
+		if err != nil {
+			im.report.skip("subscription", s.ID, "price %s: %v", item.Price.ID, err)
+			return "", nil, false
+		}
+		if period != "" && m.period != period {
+			im.report.skip("subscription", s.ID, "items bill on different intervals")
+			return "", nil, false
+		}
+		period = m.period
+		ids = append(ids, item.Price.ID)
+	}
+	sort.Strings(ids)
+	ids = dedupe(ids)
+	return strings.Join(ids, ","), ids, true
+}
+
+// mapPrice converts the Stripe price id, caching the result. Metrics are
+// created when the plan is.
+func (im *importer) mapPrice(id string) (mappedPrice, error) {
+	if m, ok := im.mapped[id]; ok {
+		return m, nil
+	}
+	p, ok := im.prices[id]
+	if !ok {
+		return mappedPrice{}, errors.New("price not found in the export")
+	}
+	if p.Recurring == nil || p.Type == "one_time" {
+		return mappedPrice{}, errors.New("one-time prices are not migrated")
+	}
+	period, err := billingPeriod(*p.Recurring)
+	if err != nil {
+		return mappedPrice{}, err
+	}
+	price, err := priceRequest(p)
+	if err != nil {
+		return mappedPrice{}, err
+	}
+	name := p.Product
+	if prod, ok := im.products[p.Product]; ok && prod.Name != "" {
+		name = prod.Name
+	}
+	if p.Nickname != "" {
+		name += " " + p.Nickname
+	}
+	m := mappedPrice{price: price, period: period, name: name}
+	im.mapped[id] = m
+	return m, nil
+}
+
+// metric returns the ID of the metric the Stripe price id charges on,
+// creating the metric if no metric of that name exists.
+func (im *importer) metric(ctx context.Context, id string) (string, error) {
+	p := im.prices[id]
+	prod, ok := im.products[p.Product]
+	if !ok {
+		prod = StripeProduct{ID: p.Product, Name: p.Product}
+	}
+	req := monigo.CreateMetricRequest{
+		Name:                prod.Name,
+		EventName:           im.opts.EventName(prod),
+		Description:         prod.Description,
+		Aggregation:         monigo.AggregationSum,
+		AggregationProperty: "quantity",
+	}
+	switch p.Recurring.AggregateUsage {
+	case "max":
+		req.Aggregation = monigo.AggregationMax
+	case "last_during_period", "last_ever":
+		req.Aggregation = monigo.AggregationLatest
+	}
+	if p.Recurring.UsageType == "licensed" {
+		req.Name += " seats"
+		req.EventName += "_seats"
+		req.Aggregation = monigo.AggregationLatest
+	}
+
+	if metricID, ok := im.metrics[req.Name]; ok {
+		return metricID, nil
+	}
+	m, err := im.client.Metrics.GetByName(ctx, req.Name)
+	if monigo.IsNotFound(err) {
+		m, err = im.client.Metrics.Create(ctx, req)
+	}
+	if err != nil {
+		return "", fmt.Errorf("migrate: metric %q: %w", req.Name, err)
+	}
+	im.metrics[req.Name] = m.ID
+	return m.ID, nil
+}
+
+// loadPlans indexes existing plans by the Stripe prices they were created
+// from, so that a re-run does not create them again.
+func (im *importer) loadPlans(ctx context.Context) error {
+	list, err := im.client.Plans.List(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate: list plans: %w", err)
+	}
+	im.plans = make(map[string]string)
+	for _, p := range list.Plans {
+		if key, ok := p.Metadata[MetadataStripePrices].(string); ok {
+			im.plans[key] = p.ID
+		}
+	}
+	return nil
+}
+
+func (im *importer) plan(ctx context.Context, key string, ids []string) error {
+	req := monigo.CreatePlanRequest{
+		Metadata: monigo.Metadata{MetadataStripePrices: key},
+	}
+	var names []string
+	for _, id := range ids {
+		m := im.mapped[id]
+		metricID, err := im.metric(ctx, id)
+		if err != nil {
+			return err
+		}
+		im.report.Metrics[id] = metricID
+
+		price := m.price
+		price.MetricID = metricID
+		req.Prices = append(req.Prices, price)
+		req.BillingPeriod = m.period
+		req.Currency = price.Currency
+		names = append(names, m.name)
+	}
+	req.Name = strings.Join(names, " + ")
+
+	if planID, ok := im.plans[key]; ok {
+		im.report.Plans[key] = planID
+		return nil
+	}
+	plan, err := im.client.Plans.Create(ctx, req)
+	if err != nil {
+		return fmt.Errorf("migrate: plan %q: %w", req.Name, err)
+	}
+	im.report.Plans[key] = plan.ID
+	return nil
+}
+
+func (im *importer) subscription(ctx context.Context, s StripeSubscription) error {
+	key, _, _ := im.subscriptionBundle(s)
+	req := monigo.CreateSubscriptionRequest{
+		CustomerID: im.report.Customers[s.Customer],
+		PlanID:     im.report.Plans[key],
+		Currency:   strings.ToUpper(s.Currency),
+		Metadata:   monigo.Metadata{MetadataStripeSubscriptionID: s.ID},
+	}
+	for k, v := range s.Metadata {
+		req.Metadata[k] = v
+	}
+	now := im.opts.Now()
+	if end := time.Unix(s.CurrentPeriodEnd, 0).UTC(); !im.opts.StartImmediately && end.After(now) {
+		req.StartAt = &end
+	}
+	if s.Status == "trialing" && s.TrialEnd != nil {
+		if end := time.Unix(*s.TrialEnd, 0).UTC(); end.After(now) {
+			req.TrialEndsAt = &end
+		}
+	}
+
+	sub, err := im.client.Subscriptions.Create(ctx, req, monigo.WithIdempotencyKey("stripe-migrate-"+s.ID))
+	if err != nil {
+		return fmt.Errorf("migrate: subscription %s: %w", s.ID, err)
+	}
+	im.report.Subscriptions[s.ID] = sub.ID
+	return nil
+}
+
+// billingPeriod maps a Stripe interval onto a Monigo billing period.
+func billingPeriod(r StripeRecurring) (monigo.BillingPeriod, error) {
+	n := r.IntervalCount
+	if n == 0 {
+		n = 1
+	}
+	switch {
+	case r.Interval == "day" && n == 1:
+		return monigo.BillingPeriodDaily, nil
+	case r.Interval == "week" && n == 1:
+		return monigo.BillingPeriodWeekly, nil
+	case r.Interval == "month" && n == 1:
+		return monigo.BillingPeriodMonthly, nil
+	case r.Interval == "month" && n == 3:
+		return monigo.BillingPeriodQuarterly, nil
+	case r.Interval == "year" && n == 1:
+		return monigo.BillingPeriodAnnually, nil
+	}
+	return "", fmt.Errorf("billing every %d %s has no Monigo billing period", n, r.Interval)
+}
+
+// priceRequest converts p into a price without a MetricID.
+func priceRequest(p StripePrice) (monigo.CreatePriceRequest, error) {
+	req := monigo.CreatePriceRequest{Currency: strings.ToUpper(p.Currency)}
+
+	if p.BillingScheme == "tiered" {
+		tiers := make([]monigo.PriceTier, len(p.Tiers))
+		for i, t := range p.Tiers {
+			if flat, _ := amount(t.FlatAmountDecimal, t.FlatAmount, p.Currency); flat != "" && flat != "0.000000" {
+				return req, errors.New("tiers with flat amounts have no Monigo equivalent")
+			}
+			unit, err := amount(t.UnitAmountDecimal, t.UnitAmount, p.Currency)
+			if err != nil {
+				return req, err
+			}
+			if unit == "" {
+				unit = "0.000000"
+			}
+			tiers[i] = monigo.PriceTier{UpTo: t.UpTo, UnitAmount: unit}
+		}
+		var err error
+		switch p.TiersMode {
+		case "graduated":
+			req.Model = monigo.PricingModelTiered
+			req.Tiers, err = marshal(tiers)
+		case "volume":
+			req.Model = monigo.PricingModelVolume
+			req.Tiers, err = marshal(monigo.VolumeConfig(tiers))
+		default:
+			return req, fmt.Errorf("unknown tiers_mode %q", p.TiersMode)
+		}
+		return req, err
+	}
+
+	unit, err := amount(p.UnitAmountDecimal, p.UnitAmount, p.Currency)
+	if err != nil {
+		return req, err
+	}
+	if unit == "" {
+		return req, errors.New("price has no unit amount")
+	}
+	if tq := p.TransformQuantity; tq != nil && tq.DivideBy > 1 {
+		req.Model = monigo.PricingModelPackage
+		roundUp := tq.Round == "up"
+		req.Tiers, err = marshal(monigo.PackageConfig{
+			PackageSize:         tq.DivideBy,
+			PackagePrice:        unit,
+			RoundUpPartialBlock: &roundUp,
+		})
+		return req, err
+	}
+	req.Model = monigo.PricingModelPerUnit
+	req.UnitPrice = unit
+	return req, nil
+}
+
+// zeroDecimal lists the currencies Stripe charges in whole units.
+var zeroDecimal = map[string]bool{
+	"bif": true, "clp": true, "djf": true, "gnf": true, "jpy": true, "kmf": true,
+	"krw": true, "mga": true, "pyg": true, "rwf": true, "ugx": true, "vnd": true,
+	"vuv": true, "xaf": true, "xof": true, "xpf": true,
+}
+
+// amount converts a Stripe minor-unit amount, preferring its decimal form,
+// to a 6-decimal major-unit string. It returns "" if neither is set.
+func amount(dec string, minor *int64, currency string) (string, error) {
+	if dec == "" && minor != nil {
+		dec = strconv.FormatInt(*minor, 10)
+	}
+	if dec == "" {
+		return "", nil
+	}
+	r, ok := new(big.Rat).SetString(dec)
+	if !ok {
+		return "", fmt.Errorf("invalid amount %q", dec)
+	}
+	if !zeroDecimal[strings.ToLower(currency)] {
+		r.Quo(r, big.NewRat(100, 1))
+	}
+	return r.FloatString(6), nil
+}
+
+// snakeCase turns a product name such as "API Calls" into "api_calls".
+func snakeCase(s string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if underscore && b.Len() > 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(r)
+			underscore = false
+		} else {
+			underscore = true
+		}
+	}
+	return b.String()
+}
+
+func marshal(v any) (json.RawMessage, error) {
+	return json.Marshal(v)
+}
+
+func dedupe(sorted []string) []string {
+	out := sorted[:0]
+	for i, s := range sorted {
+		if i == 0 || s != sorted[i-1] {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,190 @@
+package migrate_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+	"github.com/monigo-africa/go-monigo/migrate"
+	"github.com/monigo-africa/go-monigo/monigotest"
+)
+
+func int64p(v int64) *int64 { return &v }
+
+func monthly(usage string) *migrate.StripeRecurring {
+	return &migrate.StripeRecurring{Interval: "month", IntervalCount: 1, UsageType: usage}
+}
+
+func testExport() *migrate.StripeExport {
+	calls := migrate.StripePrice{
+		ID: "price_calls", Product: "prod_api", Active: true, Currency: "ngn", Type: "recurring",
+		UnitAmountDecimal: "50", BillingScheme: "per_unit", Recurring: monthly("metered"),
+	}
+	seats := migrate.StripePrice{
+		ID: "price_seats", Product: "prod_team", Active: true, Currency: "ngn", Type: "recurring",
+		UnitAmount: int64p(1000000), BillingScheme: "per_unit", Recurring: monthly("licensed"),
+	}
+	return &migrate.StripeExport{
+		Customers: []migrate.StripeCustomer{
+			{ID: "cus_1", Name: "Acme Corp", Email: "billing@acme.test", Metadata: map[string]string{"tier": "gold"}},
+			{ID: "cus_2", Deleted: true},
+		},
+		Products: []migrate.StripeProduct{
+			{ID: "prod_api", Name: "API Calls", Active: true},
+			{ID: "prod_team", Name: "Team", Active: true},
+			{ID: "prod_storage", Name: "Storage", Active: true},
+		},
+		Prices: []migrate.StripePrice{
+			calls,
+			seats,
+			{
+				ID: "price_graduated", Product: "prod_storage", Active: true, Currency: "ngn", Type: "recurring",
+				BillingScheme: "tiered", TiersMode: "graduated", Recurring: monthly("metered"),
+				Tiers: []migrate.StripeTier{
+					{UpTo: int64p(1000), UnitAmount: int64p(10)},
+					{UnitAmountDecimal: "5.5"},
+				},
+			},
+			{
+				ID: "price_flat_tiers", Product: "prod_storage", Active: true, Currency: "ngn", Type: "recurring",
+				BillingScheme: "tiered", TiersMode: "volume", Recurring: monthly("metered"),
+				Tiers: []migrate.StripeTier{{UnitAmount: int64p(10), FlatAmount: int64p(5000)}},
+			},
+			{
+				ID: "price_semiannual", Product: "prod_api", Active: true, Currency: "ngn", Type: "recurring",
+				UnitAmount: int64p(100), BillingScheme: "per_unit",
+				Recurring: &migrate.StripeRecurring{Interval: "month", IntervalCount: 6, UsageType: "metered"},
+			},
+		},
+		Subscriptions: []migrate.StripeSubscription{
+			stripeSub("sub_1", "cus_1", "active", calls, seats),
+			stripeSub("sub_2", "cus_1", "canceled", calls),
+		},
+	}
+}
+
+func stripeSub(id, customer, status string, prices ...migrate.StripePrice) migrate.StripeSubscription {
+	s := migrate.StripeSubscription{
+		ID: id, Customer: customer, Status: status, Currency: "ngn",
+		CurrentPeriodEnd: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC).Unix(),
+	}
+	for _, p := range prices {
+		s.Items.Data = append(s.Items.Data, migrate.StripeSubscriptionItem{ID: "si_" + p.ID, Price: p, Quantity: 1})
+	}
+	return s
+}
+
+func TestImport_MapsCatalogAndSubscriptions(t *testing.T) {
+	srv := monigotest.NewServer(t)
+	client := srv.Client()
+	ctx := context.Background()
+
+	report, err := migrate.Import(ctx, client, testExport(), migrate.Options{
+		Now: func() time.Time { return time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC) },
+	})
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+
+	if len(report.Customers) != 1 || report.Customers["cus_1"] == "" {
+		t.Errorf("expected cus_1 to be migrated, got %v", report.Customers)
+	}
+	if report.Subscriptions["sub_1"] == "" || len(report.Subscriptions) != 1 {
+		t.Errorf("expected only sub_1 to be migrated, got %v", report.Subscriptions)
+	}
+	var skipped []string
+	for _, s := range report.Skipped {
+		skipped = append(skipped, s.Kind+" "+s.StripeID)
+	}
+	want := "customer cus_2, subscription sub_2, price price_flat_tiers, price price_semiannual"
+	if got := strings.Join(skipped, ", "); got != want {
+		t.Errorf("expected skipped %q, got %q (%v)", want, got, report.Skipped)
+	}
+
+	planID := report.Plans["price_calls,price_seats"]
+	plan, err := client.Plans.Get(ctx, planID)
+	if err != nil {
+		t.Fatalf("get bundled plan: %v", err)
+	}
+	if plan.Name != "API Calls + Team" || len(plan.Prices) != 2 {
+		t.Errorf("unexpected bundled plan %+v", plan)
+	}
+	for _, p := range plan.Prices {
+		if p.UnitPrice != "0.500000" && p.UnitPrice != "10000.000000" {
+			t.Errorf("unexpected converted unit price %q", p.UnitPrice)
+		}
+	}
+
+	seats, err := client.Metrics.Get(ctx, report.Metrics["price_seats"])
+	if err != nil {
+		t.Fatalf("get seats metric: %v", err)
+	}
+	if seats.Name != "Team seats" || seats.EventName != "team_seats" || seats.Aggregation != monigo.AggregationLatest {
+		t.Errorf("unexpected seats metric %+v", seats)
+	}
+
+	storage, err := client.Plans.Get(ctx, report.Plans["price_graduated"])
+	if err != nil {
+		t.Fatalf("get graduated plan: %v", err)
+	}
+	var tiers []monigo.PriceTier
+	if err := json.Unmarshal(storage.Prices[0].Tiers, &tiers); err != nil {
+		t.Fatalf("decode tiers: %v", err)
+	}
+	if storage.Prices[0].Model != monigo.PricingModelTiered || len(tiers) != 2 ||
+		tiers[0].UnitAmount != "0.100000" || tiers[1].UpTo != nil || tiers[1].UnitAmount != "0.055000" {
+		t.Errorf("unexpected graduated price %+v %+v", storage.Prices[0], tiers)
+	}
+}
+
+func TestFetchStripe_FollowsPagination(t *testing.T) {
+	var pages []string
+	stripe := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer sk_test_stripe" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/v1/customers" {
+			w.Write([]byte(`{"data": [], "has_more": false}`))
+			return
+		}
+		pages = append(pages, r.URL.Query().Get("starting_after"))
+		if r.URL.Query().Get("starting_after") == "" {
+			w.Write([]byte(`{"data": [{"id": "cus_1"}, {"id": "cus_2"}], "has_more": true}`))
+			return
+		}
+		w.Write([]byte(`{"data": [{"id": "cus_3"}], "has_more": false}`))
+	}))
+	defer stripe.Close()
+
+	exp, err := migrate.FetchStripe(context.Background(), "sk_test_stripe", migrate.FetchOptions{BaseURL: stripe.URL})
+	if err != nil {
+		t.Fatalf("FetchStripe: %v", err)
+	}
+	if len(exp.Customers) != 3 || exp.Customers[2].ID != "cus_3" {
+		t.Errorf("expected 3 customers, got %+v", exp.Customers)
+	}
+	if strings.Join(pages, ",") != ",cus_2" {
+		t.Errorf("expected the second page to start after cus_2, got %q", pages)
+	}
+}
+
+func TestLoadStripeList_AcceptsListObjectOrArray(t *testing.T) {
+	for _, in := range []string{
+		`{"object": "list", "data": [{"id": "prod_1", "name": "API"}], "has_more": false}`,
+		`[{"id": "prod_1", "name": "API"}]`,
+	} {
+		var products []migrate.StripeProduct
+		if err := migrate.LoadStripeList(strings.NewReader(in), &products); err != nil {
+			t.Fatalf("LoadStripeList(%s): %v", in, err)
+		}
+		if len(products) != 1 || products[0].Name != "API" {
+			t.Errorf("unexpected products %+v", products)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// StripeExport holds the Stripe objects to migrate. Fill it with
+// FetchStripe, or decode list responses saved with the Stripe CLI using
+// LoadStripeList.
+type StripeExport struct {
+	Customers     []StripeCustomer     `json:"customers"`
+	Products      []StripeProduct      `json:"products"`
+	Prices        []StripePrice        `json:"prices"`
+	Subscriptions []StripeSubscription `json:"subscriptions"`
+}
+
+// StripeCustomer is the subset of a Stripe customer object that is migrated.
+type StripeCustomer struct {
+	ID       string            `json:"id"`
+	Name     string            `json:"name"`
+	Email    string            `json:"email"`
+	Phone    string            `json:"phone"`
+	Metadata map[string]string `json:"metadata"`
+	Deleted  bool              `json:"deleted"`
+}
+
+// StripeProduct is the subset of a Stripe product object that is migrated.
+type StripeProduct struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Active      bool   `json:"active"`
+}
+
+// StripePrice is the subset of a Stripe price object that is migrated.
+// Amounts are in the currency's minor unit, as Stripe sends them.
+type StripePrice struct {
+	ID       string `json:"id"`
+	Product  string `json:"product"`
+	Active   bool   `json:"active"`
+	Currency string `json:"currency"`
+	Nickname string `json:"nickname"`
+	// Type is "recurring" or "one_time". One-time prices are not migrated.
+	Type              string                   `json:"type"`
+	UnitAmount        *int64                   `json:"unit_amount"`
+	UnitAmountDecimal string                   `json:"unit_amount_decimal"`
+	BillingScheme     string                   `json:"billing_scheme"`
+	TiersMode         string                   `json:"tiers_mode"`
+	Tiers             []StripeTier             `json:"tiers"`
+	Recurring         *StripeRecurring         `json:"recurring"`
+	TransformQuantity *StripeTransformQuantity `json:"transform_quantity"`
+}
+
+// StripeTier is one tier of a tiered Stripe price. A nil UpTo is the last,
+// unbounded tier.
+type StripeTier struct {
+	UpTo              *int64 `json:"up_to"`
+	UnitAmount        *int64 `json:"unit_amount"`
+	UnitAmountDecimal string `json:"unit_amount_decimal"`
+	FlatAmount        *int64 `json:"flat_amount"`
+	FlatAmountDecimal string `json:"flat_amount_decimal"`
+}
+
+// StripeRecurring is the billing cadence and usage type of a recurring
+// Stripe price.
+type StripeRecurring struct {
+	Interval      string `json:"interval"`
+	IntervalCount int    `json:"interval_count"`
+	// UsageType is "metered" for usage-based prices and "licensed" for
+	// per-seat prices billed on the subscription item's quantity.
+	UsageType string `json:"usage_type"`
+	// AggregateUsage is how metered usage is combined: "sum", "max",
+	// "last_during_period" or "last_ever".
+	AggregateUsage string `json:"aggregate_usage"`
+}
+
+// StripeTransformQuantity divides the quantity before pricing, which is
+// how Stripe expresses package pricing.
+type StripeTransformQuantity struct {
+	DivideBy int64  `json:"divide_by"`
+	Round    string `json:"round"`
+}
+
+// StripeSubscription is the subset of a Stripe subscription object that is
+// migrated. Each item embeds its full price.
+type StripeSubscription struct {
+	ID                 string            `json:"id"`
+	Customer           string            `json:"customer"`
+	Status             string            `json:"status"`
+	Currency           string            `json:"currency"`
+	CurrentPeriodStart int64             `json:"current_period_start"`
+	CurrentPeriodEnd   int64             `json:"current_period_end"`
+	TrialEnd           *int64            `json:"trial_end"`
+	Metadata           map[string]string `json:"metadata"`
+	Items              struct {
+		Data []StripeSubscriptionItem `json:"data"`
+	} `json:"items"`
+}
+
+// StripeSubscriptionItem is one price on a Stripe subscription.
+type StripeSubscriptionItem struct {
+	ID       string      `json:"id"`
+	Price    StripePrice `json:"price"`
+	Quantity int64       `json:"quantity"`
+}
+
+// LoadStripeList decodes the Stripe objects in r into dst, a pointer to a
+// slice such as *[]StripeCustomer. r may hold a Stripe list object, as
+// written by "stripe customers list", or a plain JSON array.
+func LoadStripeList(r io.Reader, dst any) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		var list struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(data, &list); err != nil {
+			return fmt.Errorf("migrate: decode list: %w", err)
+		}
+		data = list.Data
+	}
+	if err := json.Unmarshal(data, dst); err != nil {
+		return fmt.Errorf("migrate: decode list: %w", err)
+	}
+	return nil
+}
+
+// LoadStripeFile is LoadStripeList for the named file.
+func LoadStripeFile(name string, dst any) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return LoadStripeList(f, dst)
+}
+
+// FetchOptions configures FetchStripe.
+type FetchOptions struct {
+	// BaseURL is the Stripe API URL. Defaults to "https://api.stripe.com".
+	BaseURL string
+	// HTTPClient defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// FetchStripe reads every customer, product, price and subscription from
+// the Stripe account that secretKey belongs to. A restricted key with read
+// access to those objects is enough.
+func FetchStripe(ctx context.Context, secretKey string, opts FetchOptions) (*StripeExport, error) {
+	if opts.BaseURL == "" {
+		opts.BaseURL = "https://api.stripe.com"
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = http.DefaultClient
+	}
+	f := fetcher{key: secretKey, opts: opts}
+
+	var exp StripeExport
+	if err := fetchAll(ctx, f, "/v1/customers", nil, &exp.Customers, func(c StripeCustomer) string { return c.ID }); err != nil {
+		return nil, err
+	}
+	if err := fetchAll(ctx, f, "/v1/products", nil, &exp.Products, func(p StripeProduct) string { return p.ID }); err != nil {
+		return nil, err
+	}
+	prices := url.Values{"expand[]": {"data.tiers"}}
+	if err := fetchAll(ctx, f, "/v1/prices", prices, &exp.Prices, func(p StripePrice) string { return p.ID }); err != nil {
+		return nil, err
+	}
+	subs := url.Values{"status": {"all"}}
+	if err := fetchAll(ctx, f, "/v1/subscriptions", subs, &exp.Subscriptions, func(s StripeSubscription) string { return s.ID }); err != nil {
+		return nil, err
+	}
+	return &exp, nil
+}
+
+type fetcher struct {
+	key  string
+	opts FetchOptions
+}
+
+// fetchAll follows a Stripe list endpoint's starting_after cursor until
+// has_more is false, appending every object to dst.
+func fetchAll[T any](ctx context.Context, f fetcher, path string, q url.Values, dst *[]T, id func(T) string) error {
+	if q == nil {
+		q = url.Values{}
+	}
+	q.Set("limit", "100")
+	for {
+		req, err := http.NewRequestWithContext(ctx, "GET", f.opts.BaseURL+path+"?"+q.Encode(), nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+f.key)
+		resp, err := f.opts.HTTPClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("migrate: GET %s: %w", path, err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("migrate: GET %s: %w", path, err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			var e struct {
+				Error struct {
+					Message string `json:"message"`
+				} `json:"error"`
+			}
+			json.Unmarshal(body, &e)
+			return fmt.Errorf("migrate: GET %s: stripe returned %d: %s", path, resp.StatusCode, e.Error.Message)
+		}
+
+		var page struct {
+			Data    []T  `json:"data"`
+			HasMore bool `json:"has_more"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("migrate: GET %s: %w", path, err)
+		}
+		*dst = append(*dst, page.Data...)
+		if !page.HasMore || len(page.Data) == 0 {
+			return nil
+		}
+		q.Set("starting_after", id(page.Data[len(page.Data)-1]))
+	}
+}
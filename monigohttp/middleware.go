@@ -0,0 +1,228 @@
+// Package monigohttp meters HTTP APIs with Monigo. Middleware wraps an
+// http.Handler and ingests one usage event per request:
+//
+//	mux := http.NewServeMux()
+//	mux.HandleFunc("GET /v1/classify", classify)
+//
+//	metered := monigohttp.Middleware(client.Events, monigohttp.Options{
+//		CustomerID: monigohttp.FromHeader("X-Customer-ID"),
+//	})(mux)
+//	http.ListenAndServe(":8080", metered)
+//
+// Each event is named "api_call" by default and carries the route, method,
+// status, and latency as properties.
+package monigohttp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+// DefaultEventName is the event name used when Options.EventName is empty.
+const DefaultEventName = "api_call"
+
+// defaultIngestTimeout bounds each background ingest call.
+const defaultIngestTimeout = 10 * time.Second
+
+// Ingester sends events to Monigo. *monigo.EventService and
+// *billingtest.Recorder implement it.
+type Ingester interface {
+	Ingest(ctx context.Context, req monigo.IngestRequest, opts ...monigo.RequestOption) (*monigo.IngestResponse, error)
+}
+
+// CustomerIDFunc extracts the Monigo customer ID from a request. It returns
+// false when the request should not be metered, e.g. an anonymous health
+// check.
+type CustomerIDFunc func(r *http.Request) (string, bool)
+
+// Options configures Middleware.
+type Options struct {
+	// CustomerID identifies who to charge for a request. Required.
+	CustomerID CustomerIDFunc
+	// Resolver, if set, treats the value returned by CustomerID as your own
+	// customer ID (Customer.ExternalID) and maps it to the Monigo UUID in
+	// the background, caching the result.
+	Resolver *monigo.CustomerResolver
+	// EventName is the ingested event name. Defaults to DefaultEventName.
+	EventName string
+	// Properties builds the event properties. The default records "path"
+	// (the matched ServeMux pattern when there is one, so IDs in URLs do
+	// not explode cardinality), "method", "status", and "latency_ms".
+	Properties func(r *http.Request, status int, latency time.Duration) map[string]any
+	// Skip, if set, leaves requests for which it returns true unmetered.
+	Skip func(r *http.Request) bool
+	// OnError receives ingest failures. Metering never fails the request.
+	OnError func(r *http.Request, err error)
+	// Timeout bounds each ingest call. Defaults to ten seconds.
+	Timeout time.Duration
+}
+
+// Middleware returns middleware that ingests a usage event for every request
+// once the wrapped handler returns. Events are sent in the background with
+// a random idempotency key, so metering adds no latency to responses.
+//
+// Every request becomes its own ingest call; for high-traffic services pass
+// an Ingester that batches.
+func Middleware(ing Ingester, opts Options) func(http.Handler) http.Handler {
+	if opts.CustomerID == nil {
+		panic("monigohttp: Options.CustomerID is required")
+	}
+	if opts.EventName == "" {
+		opts.EventName = DefaultEventName
+	}
+	if opts.Properties == nil {
+		opts.Properties = defaultProperties
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultIngestTimeout
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if opts.Skip != nil && opts.Skip(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			start := time.Now()
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(sw, r)
+			latency := time.Since(start)
+
+			customerID, ok := opts.CustomerID(r)
+			if !ok || customerID == "" {
+				return
+			}
+			event := monigo.IngestEvent{
+				EventName:      opts.EventName,
+				CustomerID:     customerID,
+				IdempotencyKey: newEventKey(),
+				Timestamp:      start.UTC(),
+				Properties:     opts.Properties(r, sw.status, latency),
+			}
+			ctx := context.WithoutCancel(r.Context())
+			go func() {
+				ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+				defer cancel()
+				err := send(ctx, ing, opts.Resolver, event)
+				if err != nil && opts.OnError != nil {
+					opts.OnError(r, err)
+				}
+			}()
+		})
+	}
+}
+
+// send resolves the event's customer ID, if a resolver is given, and
+// ingests the event.
+func send(ctx context.Context, ing Ingester, resolver *monigo.CustomerResolver, event monigo.IngestEvent) error {
+	if resolver != nil {
+		id, err := resolver.Resolve(ctx, event.CustomerID)
+		if err != nil {
+			return err
+		}
+		event.CustomerID = id
+	}
+	_, err := ing.Ingest(ctx, monigo.IngestRequest{Events: []monigo.IngestEvent{event}})
+	return err
+}
+
+func defaultProperties(r *http.Request, status int, latency time.Duration) map[string]any {
+	path := r.Pattern
+	if path == "" {
+		path = r.URL.Path
+	}
+	return map[string]any{
+		"path":       path,
+		"method":     r.Method,
+		"status":     status,
+		"latency_ms": latency.Milliseconds(),
+	}
+}
+
+// FromHeader reads the customer ID from a request header.
+func FromHeader(name string) CustomerIDFunc {
+	return func(r *http.Request) (string, bool) {
+		v := r.Header.Get(name)
+		return v, v != ""
+	}
+}
+
+// FromContext reads the customer ID from a request context value set by
+// earlier middleware, such as your authentication layer. The value must be
+// a string.
+func FromContext(key any) CustomerIDFunc {
+	return func(r *http.Request) (string, bool) {
+		v, ok := r.Context().Value(key).(string)
+		return v, ok && v != ""
+	}
+}
+
+// FromJWTClaim reads the customer ID from a string claim of the bearer token
+// in the Authorization header.
+//
+// The token's signature is NOT verified: put Middleware behind the
+// middleware that authenticates the request.
+func FromJWTClaim(claim string) CustomerIDFunc {
+	return func(r *http.Request) (string, bool) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			return "", false
+		}
+		parts := strings.Split(token, ".")
+		if len(parts) != 3 {
+			return "", false
+		}
+		payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+		if err != nil {
+			return "", false
+		}
+		var claims map[string]any
+		if err := json.Unmarshal(payload, &claims); err != nil {
+			return "", false
+		}
+		v, ok := claims[claim].(string)
+		return v, ok && v != ""
+	}
+}
+
+// statusWriter records the status code written by the wrapped handler.
+type statusWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.status = code
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// newEventKey returns a random idempotency key for a metered request.
+func newEventKey() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic("monigohttp: crypto/rand unavailable: " + err.Error())
+	}
+	return "http-" + hex.EncodeToString(b[:])
+}
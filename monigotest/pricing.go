@@ -0,0 +1,135 @@
+package monigotest
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+	"github.com/monigo-africa/go-monigo/pricing"
+)
+
+// buildInvoice prices the subscription's current period, or [start, end)
+// when start is non-zero, from the events ingested up to asOf. It returns an
+// HTTP status alongside any error. Callers hold s.mu.
+func (s *Server) buildInvoice(subscriptionID string, start, end, asOf time.Time) (*monigo.Invoice, int, error) {
+	sub, ok := s.subscriptions[subscriptionID]
+	if !ok {
+		return nil, http.StatusNotFound, errors.New("subscription not found")
+	}
+	plan, ok := s.plans[sub.PlanID]
+	if !ok {
+		return nil, http.StatusNotFound, errors.New("plan not found")
+	}
+	if start.IsZero() {
+		start, end = sub.CurrentPeriodStart, sub.CurrentPeriodEnd
+	}
+	until := end
+	if asOf.Before(until) {
+		until = asOf
+	}
+
+	now := time.Now().UTC()
+	inv := &monigo.Invoice{
+		OrgID:          orgID,
+		CustomerID:     sub.CustomerID,
+		SubscriptionID: sub.ID,
+		Status:         monigo.InvoiceStatusDraft,
+		Currency:       sub.Currency,
+		PeriodStart:    start,
+		PeriodEnd:      end,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	subtotal := new(big.Rat)
+	for _, price := range plan.Prices {
+		metric, ok := s.metrics[price.MetricID]
+		if !ok {
+			return nil, http.StatusUnprocessableEntity, fmt.Errorf("price %s references deleted metric %s", price.ID, price.MetricID)
+		}
+		qty := s.aggregate(metric, sub.CustomerID, start, until)
+		res, err := pricing.Calculate(price, qty)
+		if err != nil {
+			return nil, http.StatusUnprocessableEntity, err
+		}
+		for _, l := range res.Lines {
+			amount, _ := new(big.Rat).SetString(l.Amount)
+			subtotal.Add(subtotal, amount)
+			inv.LineItems = append(inv.LineItems, monigo.InvoiceLineItem{
+				Type:        monigo.InvoiceLineItemTypeUsage,
+				MetricID:    metric.ID,
+				PriceID:     price.ID,
+				Description: metric.Name + ": " + l.Description,
+				Quantity:    l.Quantity,
+				UnitPrice:   l.UnitPrice,
+				Amount:      amount.FloatString(2),
+				CreatedAt:   now,
+			})
+		}
+	}
+	inv.Subtotal = subtotal.FloatString(2)
+
+	total := new(big.Rat).Set(subtotal)
+	minimum := sub.MinimumAmount
+	if minimum == "" {
+		minimum = plan.MinimumAmount
+	}
+	factor := prorationFactor(sub, plan, start, end)
+	if factor != nil {
+		inv.ProrationFactor = factor.FloatString(6)
+	}
+	if minimum != "" {
+		min, ok := new(big.Rat).SetString(minimum)
+		if !ok {
+			return nil, http.StatusUnprocessableEntity, fmt.Errorf("invalid minimum_amount %q", minimum)
+		}
+		if factor != nil {
+			min.Mul(min, factor)
+		}
+		if total.Cmp(min) < 0 {
+			adj := new(big.Rat).Sub(min, total)
+			total.Set(min)
+			inv.MinimumCommitmentAdjustment = adj.FloatString(2)
+			inv.LineItems = append(inv.LineItems, monigo.InvoiceLineItem{
+				Type:        monigo.InvoiceLineItemTypeMinimumCommitment,
+				Description: "Minimum commitment",
+				Quantity:    "1",
+				UnitPrice:   adj.FloatString(6),
+				Amount:      adj.FloatString(2),
+				CreatedAt:   now,
+			})
+		}
+	}
+	inv.Total = total.FloatString(2)
+	inv.AmountDue = inv.Total
+	return inv, http.StatusOK, nil
+}
+
+// aggregate applies the metric's aggregation to the customer's events with
+// timestamps in [from, to). Callers hold s.mu.
+func (s *Server) aggregate(m *monigo.Metric, customerID string, from, to time.Time) string {
+	var events []monigo.IngestEvent
+	for _, e := range s.events {
+		if e.CustomerID == customerID {
+			events = append(events, e)
+		}
+	}
+	return pricing.Aggregate(*m, events, from, to)
+}
+
+// prorationFactor returns the share of a full billing period that [start,
+// end) covers when it is the partial first period of an anchored, prorated
+// subscription, or nil otherwise.
+func prorationFactor(sub *monigo.Subscription, plan *monigo.Plan, start, end time.Time) *big.Rat {
+	if sub.BillingCycleAnchor == nil || sub.ProrationBehavior != monigo.ProrationBehaviorProrate ||
+		!start.Equal(sub.CurrentPeriodStart) || !end.Equal(sub.CurrentPeriodEnd) {
+		return nil
+	}
+	full := end.Sub(periodBefore(end, plan.BillingPeriod))
+	if end.Sub(start) >= full {
+		return nil
+	}
+	return big.NewRat(int64(end.Sub(start)), int64(full))
+}
@@ -0,0 +1,705 @@
+// Package monigotest provides an in-memory fake of the Monigo API for
+// integration tests. It implements customers, metrics, plans, subscriptions,
+// event ingestion with idempotency-key de-duplication, and invoice preview
+// and generation priced by the pricing package, so code that drives a full
+// billing flow can be tested without a network or a test-mode organisation:
+//
+//	func TestCheckout(t *testing.T) {
+//		srv := monigotest.NewServer(t)
+//		client := srv.Client()
+//
+//		// ... create a metric, plan, customer, and subscription with client,
+//		// run the code under test, then:
+//		inv, err := client.Invoices.Generate(ctx, sub.ID)
+//	}
+//
+// Endpoints the fake does not implement answer 501 Not Implemented.
+package monigotest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+	"github.com/monigo-africa/go-monigo/pricing"
+)
+
+// Server is an in-memory Monigo API. It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the fake, for monigo.WithBaseURL.
+	URL string
+
+	srv *httptest.Server
+
+	mu            sync.Mutex
+	seq           int
+	customers     map[string]*monigo.Customer
+	metrics       map[string]*monigo.Metric
+	plans         map[string]*monigo.Plan
+	subscriptions map[string]*monigo.Subscription
+	invoices      map[string]*monigo.Invoice
+	events        []monigo.IngestEvent
+	seen          map[string]bool
+}
+
+// NewServer starts a fake Monigo API that is shut down when t finishes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+	s := &Server{
+		customers:     make(map[string]*monigo.Customer),
+		metrics:       make(map[string]*monigo.Metric),
+		plans:         make(map[string]*monigo.Plan),
+		subscriptions: make(map[string]*monigo.Subscription),
+		invoices:      make(map[string]*monigo.Invoice),
+		seen:          make(map[string]bool),
+	}
+	s.srv = httptest.NewServer(s.routes())
+	s.URL = s.srv.URL
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+// Client returns a client pointed at the fake with a test-mode key. opts are
+// applied after the base URL.
+func (s *Server) Client(opts ...monigo.Option) *monigo.Client {
+	return monigo.New("sk_test_monigotest", append([]monigo.Option{monigo.WithBaseURL(s.URL)}, opts...)...)
+}
+
+// Events returns every distinct event ingested so far, in arrival order.
+func (s *Server) Events() []monigo.IngestEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]monigo.IngestEvent(nil), s.events...)
+}
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/capabilities", s.capabilities)
+
+	mux.HandleFunc("POST /v1/customers", s.createCustomer)
+	mux.HandleFunc("GET /v1/customers", s.listCustomers)
+	mux.HandleFunc("GET /v1/customers/{id}", s.getCustomer)
+	mux.HandleFunc("DELETE /v1/customers/{id}", s.deleteCustomer)
+
+	mux.HandleFunc("POST /v1/metrics", s.createMetric)
+	mux.HandleFunc("GET /v1/metrics", s.listMetrics)
+	mux.HandleFunc("GET /v1/metrics/{id}", s.getMetric)
+	mux.HandleFunc("DELETE /v1/metrics/{id}", s.deleteMetric)
+
+	mux.HandleFunc("POST /v1/plans", s.createPlan)
+	mux.HandleFunc("GET /v1/plans", s.listPlans)
+	mux.HandleFunc("GET /v1/plans/{id}", s.getPlan)
+	mux.HandleFunc("DELETE /v1/plans/{id}", s.deletePlan)
+
+	mux.HandleFunc("POST /v1/subscriptions", s.createSubscription)
+	mux.HandleFunc("GET /v1/subscriptions", s.listSubscriptions)
+	mux.HandleFunc("GET /v1/subscriptions/{id}", s.getSubscription)
+	mux.HandleFunc("DELETE /v1/subscriptions/{id}", s.deleteSubscription)
+
+	mux.HandleFunc("POST /v1/ingest", s.ingest)
+
+	mux.HandleFunc("GET /v1/invoices/preview", s.previewInvoice)
+	mux.HandleFunc("POST /v1/invoices/generate", s.generateInvoice)
+	mux.HandleFunc("GET /v1/invoices", s.listInvoices)
+	mux.HandleFunc("GET /v1/invoices/{id}", s.getInvoice)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		respondError(w, http.StatusNotImplemented, "", fmt.Sprintf("monigotest: %s %s is not implemented", r.Method, r.URL.Path))
+	})
+	return mux
+}
+
+func (s *Server) capabilities(w http.ResponseWriter, r *http.Request) {
+	respond(w, http.StatusOK, map[string]any{"capabilities": monigo.Capabilities{
+		KeyID:  "key_monigotest",
+		OrgID:  orgID,
+		Scopes: []string{monigo.ScopeRead, monigo.ScopeWrite},
+		IsTest: true,
+	}})
+}
+
+// ---------------------------------------------------------------------------
+// Customers
+// ---------------------------------------------------------------------------
+
+func (s *Server) createCustomer(w http.ResponseWriter, r *http.Request) {
+	var req monigo.CreateCustomerRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.customers {
+		if req.ExternalID != "" && c.ExternalID == req.ExternalID {
+			respondError(w, http.StatusConflict, monigo.ErrCodeDuplicateCustomer, "a customer with this external_id already exists")
+			return
+		}
+	}
+	now := time.Now().UTC()
+	c := &monigo.Customer{
+		ID:         s.newID("cust"),
+		OrgID:      orgID,
+		ExternalID: req.ExternalID,
+		Name:       req.Name,
+		Email:      req.Email,
+		Phone:      req.Phone,
+		Locale:     req.Locale,
+		Metadata:   req.Metadata,
+		Status:     monigo.CustomerStatusActive,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	s.customers[c.ID] = c
+	respond(w, http.StatusCreated, map[string]any{"customer": c})
+}
+
+func (s *Server) listCustomers(w http.ResponseWriter, r *http.Request) {
+	externalID := r.URL.Query().Get("external_id")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []monigo.Customer{}
+	for _, c := range s.customers {
+		if externalID == "" || c.ExternalID == externalID {
+			out = append(out, *c)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	respond(w, http.StatusOK, monigo.ListCustomersResponse{Customers: out, Count: len(out)})
+}
+
+func (s *Server) getCustomer(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.customers[r.PathValue("id")]
+	if !ok {
+		notFound(w, "customer")
+		return
+	}
+	respond(w, http.StatusOK, map[string]any{"customer": c})
+}
+
+func (s *Server) deleteCustomer(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.customers[r.PathValue("id")]; !ok {
+		notFound(w, "customer")
+		return
+	}
+	delete(s.customers, r.PathValue("id"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ---------------------------------------------------------------------------
+// Metrics
+// ---------------------------------------------------------------------------
+
+func (s *Server) createMetric(w http.ResponseWriter, r *http.Request) {
+	var req monigo.CreateMetricRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	switch req.Aggregation {
+	case monigo.AggregationCount, monigo.AggregationUnique:
+	case monigo.AggregationSum, monigo.AggregationMax, monigo.AggregationMin, monigo.AggregationAverage,
+		monigo.AggregationLatest, monigo.AggregationTimeWeighted:
+		if req.AggregationProperty == "" {
+			respondError(w, http.StatusUnprocessableEntity, monigo.ErrCodeValidationFailed, "aggregation_property is required for "+string(req.Aggregation))
+			return
+		}
+	default:
+		respondError(w, http.StatusUnprocessableEntity, monigo.ErrCodeValidationFailed, fmt.Sprintf("unknown aggregation %q", req.Aggregation))
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now().UTC()
+	m := &monigo.Metric{
+		ID:                  s.newID("metric"),
+		OrgID:               orgID,
+		Name:                req.Name,
+		EventName:           req.EventName,
+		Aggregation:         req.Aggregation,
+		AggregationProperty: req.AggregationProperty,
+		Description:         req.Description,
+		PropertyRules:       req.PropertyRules,
+		Filters:             req.Filters,
+		GroupBy:             req.GroupBy,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+	s.metrics[m.ID] = m
+	respond(w, http.StatusCreated, map[string]any{"metric": m})
+}
+
+func (s *Server) listMetrics(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []monigo.Metric{}
+	for _, m := range s.metrics {
+		out = append(out, *m)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	respond(w, http.StatusOK, monigo.ListMetricsResponse{Metrics: out, Count: len(out)})
+}
+
+func (s *Server) getMetric(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, ok := s.metrics[r.PathValue("id")]
+	if !ok {
+		notFound(w, "metric")
+		return
+	}
+	respond(w, http.StatusOK, map[string]any{"metric": m})
+}
+
+func (s *Server) deleteMetric(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := r.PathValue("id")
+	if _, ok := s.metrics[id]; !ok {
+		notFound(w, "metric")
+		return
+	}
+	for _, p := range s.plans {
+		for _, price := range p.Prices {
+			if price.MetricID == id {
+				respondError(w, http.StatusConflict, monigo.ErrCodeMetricInUse, "metric is used by plan "+p.ID)
+				return
+			}
+		}
+	}
+	delete(s.metrics, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ---------------------------------------------------------------------------
+// Plans
+// ---------------------------------------------------------------------------
+
+func (s *Server) createPlan(w http.ResponseWriter, r *http.Request) {
+	var req monigo.CreatePlanRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now().UTC()
+	p := &monigo.Plan{
+		ID:            s.newID("plan"),
+		OrgID:         orgID,
+		Name:          req.Name,
+		Description:   req.Description,
+		Currency:      orDefault(req.Currency, "NGN"),
+		PlanType:      orDefault(req.PlanType, monigo.PlanTypeCollection),
+		BillingPeriod: orDefault(req.BillingPeriod, monigo.BillingPeriodMonthly),
+		MinimumAmount: req.MinimumAmount,
+		Status:        monigo.PlanStatusActive,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	for _, pr := range req.Prices {
+		if _, ok := s.metrics[pr.MetricID]; !ok {
+			respondError(w, http.StatusUnprocessableEntity, monigo.ErrCodeValidationFailed, fmt.Sprintf("price references unknown metric %q", pr.MetricID))
+			return
+		}
+		price := monigo.Price{
+			ID:        s.newID("price"),
+			PlanID:    p.ID,
+			MetricID:  pr.MetricID,
+			Model:     pr.Model,
+			UnitPrice: pr.UnitPrice,
+			Tiers:     pr.Tiers,
+			Currency:  pr.Currency,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if _, err := pricing.Calculate(price, "0"); err != nil {
+			respondError(w, http.StatusUnprocessableEntity, monigo.ErrCodeInvalidTier, err.Error())
+			return
+		}
+		p.Prices = append(p.Prices, price)
+	}
+	s.plans[p.ID] = p
+	respond(w, http.StatusCreated, map[string]any{"plan": p})
+}
+
+func (s *Server) listPlans(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []monigo.Plan{}
+	for _, p := range s.plans {
+		out = append(out, *p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	respond(w, http.StatusOK, monigo.ListPlansResponse{Plans: out, Count: len(out)})
+}
+
+func (s *Server) getPlan(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.plans[r.PathValue("id")]
+	if !ok {
+		notFound(w, "plan")
+		return
+	}
+	respond(w, http.StatusOK, map[string]any{"plan": p})
+}
+
+func (s *Server) deletePlan(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := r.PathValue("id")
+	if _, ok := s.plans[id]; !ok {
+		notFound(w, "plan")
+		return
+	}
+	for _, sub := range s.subscriptions {
+		if sub.PlanID == id && sub.Status == monigo.SubscriptionStatusActive {
+			respondError(w, http.StatusConflict, monigo.ErrCodePlanHasActiveSubscriptions, "plan has active subscriptions")
+			return
+		}
+	}
+	delete(s.plans, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ---------------------------------------------------------------------------
+// Subscriptions
+// ---------------------------------------------------------------------------
+
+func (s *Server) createSubscription(w http.ResponseWriter, r *http.Request) {
+	var req monigo.CreateSubscriptionRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.customers[req.CustomerID]; !ok {
+		notFound(w, "customer")
+		return
+	}
+	plan, ok := s.plans[req.PlanID]
+	if !ok {
+		notFound(w, "plan")
+		return
+	}
+	for _, sub := range s.subscriptions {
+		if sub.CustomerID == req.CustomerID && sub.PlanID == req.PlanID && sub.Status == monigo.SubscriptionStatusActive {
+			respondError(w, http.StatusConflict, monigo.ErrCodeSubscriptionExists, "customer is already subscribed to this plan")
+			return
+		}
+	}
+	now := time.Now().UTC()
+	start, status := now, monigo.SubscriptionStatusActive
+	switch {
+	case req.StartAt != nil:
+		start, status = req.StartAt.UTC(), monigo.SubscriptionStatusScheduled
+	case req.BackdateTo != nil:
+		start = req.BackdateTo.UTC()
+	}
+	end := periodEnd(start, plan.BillingPeriod)
+	if req.BillingCycleAnchor != nil {
+		end = anchoredPeriodEnd(start, req.BillingCycleAnchor.UTC(), plan.BillingPeriod)
+	}
+	sub := &monigo.Subscription{
+		ID:                 s.newID("sub"),
+		OrgID:              orgID,
+		CustomerID:         req.CustomerID,
+		PlanID:             req.PlanID,
+		Status:             status,
+		CurrentPeriodStart: start,
+		CurrentPeriodEnd:   end,
+		MinimumAmount:      req.MinimumAmount,
+		Currency:           orDefault(req.Currency, plan.Currency),
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+	if status == monigo.SubscriptionStatusScheduled {
+		sub.ScheduledStartAt = &start
+	}
+	if req.BillingCycleAnchor != nil {
+		anchor := req.BillingCycleAnchor.UTC()
+		sub.BillingCycleAnchor = &anchor
+		sub.ProrationBehavior = orDefault(req.ProrationBehavior, monigo.ProrationBehaviorProrate)
+	}
+	s.subscriptions[sub.ID] = sub
+	respond(w, http.StatusCreated, map[string]any{"subscription": sub})
+}
+
+func (s *Server) listSubscriptions(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	var bounds [3]time.Time
+	for i, name := range []string{"created_after", "created_before", "current_period_end_before"} {
+		if v := q.Get(name); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				respondError(w, http.StatusBadRequest, monigo.ErrCodeValidationFailed, name+" must be RFC3339")
+				return
+			}
+			bounds[i] = t
+		}
+	}
+	createdAfter, createdBefore, periodEndBefore := bounds[0], bounds[1], bounds[2]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []monigo.Subscription{}
+	for _, sub := range s.subscriptions {
+		if (q.Get("customer_id") == "" || sub.CustomerID == q.Get("customer_id")) &&
+			(q.Get("plan_id") == "" || sub.PlanID == q.Get("plan_id")) &&
+			(q.Get("status") == "" || string(sub.Status) == q.Get("status")) &&
+			(createdAfter.IsZero() || !sub.CreatedAt.Before(createdAfter)) &&
+			(createdBefore.IsZero() || sub.CreatedAt.Before(createdBefore)) &&
+			(periodEndBefore.IsZero() || sub.CurrentPeriodEnd.Before(periodEndBefore)) {
+			out = append(out, s.expand(*sub, q.Get("expand")))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	respond(w, http.StatusOK, monigo.ListSubscriptionsResponse{Subscriptions: out, Count: len(out)})
+}
+
+func (s *Server) getSubscription(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sub, ok := s.subscriptions[r.PathValue("id")]
+	if !ok {
+		notFound(w, "subscription")
+		return
+	}
+	respond(w, http.StatusOK, map[string]any{"subscription": s.expand(*sub, r.URL.Query().Get("expand"))})
+}
+
+// expand embeds the objects named in the comma-separated expand parameter.
+// Callers hold s.mu.
+func (s *Server) expand(sub monigo.Subscription, expand string) monigo.Subscription {
+	for _, name := range strings.Split(expand, ",") {
+		switch name {
+		case monigo.ExpandCustomer:
+			if c, ok := s.customers[sub.CustomerID]; ok {
+				cust := *c
+				sub.Customer = &cust
+			}
+		case monigo.ExpandPlan:
+			if p, ok := s.plans[sub.PlanID]; ok {
+				plan := *p
+				sub.Plan = &plan
+			}
+		}
+	}
+	return sub
+}
+
+func (s *Server) deleteSubscription(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.subscriptions[r.PathValue("id")]; !ok {
+		notFound(w, "subscription")
+		return
+	}
+	delete(s.subscriptions, r.PathValue("id"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ---------------------------------------------------------------------------
+// Ingest
+// ---------------------------------------------------------------------------
+
+func (s *Server) ingest(w http.ResponseWriter, r *http.Request) {
+	var req monigo.IngestRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	for i, e := range req.Events {
+		if e.EventName == "" || e.CustomerID == "" || e.IdempotencyKey == "" {
+			respondError(w, http.StatusUnprocessableEntity, monigo.ErrCodeValidationFailed,
+				fmt.Sprintf("events[%d]: event_name, customer_id, and idempotency_key are required", i))
+			return
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := monigo.IngestResponse{Ingested: []string{}, Duplicates: []string{}}
+	for _, e := range req.Events {
+		if s.seen[e.IdempotencyKey] {
+			resp.Duplicates = append(resp.Duplicates, e.IdempotencyKey)
+			continue
+		}
+		s.seen[e.IdempotencyKey] = true
+		if e.Timestamp.IsZero() {
+			e.Timestamp = time.Now().UTC()
+		}
+		s.events = append(s.events, e)
+		resp.Ingested = append(resp.Ingested, e.IdempotencyKey)
+	}
+	respond(w, http.StatusAccepted, resp)
+}
+
+// ---------------------------------------------------------------------------
+// Invoices
+// ---------------------------------------------------------------------------
+
+func (s *Server) previewInvoice(w http.ResponseWriter, r *http.Request) {
+	asOf := time.Now().UTC()
+	if v := r.URL.Query().Get("as_of"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, monigo.ErrCodeValidationFailed, "as_of must be RFC3339")
+			return
+		}
+		asOf = t
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	inv, status, err := s.buildInvoice(r.URL.Query().Get("subscription_id"), time.Time{}, time.Time{}, asOf)
+	if err != nil {
+		respondError(w, status, "", err.Error())
+		return
+	}
+	respond(w, http.StatusOK, map[string]any{"invoice": inv})
+}
+
+func (s *Server) generateInvoice(w http.ResponseWriter, r *http.Request) {
+	var req monigo.GenerateInvoiceRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var start, end time.Time
+	if req.PeriodStart != nil && req.PeriodEnd != nil {
+		start, end = *req.PeriodStart, *req.PeriodEnd
+	}
+	inv, status, err := s.buildInvoice(req.SubscriptionID, start, end, time.Now().UTC())
+	if err != nil {
+		respondError(w, status, "", err.Error())
+		return
+	}
+	inv.ID = s.newID("inv")
+	for i := range inv.LineItems {
+		inv.LineItems[i].ID = s.newID("li")
+		inv.LineItems[i].InvoiceID = inv.ID
+	}
+	s.invoices[inv.ID] = inv
+	respond(w, http.StatusCreated, map[string]any{"invoice": inv})
+}
+
+func (s *Server) listInvoices(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []monigo.Invoice{}
+	for _, inv := range s.invoices {
+		if (q.Get("customer_id") == "" || inv.CustomerID == q.Get("customer_id")) &&
+			(q.Get("subscription_id") == "" || inv.SubscriptionID == q.Get("subscription_id")) &&
+			(q.Get("status") == "" || string(inv.Status) == q.Get("status")) {
+			out = append(out, *inv)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	respond(w, http.StatusOK, monigo.ListInvoicesResponse{Invoices: out, Count: len(out)})
+}
+
+func (s *Server) getInvoice(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	inv, ok := s.invoices[r.PathValue("id")]
+	if !ok {
+		notFound(w, "invoice")
+		return
+	}
+	respond(w, http.StatusOK, map[string]any{"invoice": inv})
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+const orgID = "org_monigotest"
+
+// newID returns a readable, unique ID such as "cust_3". Callers hold s.mu.
+func (s *Server) newID(prefix string) string {
+	s.seq++
+	return fmt.Sprintf("%s_%d", prefix, s.seq)
+}
+
+func orDefault[T ~string](v, def T) T {
+	if v == "" {
+		return def
+	}
+	return v
+}
+
+// periodEnd returns the end of the billing period starting at start.
+func periodEnd(start time.Time, period monigo.BillingPeriod) time.Time {
+	switch period {
+	case monigo.BillingPeriodDaily:
+		return start.AddDate(0, 0, 1)
+	case monigo.BillingPeriodWeekly:
+		return start.AddDate(0, 0, 7)
+	case monigo.BillingPeriodQuarterly:
+		return start.AddDate(0, 3, 0)
+	case monigo.BillingPeriodAnnually:
+		return start.AddDate(1, 0, 0)
+	default:
+		return start.AddDate(0, 1, 0)
+	}
+}
+
+// periodBefore returns the start of the billing period that ends at end.
+func periodBefore(end time.Time, period monigo.BillingPeriod) time.Time {
+	switch period {
+	case monigo.BillingPeriodDaily:
+		return end.AddDate(0, 0, -1)
+	case monigo.BillingPeriodWeekly:
+		return end.AddDate(0, 0, -7)
+	case monigo.BillingPeriodQuarterly:
+		return end.AddDate(0, -3, 0)
+	case monigo.BillingPeriodAnnually:
+		return end.AddDate(-1, 0, 0)
+	default:
+		return end.AddDate(0, -1, 0)
+	}
+}
+
+// anchoredPeriodEnd returns the first occurrence of anchor, stepping by
+// whole billing periods, that is after start.
+func anchoredPeriodEnd(start, anchor time.Time, period monigo.BillingPeriod) time.Time {
+	end := anchor
+	for !end.After(start) {
+		end = periodEnd(end, period)
+	}
+	for prev := periodBefore(end, period); prev.After(start); prev = periodBefore(end, period) {
+		end = prev
+	}
+	return end
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		respondError(w, http.StatusBadRequest, monigo.ErrCodeValidationFailed, "invalid JSON body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+func respond(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func respondError(w http.ResponseWriter, status int, code monigo.ErrorCode, msg string) {
+	respond(w, status, monigo.APIError{Code: code, Message: msg})
+}
+
+func notFound(w http.ResponseWriter, what string) {
+	respondError(w, http.StatusNotFound, monigo.ErrCodeNotFound, what+" not found")
+}
@@ -0,0 +1,327 @@
+package monigotest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+	"github.com/monigo-africa/go-monigo/e2e"
+	"github.com/monigo-africa/go-monigo/monigotest"
+)
+
+func TestServer_DefaultScenarios(t *testing.T) {
+	srv := monigotest.NewServer(t)
+	r := e2e.NewRunner(srv.Client())
+	r.SettleTimeout = 0
+
+	for _, sc := range e2e.DefaultScenarios() {
+		t.Run(sc.Name, func(t *testing.T) {
+			if _, err := r.Run(context.Background(), sc); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestServer_IngestDeduplicates(t *testing.T) {
+	srv := monigotest.NewServer(t)
+	client := srv.Client()
+	ctx := context.Background()
+
+	event := monigo.IngestEvent{
+		EventName:      "api_call",
+		CustomerID:     "cust_1",
+		IdempotencyKey: "evt-1",
+		Timestamp:      time.Now(),
+	}
+	if _, err := client.Events.Ingest(ctx, monigo.IngestRequest{Events: []monigo.IngestEvent{event}}); err != nil {
+		t.Fatalf("first ingest: %v", err)
+	}
+	resp, err := client.Events.Ingest(ctx, monigo.IngestRequest{Events: []monigo.IngestEvent{event}})
+	if err != nil {
+		t.Fatalf("second ingest: %v", err)
+	}
+	if len(resp.Ingested) != 0 || len(resp.Duplicates) != 1 {
+		t.Errorf("expected one duplicate, got %+v", resp)
+	}
+	if got := len(srv.Events()); got != 1 {
+		t.Errorf("expected 1 stored event, got %d", got)
+	}
+}
+
+func TestServer_InvoiceAggregatesUsage(t *testing.T) {
+	srv := monigotest.NewServer(t)
+	client := srv.Client()
+	ctx := context.Background()
+
+	metric, err := client.Metrics.Create(ctx, monigo.CreateMetricRequest{
+		Name:                "Peak seats",
+		EventName:           "seats",
+		Aggregation:         monigo.AggregationMax,
+		AggregationProperty: "count",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := client.Plans.Create(ctx, monigo.CreatePlanRequest{
+		Name:          "Seats",
+		MinimumAmount: "50.00",
+		Prices: []monigo.CreatePriceRequest{
+			{MetricID: metric.ID, Model: monigo.PricingModelFlat, UnitPrice: "5.000000"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cust, err := client.Customers.Create(ctx, monigo.CreateCustomerRequest{ExternalID: "acme", Name: "Acme"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub, err := client.Subscriptions.Create(ctx, monigo.CreateSubscriptionRequest{CustomerID: cust.ID, PlanID: plan.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	var events []monigo.IngestEvent
+	for i, n := range []int{4, 7, 6} {
+		events = append(events, monigo.IngestEvent{
+			EventName:      "seats",
+			CustomerID:     cust.ID,
+			IdempotencyKey: "seats-" + string(rune('a'+i)),
+			Timestamp:      now,
+			Properties:     map[string]any{"count": n},
+		})
+	}
+	if _, err := client.Events.Ingest(ctx, monigo.IngestRequest{Events: events}); err != nil {
+		t.Fatal(err)
+	}
+
+	inv, err := client.Invoices.Generate(ctx, sub.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.Subtotal != "35.00" || inv.Total != "50.00" || inv.MinimumCommitmentAdjustment != "15.00" {
+		t.Errorf("unexpected totals: subtotal %s, total %s, adjustment %s", inv.Subtotal, inv.Total, inv.MinimumCommitmentAdjustment)
+	}
+	if len(inv.LineItems) != 2 || inv.LineItems[0].Quantity != "7" {
+		t.Errorf("unexpected line items: %+v", inv.LineItems)
+	}
+
+	got, err := client.Invoices.Get(ctx, inv.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != inv.ID {
+		t.Errorf("expected invoice %s, got %s", inv.ID, got.ID)
+	}
+}
+
+func TestServer_InvoiceForExplicitPeriod(t *testing.T) {
+	srv := monigotest.NewServer(t)
+	client := srv.Client()
+	ctx := context.Background()
+
+	metric, err := client.Metrics.Create(ctx, monigo.CreateMetricRequest{Name: "Calls", EventName: "call", Aggregation: monigo.AggregationCount})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := client.Plans.Create(ctx, monigo.CreatePlanRequest{
+		Name:   "Calls",
+		Prices: []monigo.CreatePriceRequest{{MetricID: metric.ID, Model: monigo.PricingModelPerUnit, UnitPrice: "1.000000"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cust, err := client.Customers.Create(ctx, monigo.CreateCustomerRequest{ExternalID: "acme", Name: "Acme"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub, err := client.Subscriptions.Create(ctx, monigo.CreateSubscriptionRequest{CustomerID: cust.ID, PlanID: plan.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	events := []monigo.IngestEvent{
+		{EventName: "call", CustomerID: cust.ID, IdempotencyKey: "old-1", Timestamp: start.Add(time.Hour)},
+		{EventName: "call", CustomerID: cust.ID, IdempotencyKey: "old-2", Timestamp: end.Add(-time.Hour)},
+		{EventName: "call", CustomerID: cust.ID, IdempotencyKey: "after", Timestamp: end},
+	}
+	if _, err := client.Events.Ingest(ctx, monigo.IngestRequest{Events: events}); err != nil {
+		t.Fatal(err)
+	}
+
+	inv, err := client.Invoices.GenerateWithOptions(ctx, sub.ID, monigo.GenerateInvoiceOptions{PeriodStart: start, PeriodEnd: end})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !inv.PeriodStart.Equal(start) || inv.Subtotal != "2.00" {
+		t.Errorf("expected two calls billed for June 2025, got period %v subtotal %s", inv.PeriodStart, inv.Subtotal)
+	}
+}
+
+func TestServer_BillingCycleAnchorProratesFirstInvoice(t *testing.T) {
+	srv := monigotest.NewServer(t)
+	client := srv.Client()
+	ctx := context.Background()
+
+	plan, err := client.Plans.Create(ctx, monigo.CreatePlanRequest{Name: "Platform", MinimumAmount: "3100.00"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cust, err := client.Customers.Create(ctx, monigo.CreateCustomerRequest{ExternalID: "acme", Name: "Acme"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC)
+	anchor := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	sub, err := client.Subscriptions.Create(ctx, monigo.CreateSubscriptionRequest{
+		CustomerID:         cust.ID,
+		PlanID:             plan.ID,
+		BackdateTo:         &start,
+		BillingCycleAnchor: &anchor,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC); !sub.CurrentPeriodEnd.Equal(want) {
+		t.Fatalf("expected the first period to end on %v, got %v", want, sub.CurrentPeriodEnd)
+	}
+
+	inv, err := client.Invoices.Generate(ctx, sub.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 16 of January's 31 days.
+	if inv.ProrationFactor != "0.516129" || inv.Total != "1600.00" {
+		t.Errorf("expected a prorated minimum of 1600.00, got factor %s total %s", inv.ProrationFactor, inv.Total)
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	srv := monigotest.NewServer(t)
+	client := srv.Client()
+	ctx := context.Background()
+
+	if _, err := client.Customers.Get(ctx, "cust_missing"); !monigo.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	if _, err := client.Customers.Create(ctx, monigo.CreateCustomerRequest{ExternalID: "dup", Name: "Dup"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Customers.Create(ctx, monigo.CreateCustomerRequest{ExternalID: "dup", Name: "Dup"}); !errors.Is(err, monigo.ErrCodeDuplicateCustomer) {
+		t.Errorf("expected duplicate_customer, got %v", err)
+	}
+
+	var apiErr *monigo.APIError
+	if _, err := client.Payouts.Get(ctx, "slip-1"); !errors.As(err, &apiErr) || apiErr.StatusCode != 501 {
+		t.Errorf("expected 501 for an unimplemented endpoint, got %v", err)
+	}
+}
+
+func TestServer_MetricFilters(t *testing.T) {
+	srv := monigotest.NewServer(t)
+	client := srv.Client()
+	ctx := context.Background()
+
+	metric, err := client.Metrics.Create(ctx, monigo.CreateMetricRequest{
+		Name:        "EU calls",
+		EventName:   "api_call",
+		Aggregation: monigo.AggregationCount,
+		Filters:     []monigo.MetricFilter{{Property: "region", Operator: monigo.MetricFilterEq, Value: "eu"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := client.Plans.Create(ctx, monigo.CreatePlanRequest{
+		Name:   "EU",
+		Prices: []monigo.CreatePriceRequest{{MetricID: metric.ID, Model: monigo.PricingModelFlat, UnitPrice: "1"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cust, err := client.Customers.Create(ctx, monigo.CreateCustomerRequest{Name: "Acme"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub, err := client.Subscriptions.Create(ctx, monigo.CreateSubscriptionRequest{CustomerID: cust.ID, PlanID: plan.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var events []monigo.IngestEvent
+	for i, region := range []string{"eu", "us", "eu"} {
+		events = append(events, monigo.IngestEvent{
+			EventName:      "api_call",
+			CustomerID:     cust.ID,
+			IdempotencyKey: "call-" + string(rune('a'+i)),
+			Timestamp:      time.Now(),
+			Properties:     map[string]any{"region": region},
+		})
+	}
+	if _, err := client.Events.Ingest(ctx, monigo.IngestRequest{Events: events}); err != nil {
+		t.Fatal(err)
+	}
+
+	inv, err := client.Invoices.Preview(ctx, sub.ID, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.Subtotal != "2.00" {
+		t.Errorf("expected only EU calls billed, got subtotal %s", inv.Subtotal)
+	}
+}
+
+func TestServer_TimeWeightedAggregation(t *testing.T) {
+	srv := monigotest.NewServer(t)
+	client := srv.Client()
+	ctx := context.Background()
+
+	metric, err := client.Metrics.Create(ctx, monigo.CreateMetricRequest{
+		Name:                "Seats",
+		EventName:           "seats.changed",
+		Aggregation:         monigo.AggregationTimeWeighted,
+		AggregationProperty: "seats",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := client.Plans.Create(ctx, monigo.CreatePlanRequest{
+		Name:   "Seats",
+		Prices: []monigo.CreatePriceRequest{{MetricID: metric.ID, Model: monigo.PricingModelFlat, UnitPrice: "1"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cust, err := client.Customers.Create(ctx, monigo.CreateCustomerRequest{Name: "Acme"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sub, err := client.Subscriptions.Create(ctx, monigo.CreateSubscriptionRequest{CustomerID: cust.ID, PlanID: plan.ID, BackdateTo: &start})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 10 seats for five days, then 20 for five days.
+	events := []monigo.IngestEvent{
+		{EventName: "seats.changed", CustomerID: cust.ID, IdempotencyKey: "s1", Timestamp: start, Properties: map[string]any{"seats": 10}},
+		{EventName: "seats.changed", CustomerID: cust.ID, IdempotencyKey: "s2", Timestamp: start.AddDate(0, 0, 5), Properties: map[string]any{"seats": 20}},
+	}
+	if _, err := client.Events.Ingest(ctx, monigo.IngestRequest{Events: events}); err != nil {
+		t.Fatal(err)
+	}
+
+	inv, err := client.Invoices.Preview(ctx, sub.ID, start.AddDate(0, 0, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.Subtotal != "15.00" {
+		t.Errorf("expected 15 time-weighted seats, got subtotal %s", inv.Subtotal)
+	}
+}
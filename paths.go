@@ -0,0 +1,34 @@
+package monigo
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// pathf fills the %s verbs in format with ids, each validated by checkID
+// and escaped as a single path segment.
+func pathf(format string, ids ...string) (string, error) {
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		if err := checkID(id); err != nil {
+			return "", err
+		}
+		args[i] = url.PathEscape(id)
+	}
+	return fmt.Sprintf(format, args...), nil
+}
+
+// checkID rejects IDs that would change which endpoint a path addresses.
+// Any other value, UUID or external ID, is passed to the API to judge.
+func checkID(id string) error {
+	switch {
+	case strings.TrimSpace(id) == "":
+		return &InvalidIDError{ID: id, Reason: "must not be empty"}
+	case id == "." || id == "..":
+		return &InvalidIDError{ID: id, Reason: "must not be a relative path"}
+	case strings.IndexFunc(id, func(r rune) bool { return r < 0x20 || r == 0x7f }) >= 0:
+		return &InvalidIDError{ID: id, Reason: "must not contain control characters"}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package monigo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestPaths_EscapeIDs(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.EscapedPath(); got != "/v1/customers/acme%2F42%3Fx=1" {
+			t.Errorf("path: got %q", got)
+		}
+		respondJSON(t, w, 200, map[string]any{"customer": sampleCustomer})
+	}))
+	if _, err := c.Customers.Get(context.Background(), "acme/42?x=1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPaths_EscapeQuery(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("customer_id"); got != "a&b=c" {
+			t.Errorf("customer_id: got %q, want a&b=c", got)
+		}
+		respondJSON(t, w, 200, monigo.ListPortalTokensResponse{})
+	}))
+	if _, err := c.PortalTokens.List(context.Background(), "a&b=c"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPaths_RejectInvalidIDs(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+	for _, id := range []string{"", "  ", "..", "a\nb"} {
+		err := c.Subscriptions.Delete(context.Background(), id)
+		var idErr *monigo.InvalidIDError
+		if !errors.Is(err, monigo.ErrInvalidID) || !errors.As(err, &idErr) || idErr.ID != id {
+			t.Errorf("%q: expected InvalidIDError, got %v", id, err)
+		}
+	}
+	if _, err := c.Customers.GetByExternalID(context.Background(), ""); !errors.Is(err, monigo.ErrInvalidID) {
+		t.Errorf("expected ErrInvalidID for an empty external ID, got %v", err)
+	}
+	if _, err := c.Invoices.Preview(context.Background(), "", time.Time{}); !errors.Is(err, monigo.ErrInvalidID) {
+		t.Errorf("expected ErrInvalidID for an empty subscription ID, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package monigo
+
+import (
+	"context"
+)
+
+// PaymentMethodService manages the cards and bank-debit mandates that
+// subscriptions with AutoCharge set collect finalized invoices from. Payment
+// methods are always scoped to a customer.
+type PaymentMethodService struct {
+	client *Client
+}
+
+// Create saves a provider authorization as a payment method on a customer.
+func (s *PaymentMethodService) Create(ctx context.Context, customerID string, req CreatePaymentMethodRequest, opts ...RequestOption) (*PaymentMethod, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	var wrapper struct {
+		PaymentMethod PaymentMethod `json:"payment_method"`
+	}
+	path, err := pathf("/v1/customers/%s/payment-methods", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.PaymentMethod, nil
+}
+
+// List returns all payment methods for a customer.
+func (s *PaymentMethodService) List(ctx context.Context, customerID string) (*ListPaymentMethodsResponse, error) {
+	var out ListPaymentMethodsResponse
+	path, err := pathf("/v1/customers/%s/payment-methods", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetDefault makes a payment method the customer's default, which
+// subscriptions without their own PaymentMethodID are charged to.
+func (s *PaymentMethodService) SetDefault(ctx context.Context, customerID, paymentMethodID string, opts ...RequestOption) (*PaymentMethod, error) {
+	var wrapper struct {
+		PaymentMethod PaymentMethod `json:"payment_method"`
+	}
+	path, err := pathf("/v1/customers/%s/payment-methods/%s/default", customerID, paymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, nil, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.PaymentMethod, nil
+}
+
+// Delete removes a payment method and revokes the provider authorization.
+// Subscriptions charging it fall back to the customer's default.
+func (s *PaymentMethodService) Delete(ctx context.Context, customerID, paymentMethodID string) error {
+	path, err := pathf("/v1/customers/%s/payment-methods/%s", customerID, paymentMethodID)
+	if err != nil {
+		return err
+	}
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
@@ -0,0 +1,115 @@
+package monigo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+var samplePaymentMethod = monigo.PaymentMethod{
+	ID:         "pm-1",
+	CustomerID: "cust-abc",
+	OrgID:      "org-1",
+	Type:       monigo.PaymentMethodTypeCard,
+	Provider:   "paystack",
+	Brand:      "visa",
+	Last4:      "4081",
+	ExpMonth:   12,
+	ExpYear:    2028,
+	IsDefault:  true,
+	CreatedAt:  time.Now(),
+}
+
+func TestPaymentMethods_Create(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/customers/cust-abc/payment-methods")
+
+		var req monigo.CreatePaymentMethodRequest
+		decodeBody(t, r, &req)
+		if req.ProviderToken != "AUTH_abc123" || req.Type != monigo.PaymentMethodTypeCard {
+			t.Errorf("unexpected body: %+v", req)
+		}
+		respondJSON(t, w, 201, map[string]any{"payment_method": samplePaymentMethod})
+	}))
+
+	pm, err := c.PaymentMethods.Create(context.Background(), "cust-abc", monigo.CreatePaymentMethodRequest{
+		Type:          monigo.PaymentMethodTypeCard,
+		Provider:      "paystack",
+		ProviderToken: "AUTH_abc123",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pm.Last4 != "4081" {
+		t.Errorf("expected last4 4081, got %q", pm.Last4)
+	}
+}
+
+func TestPaymentMethods_Create_Invalid(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected")
+	}))
+
+	_, err := c.PaymentMethods.Create(context.Background(), "cust-abc", monigo.CreatePaymentMethodRequest{Type: "cash"})
+	var verr *monigo.RequestValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected RequestValidationError, got %v", err)
+	}
+	for _, field := range []string{"type", "provider", "provider_token"} {
+		if _, ok := verr.Fields[field]; !ok {
+			t.Errorf("expected an error for %s, got %v", field, verr.Fields)
+		}
+	}
+}
+
+func TestPaymentMethods_List(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/customers/cust-abc/payment-methods")
+		respondJSON(t, w, 200, monigo.ListPaymentMethodsResponse{
+			PaymentMethods: []monigo.PaymentMethod{samplePaymentMethod},
+			Count:          1,
+		})
+	}))
+
+	resp, err := c.PaymentMethods.List(context.Background(), "cust-abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Count != 1 {
+		t.Errorf("expected count 1, got %d", resp.Count)
+	}
+}
+
+func TestPaymentMethods_SetDefault(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/customers/cust-abc/payment-methods/pm-1/default")
+		respondJSON(t, w, 200, map[string]any{"payment_method": samplePaymentMethod})
+	}))
+
+	pm, err := c.PaymentMethods.SetDefault(context.Background(), "cust-abc", "pm-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !pm.IsDefault {
+		t.Error("expected payment method to be default")
+	}
+}
+
+func TestPaymentMethods_Delete(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "DELETE")
+		assertPath(t, r, "/v1/customers/cust-abc/payment-methods/pm-1")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	if err := c.PaymentMethods.Delete(context.Background(), "cust-abc", "pm-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -2,7 +2,6 @@ package monigo
 
 import (
 	"context"
-	"fmt"
 )
 
 // PayoutAccountService manages bank or mobile-money accounts for customer payouts.
@@ -13,10 +12,16 @@ type PayoutAccountService struct {
 
 // Create adds a new payout account to a customer.
 func (s *PayoutAccountService) Create(ctx context.Context, customerID string, req CreatePayoutAccountRequest, opts ...RequestOption) (*PayoutAccount, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	var wrapper struct {
 		PayoutAccount PayoutAccount `json:"payout_account"`
 	}
-	path := fmt.Sprintf("/v1/customers/%s/payout-accounts", customerID)
+	path, err := pathf("/v1/customers/%s/payout-accounts", customerID)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.client.do(ctx, "POST", path, req, &wrapper, opts...); err != nil {
 		return nil, err
 	}
@@ -26,7 +31,10 @@ func (s *PayoutAccountService) Create(ctx context.Context, customerID string, re
 // List returns all payout accounts for a customer.
 func (s *PayoutAccountService) List(ctx context.Context, customerID string) (*ListPayoutAccountsResponse, error) {
 	var out ListPayoutAccountsResponse
-	path := fmt.Sprintf("/v1/customers/%s/payout-accounts", customerID)
+	path, err := pathf("/v1/customers/%s/payout-accounts", customerID)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
 		return nil, err
 	}
@@ -38,7 +46,10 @@ func (s *PayoutAccountService) Get(ctx context.Context, customerID, accountID st
 	var wrapper struct {
 		PayoutAccount PayoutAccount `json:"payout_account"`
 	}
-	path := fmt.Sprintf("/v1/customers/%s/payout-accounts/%s", customerID, accountID)
+	path, err := pathf("/v1/customers/%s/payout-accounts/%s", customerID, accountID)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
 		return nil, err
 	}
@@ -47,10 +58,16 @@ func (s *PayoutAccountService) Get(ctx context.Context, customerID, accountID st
 
 // Update modifies an existing payout account.
 func (s *PayoutAccountService) Update(ctx context.Context, customerID, accountID string, req UpdatePayoutAccountRequest, opts ...RequestOption) (*PayoutAccount, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	var wrapper struct {
 		PayoutAccount PayoutAccount `json:"payout_account"`
 	}
-	path := fmt.Sprintf("/v1/customers/%s/payout-accounts/%s", customerID, accountID)
+	path, err := pathf("/v1/customers/%s/payout-accounts/%s", customerID, accountID)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.client.do(ctx, "PUT", path, req, &wrapper, opts...); err != nil {
 		return nil, err
 	}
@@ -59,6 +76,22 @@ func (s *PayoutAccountService) Update(ctx context.Context, customerID, accountID
 
 // Delete permanently removes a payout account.
 func (s *PayoutAccountService) Delete(ctx context.Context, customerID, accountID string) error {
-	path := fmt.Sprintf("/v1/customers/%s/payout-accounts/%s", customerID, accountID)
+	path, err := pathf("/v1/customers/%s/payout-accounts/%s", customerID, accountID)
+	if err != nil {
+		return err
+	}
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
+
+// Verify resolves a bank account number with the bank and returns the name
+// on the account, so mistyped details can be caught before the account is
+// saved. An unrecognised account returns Valid false rather than an error.
+func (s *PayoutAccountService) Verify(ctx context.Context, req VerifyAccountRequest, opts ...RequestOption) (*AccountVerification, error) {
+	var wrapper struct {
+		Verification AccountVerification `json:"verification"`
+	}
+	if err := s.client.do(ctx, "POST", "/v1/payout-accounts/verify", req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Verification, nil
+}
@@ -140,3 +140,33 @@ func TestPayoutAccounts_Delete(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestPayoutAccounts_Verify(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/payout-accounts/verify")
+
+		var req monigo.VerifyAccountRequest
+		decodeBody(t, r, &req)
+		if req.BankCode != "011" || req.AccountNumber != "3001234567" {
+			t.Errorf("unexpected request: %+v", req)
+		}
+		respondJSON(t, w, 200, map[string]any{"verification": monigo.AccountVerification{
+			Valid:         true,
+			AccountName:   "JOHN DRIVER",
+			BankCode:      "011",
+			AccountNumber: "3001234567",
+		}})
+	}))
+
+	v, err := c.PayoutAccounts.Verify(context.Background(), monigo.VerifyAccountRequest{
+		BankCode:      "011",
+		AccountNumber: "3001234567",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !v.Valid || v.AccountName != "JOHN DRIVER" {
+		t.Errorf("unexpected verification: %+v", v)
+	}
+}
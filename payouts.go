@@ -0,0 +1,120 @@
+package monigo
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// PayoutService manages payout slips — the amounts owed to drivers, vendors,
+// and other payees on payout-type plans — and the runs that pay them.
+// Slips follow the same review-then-send lifecycle as invoices: each is
+// generated as a draft, approved or rejected, and then paid by a payout run.
+type PayoutService struct {
+	client *Client
+}
+
+// List returns payout slips, optionally filtered by status, payee, run, or
+// period.
+func (s *PayoutService) List(ctx context.Context, params ListPayoutSlipsParams) (*ListPayoutSlipsResponse, error) {
+	q := url.Values{}
+	if params.Status != "" {
+		q.Set("status", params.Status)
+	}
+	if params.CustomerID != "" {
+		q.Set("customer_id", params.CustomerID)
+	}
+	if params.RunID != "" {
+		q.Set("run_id", params.RunID)
+	}
+	if !params.PeriodStart.IsZero() {
+		q.Set("period_start", params.PeriodStart.UTC().Format(time.RFC3339))
+	}
+	if !params.PeriodEnd.IsZero() {
+		q.Set("period_end", params.PeriodEnd.UTC().Format(time.RFC3339))
+	}
+
+	path := "/v1/payout-slips"
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	var out ListPayoutSlipsResponse
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Get fetches a single payout slip, including its line items.
+func (s *PayoutService) Get(ctx context.Context, slipID string) (*PayoutSlip, error) {
+	var wrapper struct {
+		PayoutSlip PayoutSlip `json:"payout_slip"`
+	}
+	path, err := pathf("/v1/payout-slips/%s", slipID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.PayoutSlip, nil
+}
+
+// Approve marks a draft slip as ready to be paid by the next payout run.
+func (s *PayoutService) Approve(ctx context.Context, slipID string, opts ...RequestOption) (*PayoutSlip, error) {
+	var wrapper struct {
+		PayoutSlip PayoutSlip `json:"payout_slip"`
+	}
+	path, err := pathf("/v1/payout-slips/%s/approve", slipID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, nil, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.PayoutSlip, nil
+}
+
+// Reject marks a draft slip as not to be paid.
+func (s *PayoutService) Reject(ctx context.Context, slipID, reason string, opts ...RequestOption) (*PayoutSlip, error) {
+	var wrapper struct {
+		PayoutSlip PayoutSlip `json:"payout_slip"`
+	}
+	body := RejectPayoutSlipRequest{Reason: reason}
+	path, err := pathf("/v1/payout-slips/%s/reject", slipID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, body, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.PayoutSlip, nil
+}
+
+// Run starts a payout run that sends money for approved slips. The run is
+// processed asynchronously; poll GetRun for its status.
+func (s *PayoutService) Run(ctx context.Context, req CreatePayoutRunRequest, opts ...RequestOption) (*PayoutRun, error) {
+	var wrapper struct {
+		PayoutRun PayoutRun `json:"payout_run"`
+	}
+	if err := s.client.do(ctx, "POST", "/v1/payout-runs", req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.PayoutRun, nil
+}
+
+// GetRun fetches the status of a payout run.
+func (s *PayoutService) GetRun(ctx context.Context, runID string) (*PayoutRun, error) {
+	var wrapper struct {
+		PayoutRun PayoutRun `json:"payout_run"`
+	}
+	path, err := pathf("/v1/payout-runs/%s", runID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.PayoutRun, nil
+}
@@ -0,0 +1,153 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+var samplePayoutSlip = monigo.PayoutSlip{
+	ID:               "slip-1",
+	OrgID:            "org-1",
+	CustomerID:       "cust-abc",
+	SubscriptionID:   "sub-1",
+	Status:           monigo.PayoutSlipStatusDraft,
+	Currency:         "NGN",
+	GrossAmount:      "10000.00",
+	CommissionAmount: "1250.00",
+	NetAmount:        "8750.00",
+	LineItems: []monigo.PayoutSlipLineItem{
+		{ID: "li-1", MetricID: "metric-1", Description: "Trips", Quantity: "20", UnitPrice: "500.00", Amount: "10000.00"},
+	},
+	CreatedAt: time.Now(),
+	UpdatedAt: time.Now(),
+}
+
+func TestPayouts_List(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/payout-slips")
+		assertBearerToken(t, r)
+		q := r.URL.Query()
+		if q.Get("status") != monigo.PayoutSlipStatusDraft {
+			t.Errorf("status: got %q", q.Get("status"))
+		}
+		if q.Get("customer_id") != "cust-abc" {
+			t.Errorf("customer_id: got %q", q.Get("customer_id"))
+		}
+		if q.Get("period_start") != "2026-01-01T00:00:00Z" {
+			t.Errorf("period_start: got %q", q.Get("period_start"))
+		}
+		respondJSON(t, w, 200, monigo.ListPayoutSlipsResponse{
+			PayoutSlips: []monigo.PayoutSlip{samplePayoutSlip},
+			Count:       1,
+		})
+	}))
+
+	resp, err := c.Payouts.List(context.Background(), monigo.ListPayoutSlipsParams{
+		Status:      monigo.PayoutSlipStatusDraft,
+		CustomerID:  "cust-abc",
+		PeriodStart: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Count != 1 || resp.PayoutSlips[0].ID != "slip-1" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
+
+func TestPayouts_Get(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/payout-slips/slip-1")
+		respondJSON(t, w, 200, map[string]any{"payout_slip": samplePayoutSlip})
+	}))
+
+	slip, err := c.Payouts.Get(context.Background(), "slip-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if slip.NetAmount != "8750.00" || len(slip.LineItems) != 1 {
+		t.Errorf("unexpected slip: %+v", slip)
+	}
+}
+
+func TestPayouts_Approve(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/payout-slips/slip-1/approve")
+		approved := samplePayoutSlip
+		approved.Status = monigo.PayoutSlipStatusApproved
+		respondJSON(t, w, 200, map[string]any{"payout_slip": approved})
+	}))
+
+	slip, err := c.Payouts.Approve(context.Background(), "slip-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if slip.Status != monigo.PayoutSlipStatusApproved {
+		t.Errorf("status: got %q", slip.Status)
+	}
+}
+
+func TestPayouts_Reject(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/payout-slips/slip-1/reject")
+		var req monigo.RejectPayoutSlipRequest
+		decodeBody(t, r, &req)
+		if req.Reason != "duplicate trips" {
+			t.Errorf("reason: got %q", req.Reason)
+		}
+		rejected := samplePayoutSlip
+		rejected.Status = monigo.PayoutSlipStatusRejected
+		rejected.RejectionReason = req.Reason
+		respondJSON(t, w, 200, map[string]any{"payout_slip": rejected})
+	}))
+
+	slip, err := c.Payouts.Reject(context.Background(), "slip-1", "duplicate trips")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if slip.Status != monigo.PayoutSlipStatusRejected {
+		t.Errorf("status: got %q", slip.Status)
+	}
+}
+
+func TestPayouts_RunAndGetRun(t *testing.T) {
+	run := monigo.PayoutRun{ID: "run-1", Status: monigo.PayoutRunStatusPending, TotalAmount: "8750.00", SlipCount: 1}
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v1/payout-runs":
+			var req monigo.CreatePayoutRunRequest
+			decodeBody(t, r, &req)
+			if len(req.SlipIDs) != 1 || req.SlipIDs[0] != "slip-1" {
+				t.Errorf("slip_ids: got %v", req.SlipIDs)
+			}
+			respondJSON(t, w, 202, map[string]any{"payout_run": run})
+		case "GET /v1/payout-runs/run-1":
+			done := run
+			done.Status = monigo.PayoutRunStatusCompleted
+			done.PaidCount = 1
+			respondJSON(t, w, 200, map[string]any{"payout_run": done})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	started, err := c.Payouts.Run(context.Background(), monigo.CreatePayoutRunRequest{SlipIDs: []string{"slip-1"}})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	got, err := c.Payouts.GetRun(context.Background(), started.ID)
+	if err != nil {
+		t.Fatalf("GetRun: %v", err)
+	}
+	if got.Status != monigo.PayoutRunStatusCompleted || got.PaidCount != 1 {
+		t.Errorf("unexpected run: %+v", got)
+	}
+}
@@ -0,0 +1,70 @@
+package monigo
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PeriodBounds returns the start and exclusive end of the calendar period
+// of the given length that contains t, with boundaries at midnight in tz.
+// Days, weeks starting on Monday, months, quarters starting in January,
+// April, July, and October, and years are supported; any other period is
+// an error. A nil tz means UTC.
+//
+// The bounds match the API's only for calendar-aligned billing. A
+// subscription with a BillingCycleAnchor has periods aligned to that date
+// instead; bill those with its CurrentPeriodStart and CurrentPeriodEnd.
+//
+// The API splits usage at midnight in the organisation's billing
+// timezone, so pass the location from Client.BillingLocation when building
+// usage queries; splitting at UTC midnight attributes the first hour of a
+// WAT day to the previous day:
+//
+//	tz, err := client.BillingLocation(ctx)
+//	start, end, err := monigo.PeriodBounds(monigo.BillingPeriodMonthly, time.Now(), tz)
+//	usage, err := client.Usage.Query(ctx, monigo.UsageParams{From: &start, To: &end})
+func PeriodBounds(period BillingPeriod, t time.Time, tz *time.Location) (start, end time.Time, err error) {
+	if tz == nil {
+		tz = time.UTC
+	}
+	y, m, d := t.In(tz).Date()
+	switch period {
+	case BillingPeriodDaily:
+		return time.Date(y, m, d, 0, 0, 0, 0, tz), time.Date(y, m, d+1, 0, 0, 0, 0, tz), nil
+	case BillingPeriodWeekly:
+		d -= (int(time.Date(y, m, d, 0, 0, 0, 0, tz).Weekday()) + 6) % 7
+		return time.Date(y, m, d, 0, 0, 0, 0, tz), time.Date(y, m, d+7, 0, 0, 0, 0, tz), nil
+	case BillingPeriodMonthly:
+		return time.Date(y, m, 1, 0, 0, 0, 0, tz), time.Date(y, m+1, 1, 0, 0, 0, 0, tz), nil
+	case BillingPeriodQuarterly:
+		m -= (m - 1) % 3
+		return time.Date(y, m, 1, 0, 0, 0, 0, tz), time.Date(y, m+3, 1, 0, 0, 0, 0, tz), nil
+	case BillingPeriodAnnually:
+		return time.Date(y, 1, 1, 0, 0, 0, 0, tz), time.Date(y+1, 1, 1, 0, 0, 0, 0, tz), nil
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("monigo: unknown billing period %q", period)
+	}
+}
+
+// BillingLocation returns the organisation's billing timezone, from the
+// cached Capabilities or, if none are cached yet, by fetching them.
+func (c *Client) BillingLocation(ctx context.Context) (*time.Location, error) {
+	c.mu.RLock()
+	caps := c.caps
+	c.mu.RUnlock()
+	if caps == nil {
+		var err error
+		if caps, err = c.Capabilities(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if caps.BillingTimezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(caps.BillingTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("monigo: billing timezone: %w", err)
+	}
+	return loc, nil
+}
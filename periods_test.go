@@ -0,0 +1,66 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestPeriodBounds(t *testing.T) {
+	wat := time.FixedZone("WAT", 3600)
+	// 23:30 UTC on 31 January is already 1 February in Lagos.
+	at := time.Date(2026, 1, 31, 23, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		period     monigo.BillingPeriod
+		tz         *time.Location
+		start, end time.Time
+	}{
+		{monigo.BillingPeriodMonthly, nil, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{monigo.BillingPeriodMonthly, wat, time.Date(2026, 2, 1, 0, 0, 0, 0, wat), time.Date(2026, 3, 1, 0, 0, 0, 0, wat)},
+		{monigo.BillingPeriodDaily, wat, time.Date(2026, 2, 1, 0, 0, 0, 0, wat), time.Date(2026, 2, 2, 0, 0, 0, 0, wat)},
+		{monigo.BillingPeriodWeekly, wat, time.Date(2026, 1, 26, 0, 0, 0, 0, wat), time.Date(2026, 2, 2, 0, 0, 0, 0, wat)},
+		{monigo.BillingPeriodQuarterly, wat, time.Date(2026, 1, 1, 0, 0, 0, 0, wat), time.Date(2026, 4, 1, 0, 0, 0, 0, wat)},
+		{monigo.BillingPeriodAnnually, nil, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		start, end, err := monigo.PeriodBounds(tc.period, at, tc.tz)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.period, err)
+		}
+		if !start.Equal(tc.start) || !end.Equal(tc.end) {
+			t.Errorf("%s in %v: got [%v, %v), want [%v, %v)", tc.period, tc.tz, start, end, tc.start, tc.end)
+		}
+	}
+
+	if _, _, err := monigo.PeriodBounds("fortnightly", at, nil); err == nil {
+		t.Error("expected an error for an unknown period")
+	}
+}
+
+func TestClient_BillingLocation(t *testing.T) {
+	calls := 0
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertPath(t, r, "/v1/capabilities")
+		calls++
+		respondJSON(t, w, 200, map[string]any{"capabilities": monigo.Capabilities{BillingTimezone: "Africa/Lagos"}})
+	}))
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		loc, err := c.BillingLocation(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if loc.String() != "Africa/Lagos" {
+			t.Errorf("expected Africa/Lagos, got %v", loc)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected the capabilities to be fetched once, got %d calls", calls)
+	}
+}
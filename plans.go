@@ -2,7 +2,8 @@ package monigo
 
 import (
 	"context"
-	"fmt"
+	"net/url"
+	"sort"
 )
 
 // PlanService manages billing plans and their associated prices.
@@ -12,6 +13,9 @@ type PlanService struct {
 
 // Create defines a new billing plan, optionally with prices attached.
 func (s *PlanService) Create(ctx context.Context, req CreatePlanRequest, opts ...RequestOption) (*Plan, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	var wrapper struct {
 		Plan Plan `json:"plan"`
 	}
@@ -21,10 +25,20 @@ func (s *PlanService) Create(ctx context.Context, req CreatePlanRequest, opts ..
 	return &wrapper.Plan, nil
 }
 
-// List returns all billing plans for the authenticated organisation.
-func (s *PlanService) List(ctx context.Context) (*ListPlansResponse, error) {
+// List returns all billing plans for the authenticated organisation. Pass a
+// ListPlansParams to filter by metadata.
+func (s *PlanService) List(ctx context.Context, params ...ListPlansParams) (*ListPlansResponse, error) {
+	path := "/v1/plans"
+	if len(params) > 0 {
+		q := url.Values{}
+		setMetadataFilter(q, params[0].MetadataFilter)
+		if len(q) > 0 {
+			path += "?" + q.Encode()
+		}
+	}
+
 	var out ListPlansResponse
-	if err := s.client.do(ctx, "GET", "/v1/plans", nil, &out); err != nil {
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
 		return nil, err
 	}
 	return &out, nil
@@ -35,24 +49,165 @@ func (s *PlanService) Get(ctx context.Context, planID string) (*Plan, error) {
 	var wrapper struct {
 		Plan Plan `json:"plan"`
 	}
-	if err := s.client.do(ctx, "GET", fmt.Sprintf("/v1/plans/%s", planID), nil, &wrapper); err != nil {
+	path, err := pathf("/v1/plans/%s", planID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
 		return nil, err
 	}
 	return &wrapper.Plan, nil
 }
 
+// GetByName fetches the plan with the given name, with its prices. It
+// returns a 409 error (use IsConflict) when several plans share the name and
+// a 404 error (use IsNotFound) when none has it.
+func (s *PlanService) GetByName(ctx context.Context, name string) (*Plan, error) {
+	list, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var found []Plan
+	for _, p := range list.Plans {
+		if p.Name == name {
+			found = append(found, p)
+		}
+	}
+	p, err := pickByName("plan", name, found)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, p.ID)
+}
+
+// CreateRequest returns the request that would create p as it is now, in a
+// stable form for diffing: prices are sorted by metric ID then currency and
+// their tiers are normalised with NormalizeTiers. Use it to import a live
+// plan into a declarative configuration such as a Terraform state.
+func (p Plan) CreateRequest() (CreatePlanRequest, error) {
+	req := CreatePlanRequest{
+		Name:          p.Name,
+		Description:   p.Description,
+		Currency:      p.Currency,
+		PlanType:      p.PlanType,
+		BillingPeriod: p.BillingPeriod,
+		Commission:    p.Commission,
+		MinimumAmount: p.MinimumAmount,
+		TaxBehavior:   p.TaxBehavior,
+		Metadata:      p.Metadata,
+	}
+	for _, price := range p.Prices {
+		tiers, err := NormalizeTiers(price.Tiers)
+		if err != nil {
+			return req, err
+		}
+		req.Prices = append(req.Prices, CreatePriceRequest{
+			MetricID:  price.MetricID,
+			Model:     price.Model,
+			UnitPrice: price.UnitPrice,
+			Tiers:     tiers,
+			Currency:  price.Currency,
+		})
+	}
+	sort.SliceStable(req.Prices, func(i, j int) bool {
+		a, b := req.Prices[i], req.Prices[j]
+		if a.MetricID != b.MetricID {
+			return a.MetricID < b.MetricID
+		}
+		return a.Currency < b.Currency
+	})
+	return req, nil
+}
+
 // Update modifies an existing plan's name, description, or prices.
 func (s *PlanService) Update(ctx context.Context, planID string, req UpdatePlanRequest, opts ...RequestOption) (*Plan, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	var wrapper struct {
+		Plan Plan `json:"plan"`
+	}
+	path, err := pathf("/v1/plans/%s", planID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "PUT", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Plan, nil
+}
+
+// Archive stops a plan accepting new subscriptions. Existing subscriptions
+// keep billing on it unchanged.
+func (s *PlanService) Archive(ctx context.Context, planID string, opts ...RequestOption) (*Plan, error) {
+	var wrapper struct {
+		Plan Plan `json:"plan"`
+	}
+	path, err := pathf("/v1/plans/%s/archive", planID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, nil, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Plan, nil
+}
+
+// Unarchive lets an archived plan accept new subscriptions again.
+func (s *PlanService) Unarchive(ctx context.Context, planID string, opts ...RequestOption) (*Plan, error) {
 	var wrapper struct {
 		Plan Plan `json:"plan"`
 	}
-	if err := s.client.do(ctx, "PUT", fmt.Sprintf("/v1/plans/%s", planID), req, &wrapper, opts...); err != nil {
+	path, err := pathf("/v1/plans/%s/unarchive", planID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, nil, &wrapper, opts...); err != nil {
 		return nil, err
 	}
 	return &wrapper.Plan, nil
 }
 
-// Delete permanently removes a billing plan record.
+// Clone creates a new plan with the same prices as planID, applying any
+// fields set in overrides, e.g. a different currency or billing period.
+// Price amounts are copied as-is; update them on the clone if the currency
+// changed.
+func (s *PlanService) Clone(ctx context.Context, planID string, overrides ClonePlanRequest, opts ...RequestOption) (*Plan, error) {
+	var wrapper struct {
+		Plan Plan `json:"plan"`
+	}
+	path, err := pathf("/v1/plans/%s/clone", planID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, overrides, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Plan, nil
+}
+
+// Delete removes a billing plan record. Deleted plans can be recovered with Restore
+// within the retention window; after that they are purged.
 func (s *PlanService) Delete(ctx context.Context, planID string) error {
-	return s.client.do(ctx, "DELETE", fmt.Sprintf("/v1/plans/%s", planID), nil, nil)
+	path, err := pathf("/v1/plans/%s", planID)
+	if err != nil {
+		return err
+	}
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// Restore recovers a recently deleted plan. Returns a 404 error (use
+// IsNotFound) once the retention window has passed.
+func (s *PlanService) Restore(ctx context.Context, planID string, opts ...RequestOption) (*Plan, error) {
+	var wrapper struct {
+		Plan Plan `json:"plan"`
+	}
+	path, err := pathf("/v1/plans/%s/restore", planID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, nil, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Plan, nil
 }
@@ -51,6 +51,38 @@ func TestPlans_Create(t *testing.T) {
 	}
 }
 
+func TestPlans_Create_PayoutWithCommission(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req monigo.CreatePlanRequest
+		decodeBody(t, r, &req)
+		if req.Commission == nil || req.Commission.Percent != "15" {
+			t.Fatalf("commission: got %+v, want 15%%", req.Commission)
+		}
+		if len(req.Commission.MetricIDs) != 1 || req.Commission.MetricIDs[0] != "metric-1" {
+			t.Errorf("commission metric_ids: got %v", req.Commission.MetricIDs)
+		}
+		p := samplePlan
+		p.PlanType = monigo.PlanTypePayout
+		p.Commission = req.Commission
+		respondJSON(t, w, 201, map[string]any{"plan": p})
+	}))
+
+	plan, err := c.Plans.Create(context.Background(), monigo.CreatePlanRequest{
+		Name:     "Driver Payouts",
+		PlanType: monigo.PlanTypePayout,
+		Commission: &monigo.CommissionRule{
+			Percent:   "15",
+			MetricIDs: []string{"metric-1"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plan.Commission == nil || plan.Commission.Percent != "15" {
+		t.Errorf("expected commission on returned plan, got %+v", plan.Commission)
+	}
+}
+
 func TestPlans_Create_WithPrices(t *testing.T) {
 	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var req monigo.CreatePlanRequest
@@ -172,6 +204,37 @@ func TestPlans_Update(t *testing.T) {
 	}
 }
 
+func TestPlans_Update_ClearsMetadata(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		decodeBody(t, r, &body)
+		if m, ok := body["metadata"].(map[string]any); !ok || len(m) != 0 {
+			t.Errorf("expected an empty metadata object, got %v", body["metadata"])
+		}
+		respondJSON(t, w, 200, map[string]any{"plan": samplePlan})
+	}))
+
+	if _, err := c.Plans.Update(context.Background(), "plan-1", monigo.UpdatePlanRequest{Metadata: &monigo.Metadata{}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPlans_Update_RemovesMinimum(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		decodeBody(t, r, &body)
+		if v, ok := body["minimum_amount"]; !ok || v != "" {
+			t.Errorf("expected an empty minimum_amount, got %v", body["minimum_amount"])
+		}
+		respondJSON(t, w, 200, map[string]any{"plan": samplePlan})
+	}))
+
+	none := ""
+	if _, err := c.Plans.Update(context.Background(), "plan-1", monigo.UpdatePlanRequest{MinimumAmount: &none}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestPlans_Delete(t *testing.T) {
 	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertMethod(t, r, "DELETE")
@@ -183,3 +246,153 @@ func TestPlans_Delete(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestPlans_Restore(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/plans/plan-1/restore")
+		respondJSON(t, w, 200, map[string]any{"plan": samplePlan})
+	}))
+
+	got, err := c.Plans.Restore(context.Background(), samplePlan.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.ID != samplePlan.ID {
+		t.Errorf("expected %s, got %s", samplePlan.ID, got.ID)
+	}
+}
+
+func TestPlans_Archive(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/plans/plan-1/archive")
+		p := samplePlan
+		p.Status = monigo.PlanStatusArchived
+		respondJSON(t, w, 200, map[string]any{"plan": p})
+	}))
+
+	plan, err := c.Plans.Archive(context.Background(), "plan-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plan.Status != monigo.PlanStatusArchived {
+		t.Errorf("status: got %q, want archived", plan.Status)
+	}
+}
+
+func TestPlans_Unarchive(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/plans/plan-1/unarchive")
+		p := samplePlan
+		p.Status = monigo.PlanStatusActive
+		respondJSON(t, w, 200, map[string]any{"plan": p})
+	}))
+
+	plan, err := c.Plans.Unarchive(context.Background(), "plan-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plan.Status != monigo.PlanStatusActive {
+		t.Errorf("status: got %q, want active", plan.Status)
+	}
+}
+
+func TestPlans_Clone(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/plans/plan-1/clone")
+
+		var req monigo.ClonePlanRequest
+		decodeBody(t, r, &req)
+		if req.Currency != "USD" || req.BillingPeriod != monigo.BillingPeriodAnnually {
+			t.Errorf("unexpected overrides: %+v", req)
+		}
+		p := samplePlan
+		p.ID = "plan-2"
+		p.Currency = req.Currency
+		p.BillingPeriod = req.BillingPeriod
+		respondJSON(t, w, 201, map[string]any{"plan": p})
+	}))
+
+	plan, err := c.Plans.Clone(context.Background(), "plan-1", monigo.ClonePlanRequest{
+		Currency:      "USD",
+		BillingPeriod: monigo.BillingPeriodAnnually,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plan.ID != "plan-2" || plan.Currency != "USD" {
+		t.Errorf("unexpected clone: %+v", plan)
+	}
+}
+
+func TestPlans_Create_MultiCurrencyPrices(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req monigo.CreatePlanRequest
+		decodeBody(t, r, &req)
+		if len(req.Prices) != 2 {
+			t.Fatalf("expected 2 prices, got %d", len(req.Prices))
+		}
+		if req.Prices[0].Currency != "" || req.Prices[1].Currency != "KES" {
+			t.Errorf("currencies: got %q and %q", req.Prices[0].Currency, req.Prices[1].Currency)
+		}
+		respondJSON(t, w, 201, map[string]any{"plan": samplePlan})
+	}))
+
+	_, err := c.Plans.Create(context.Background(), monigo.CreatePlanRequest{
+		Name:     "API Pro",
+		Currency: "NGN",
+		Prices: []monigo.CreatePriceRequest{
+			{MetricID: "metric-1", Model: monigo.PricingModelFlat, UnitPrice: "2.000000"},
+			{MetricID: "metric-1", Model: monigo.PricingModelFlat, UnitPrice: "0.200000", Currency: "KES"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPlans_GetByName(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/plans":
+			respondJSON(t, w, 200, map[string]any{"plans": []monigo.Plan{samplePlan}, "count": 1})
+		case "/v1/plans/plan-1":
+			respondJSON(t, w, 200, map[string]any{"plan": samplePlan})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	p, err := c.Plans.GetByName(context.Background(), "API Pro")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.ID != "plan-1" {
+		t.Errorf("expected plan-1, got %s", p.ID)
+	}
+}
+
+func TestPlan_CreateRequest_IsStable(t *testing.T) {
+	plan := samplePlan
+	plan.Prices = []monigo.Price{
+		{ID: "price-2", MetricID: "metric-b", Model: monigo.PricingModelPerUnit, UnitPrice: "1.000000"},
+		{ID: "price-1", MetricID: "metric-a", Model: monigo.PricingModelTiered, Tiers: json.RawMessage(`[ {"unit_amount": "1.0", "up_to": 100} ]`)},
+	}
+
+	req, err := plan.CreateRequest()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.Name != "API Pro" || len(req.Prices) != 2 {
+		t.Fatalf("unexpected request: %+v", req)
+	}
+	if req.Prices[0].MetricID != "metric-a" {
+		t.Errorf("expected prices sorted by metric, got %s first", req.Prices[0].MetricID)
+	}
+	if got := string(req.Prices[0].Tiers); got != `[{"unit_amount":"1.0","up_to":100}]` {
+		t.Errorf("expected normalised tiers, got %s", got)
+	}
+}
@@ -0,0 +1,56 @@
+package monigo
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrJobFailed is returned, wrapped with the job's error message, by the
+// WaitForXxx helpers when an asynchronous job ends in JobStatusFailed or
+// JobStatusCanceled. Check for it with errors.Is.
+var ErrJobFailed = errors.New("monigo: job did not complete")
+
+// defaultPollInterval is used when PollOptions.Interval is zero.
+const defaultPollInterval = 3 * time.Second
+
+// PollOptions configures the WaitForXxx helpers, which poll an asynchronous
+// job until it finishes.
+type PollOptions struct {
+	// Interval is the delay between polls. Defaults to three seconds.
+	Interval time.Duration
+	// Timeout bounds the total wait. Zero waits until ctx is done.
+	Timeout time.Duration
+	// Progress, if set, is called after every poll with the job's processed
+	// and total counts.
+	Progress func(done, total int64)
+}
+
+// poll calls fetch every opts.Interval until it reports done, the timeout
+// elapses, or ctx is done. The first fetch happens immediately.
+func poll(ctx context.Context, opts PollOptions, fetch func(context.Context) (done bool, err error)) error {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	t := time.NewTimer(0)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+		done, err := fetch(ctx)
+		if err != nil || done {
+			return err
+		}
+		t.Reset(interval)
+	}
+}
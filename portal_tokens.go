@@ -2,7 +2,8 @@ package monigo
 
 import (
 	"context"
-	"fmt"
+	"net/url"
+	"time"
 )
 
 // PortalTokenService manages customer portal access links for your organisation.
@@ -43,15 +44,80 @@ func (s *PortalTokenService) Create(ctx context.Context, req CreatePortalTokenRe
 // customerID may be the Monigo UUID or the customer's external_id.
 func (s *PortalTokenService) List(ctx context.Context, customerID string) (*ListPortalTokensResponse, error) {
 	var out ListPortalTokensResponse
-	path := fmt.Sprintf("/v1/portal/tokens?customer_id=%s", customerID)
-	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+	if err := checkID(customerID); err != nil {
+		return nil, err
+	}
+	q := url.Values{"customer_id": {customerID}}
+	if err := s.client.do(ctx, "GET", "/v1/portal/tokens?"+q.Encode(), nil, &out); err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
+// Get fetches a single portal token by its UUID.
+func (s *PortalTokenService) Get(ctx context.Context, tokenID string) (*PortalToken, error) {
+	var wrapper struct {
+		Token PortalToken `json:"token"`
+	}
+	path, err := pathf("/v1/portal/tokens/%s", tokenID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Token, nil
+}
+
+// Update relabels a portal token or changes its expiry. The portal URL stays
+// the same, so links already shared with the customer keep working.
+func (s *PortalTokenService) Update(ctx context.Context, tokenID string, req UpdatePortalTokenRequest, opts ...RequestOption) (*PortalToken, error) {
+	var wrapper struct {
+		Token PortalToken `json:"token"`
+	}
+	path, err := pathf("/v1/portal/tokens/%s", tokenID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "PUT", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Token, nil
+}
+
 // Revoke immediately invalidates a portal token. Any customer holding the
 // corresponding URL will receive a 401 on their next request.
 func (s *PortalTokenService) Revoke(ctx context.Context, tokenID string) error {
-	return s.client.do(ctx, "DELETE", fmt.Sprintf("/v1/portal/tokens/%s", tokenID), nil, nil)
+	path, err := pathf("/v1/portal/tokens/%s", tokenID)
+	if err != nil {
+		return err
+	}
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// CreateSession returns a short-lived signed portal URL for the customer,
+// meant to be loaded in an iframe inside your own application. Create a
+// fresh session each time the page is rendered rather than storing it.
+//
+// While the iframe stays open the portal renews the session in the
+// background, so customers are not logged out mid-visit; a copied URL stops
+// working once ttl has passed. A ttl of zero uses the server default.
+//
+//	sess, err := client.PortalTokens.CreateSession(ctx, user.ID, 15*time.Minute)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Fprintf(w, `<iframe src="%s"></iframe>`, html.EscapeString(sess.URL))
+func (s *PortalTokenService) CreateSession(ctx context.Context, customerExternalID string, ttl time.Duration, opts ...RequestOption) (*PortalSession, error) {
+	var wrapper struct {
+		Session PortalSession `json:"session"`
+	}
+	body := CreatePortalSessionRequest{
+		CustomerExternalID: customerExternalID,
+		TTLSeconds:         int64(ttl / time.Second),
+	}
+	if err := s.client.do(ctx, "POST", "/v1/portal/sessions", body, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Session, nil
 }
@@ -139,3 +139,81 @@ func TestPortalTokens_Create_CustomerNotFound(t *testing.T) {
 		t.Errorf("expected IsNotFound=true; err=%v", err)
 	}
 }
+
+func TestPortalTokens_Get(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/portal/tokens/tok-1")
+		assertBearerToken(t, r)
+		respondJSON(t, w, 200, map[string]any{"token": sampleToken})
+	}))
+
+	tok, err := c.PortalTokens.Get(context.Background(), "tok-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tok.Label != "Invoice link" {
+		t.Errorf("label: got %q", tok.Label)
+	}
+}
+
+func TestPortalTokens_Update(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "PUT")
+		assertPath(t, r, "/v1/portal/tokens/tok-1")
+
+		var req monigo.UpdatePortalTokenRequest
+		decodeBody(t, r, &req)
+		if req.ExpiresAt != "2026-12-31T23:59:59Z" {
+			t.Errorf("expires_at: got %q", req.ExpiresAt)
+		}
+		if req.Label != "" {
+			t.Errorf("label: expected unchanged, got %q", req.Label)
+		}
+		exp := time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC)
+		updated := sampleToken
+		updated.ExpiresAt = &exp
+		respondJSON(t, w, 200, map[string]any{"token": updated})
+	}))
+
+	tok, err := c.PortalTokens.Update(context.Background(), "tok-1", monigo.UpdatePortalTokenRequest{
+		ExpiresAt: "2026-12-31T23:59:59Z",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tok.ExpiresAt == nil || tok.PortalURL != sampleToken.PortalURL {
+		t.Errorf("unexpected token: %+v", tok)
+	}
+}
+
+func TestPortalTokens_CreateSession(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/portal/sessions")
+		assertBearerToken(t, r)
+
+		var req monigo.CreatePortalSessionRequest
+		decodeBody(t, r, &req)
+		if req.CustomerExternalID != "usr_abc123" {
+			t.Errorf("customer_external_id: got %q", req.CustomerExternalID)
+		}
+		if req.TTLSeconds != 900 {
+			t.Errorf("ttl_seconds: got %d, want 900", req.TTLSeconds)
+		}
+		respondJSON(t, w, 201, map[string]any{"session": monigo.PortalSession{
+			ID:         "ps-1",
+			CustomerID: "cust-abc",
+			URL:        "https://app.monigo.co/portal/s/signed",
+			ExpiresAt:  time.Now().Add(15 * time.Minute),
+		}})
+	}))
+
+	sess, err := c.PortalTokens.CreateSession(context.Background(), "usr_abc123", 15*time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sess.URL == "" || sess.ExpiresAt.IsZero() {
+		t.Errorf("unexpected session: %+v", sess)
+	}
+}
@@ -0,0 +1,145 @@
+package monigo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrNoTiers is returned by the Price config accessors when the price has no
+// Tiers configuration attached.
+var ErrNoTiers = errors.New("monigo: price has no tiers configuration")
+
+// TieredConfig decodes Tiers as the []PriceTier used by PricingModelTiered.
+// It returns an error if the price uses a different model.
+func (p Price) TieredConfig() ([]PriceTier, error) {
+	var tiers []PriceTier
+	if err := p.decodeTiers(&tiers, PricingModelTiered); err != nil {
+		return nil, err
+	}
+	return tiers, nil
+}
+
+// VolumeConfig decodes Tiers as the VolumeConfig used by PricingModelVolume.
+// It returns an error if the price uses a different model.
+func (p Price) VolumeConfig() (*VolumeConfig, error) {
+	var cfg VolumeConfig
+	if err := p.decodeTiers(&cfg, PricingModelVolume); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// WeightedTieredConfig decodes Tiers as the WeightedTieredConfig used by
+// PricingModelWeightedTiered. It returns an error if the price uses a
+// different model.
+func (p Price) WeightedTieredConfig() (*WeightedTieredConfig, error) {
+	var cfg WeightedTieredConfig
+	if err := p.decodeTiers(&cfg, PricingModelWeightedTiered); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// PackageConfig decodes Tiers as the PackageConfig used by PricingModelPackage.
+// It returns an error if the price uses a different model.
+func (p Price) PackageConfig() (*PackageConfig, error) {
+	var cfg PackageConfig
+	if err := p.decodeTiers(&cfg, PricingModelPackage); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// OverageConfig decodes Tiers as the OverageConfig used by PricingModelOverage.
+// It returns an error if the price uses a different model.
+func (p Price) OverageConfig() (*OverageConfig, error) {
+	var cfg OverageConfig
+	if err := p.decodeTiers(&cfg, PricingModelOverage); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// decodeTiers unmarshals p.Tiers into out after checking that p.Model is one
+// of models.
+func (p Price) decodeTiers(out any, models ...PricingModel) error {
+	matched := false
+	for _, m := range models {
+		if p.Model == m {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return fmt.Errorf("monigo: price %s uses model %q, not %q", p.ID, p.Model, models[0])
+	}
+	if len(p.Tiers) == 0 || string(p.Tiers) == "null" {
+		return ErrNoTiers
+	}
+	if err := json.Unmarshal(p.Tiers, out); err != nil {
+		return fmt.Errorf("monigo: decode %s tiers: %w", p.Model, err)
+	}
+	return nil
+}
+
+// NormalizeTiers returns a price's Tiers in a canonical form: compact, with
+// object keys sorted and numbers kept exactly as written. Equivalent
+// configurations normalise to the same bytes, so tools that diff desired
+// against live state, such as a Terraform provider, can compare them
+// directly. Empty and null configurations normalise to nil.
+func NormalizeTiers(raw json.RawMessage) (json.RawMessage, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("monigo: normalize tiers: %w", err)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("monigo: normalize tiers: %w", err)
+	}
+	return b, nil
+}
+
+// Validate checks the tiers with ValidateTiers.
+func (c VolumeConfig) Validate() error { return ValidateTiers(c) }
+
+// Validate checks the tiers with ValidateTiers.
+func (c WeightedTieredConfig) Validate() error { return ValidateTiers(c) }
+
+// ValidateTiers checks a tier list for the tiered, volume, and weighted-tiered
+// models: there is at least one tier, every UnitAmount is a non-negative
+// decimal, UpTo bounds are positive and strictly increasing, and only the
+// last tier is unbounded.
+func ValidateTiers(tiers []PriceTier) error {
+	if len(tiers) == 0 {
+		return ErrNoTiers
+	}
+	var prev int64
+	for i, t := range tiers {
+		amount, ok := new(big.Rat).SetString(t.UnitAmount)
+		if !ok || amount.Sign() < 0 {
+			return fmt.Errorf("monigo: tiers[%d]: unit_amount %q is not a non-negative decimal", i, t.UnitAmount)
+		}
+		last := i == len(tiers)-1
+		switch {
+		case t.UpTo == nil && !last:
+			return fmt.Errorf("monigo: tiers[%d]: only the last tier may be unbounded", i)
+		case t.UpTo != nil && last:
+			return fmt.Errorf("monigo: tiers[%d]: the last tier must be unbounded (up_to: null)", i)
+		case t.UpTo != nil && *t.UpTo <= prev:
+			return fmt.Errorf("monigo: tiers[%d]: up_to %d must exceed %d", i, *t.UpTo, prev)
+		}
+		if t.UpTo != nil {
+			prev = *t.UpTo
+		}
+	}
+	return nil
+}
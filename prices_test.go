@@ -0,0 +1,160 @@
+package monigo_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestPrice_TieredConfig(t *testing.T) {
+	upTo := int64(1000)
+	tiers, _ := json.Marshal([]monigo.PriceTier{
+		{UpTo: &upTo, UnitAmount: "1.000000"},
+		{UpTo: nil, UnitAmount: "0.500000"},
+	})
+	p := monigo.Price{ID: "price-1", Model: monigo.PricingModelTiered, Tiers: tiers}
+
+	got, err := p.TieredConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 tiers, got %d", len(got))
+	}
+	if got[0].UpTo == nil || *got[0].UpTo != 1000 {
+		t.Errorf("tier 0 up_to: got %v, want 1000", got[0].UpTo)
+	}
+	if got[1].UpTo != nil {
+		t.Errorf("tier 1 up_to: expected nil (infinity), got %v", *got[1].UpTo)
+	}
+}
+
+func TestPrice_PackageConfig(t *testing.T) {
+	p := monigo.Price{
+		Model: monigo.PricingModelPackage,
+		Tiers: json.RawMessage(`{"package_size":100,"package_price":"500.000000","round_up_partial_block":true}`),
+	}
+	cfg, err := p.PackageConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.PackageSize != 100 || cfg.PackagePrice != "500.000000" || !cfg.RoundsUp() {
+		t.Errorf("unexpected config: %+v", cfg)
+	}
+}
+
+func TestPackageConfig_RoundsUpWhenOmitted(t *testing.T) {
+	p := monigo.Price{
+		Model: monigo.PricingModelPackage,
+		Tiers: json.RawMessage(`{"package_size":100,"package_price":"500.000000"}`),
+	}
+	cfg, err := p.PackageConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.RoundUpPartialBlock != nil || !cfg.RoundsUp() {
+		t.Errorf("expected the default of rounding up, got %+v", cfg)
+	}
+
+	b, _ := json.Marshal(monigo.PackageConfig{PackageSize: 100, PackagePrice: "500.000000"})
+	if strings.Contains(string(b), "round_up_partial_block") {
+		t.Errorf("nil RoundUpPartialBlock should be omitted, got %s", b)
+	}
+}
+
+func TestPrice_OverageConfig(t *testing.T) {
+	p := monigo.Price{
+		Model: monigo.PricingModelOverage,
+		Tiers: json.RawMessage(`{"included_units":1000,"base_price":"50.000000","overage_price":"1.500000"}`),
+	}
+	cfg, err := p.OverageConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.IncludedUnits != 1000 || cfg.OveragePrice != "1.500000" {
+		t.Errorf("unexpected config: %+v", cfg)
+	}
+}
+
+func TestPrice_ConfigModelMismatch(t *testing.T) {
+	p := monigo.Price{
+		Model: monigo.PricingModelFlat,
+		Tiers: json.RawMessage(`{"package_size":100}`),
+	}
+	if _, err := p.PackageConfig(); err == nil {
+		t.Error("expected error for model mismatch, got nil")
+	}
+}
+
+func TestPrice_ConfigNoTiers(t *testing.T) {
+	p := monigo.Price{Model: monigo.PricingModelOverage}
+	if _, err := p.OverageConfig(); !errors.Is(err, monigo.ErrNoTiers) {
+		t.Errorf("expected ErrNoTiers, got %v", err)
+	}
+}
+
+func TestPrice_VolumeConfig(t *testing.T) {
+	upTo := int64(100)
+	tiers, _ := json.Marshal(monigo.VolumeConfig{
+		{UpTo: &upTo, UnitAmount: "2.000000"},
+		{UpTo: nil, UnitAmount: "1.000000"},
+	})
+	p := monigo.Price{Model: monigo.PricingModelVolume, Tiers: tiers}
+
+	cfg, err := p.VolumeConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*cfg) != 2 || cfg.Validate() != nil {
+		t.Errorf("unexpected config: %+v", cfg)
+	}
+	if _, err := p.WeightedTieredConfig(); err == nil {
+		t.Error("expected error for model mismatch, got nil")
+	}
+}
+
+func TestValidateTiers(t *testing.T) {
+	ten, five := int64(10), int64(5)
+	cases := []struct {
+		name  string
+		tiers []monigo.PriceTier
+		ok    bool
+	}{
+		{"valid", []monigo.PriceTier{{UpTo: &ten, UnitAmount: "1"}, {UnitAmount: "0.5"}}, true},
+		{"single unbounded", []monigo.PriceTier{{UnitAmount: "1"}}, true},
+		{"empty", nil, false},
+		{"last bounded", []monigo.PriceTier{{UpTo: &ten, UnitAmount: "1"}}, false},
+		{"unbounded in middle", []monigo.PriceTier{{UnitAmount: "1"}, {UnitAmount: "0.5"}}, false},
+		{"decreasing", []monigo.PriceTier{{UpTo: &ten, UnitAmount: "1"}, {UpTo: &five, UnitAmount: "1"}, {UnitAmount: "1"}}, false},
+		{"negative amount", []monigo.PriceTier{{UnitAmount: "-1"}}, false},
+		{"bad amount", []monigo.PriceTier{{UnitAmount: "cheap"}}, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := monigo.ValidateTiers(tc.tiers)
+			if (err == nil) != tc.ok {
+				t.Errorf("ValidateTiers: got %v, want ok=%v", err, tc.ok)
+			}
+		})
+	}
+}
+
+func TestNormalizeTiers(t *testing.T) {
+	a, err := monigo.NormalizeTiers(json.RawMessage(`{ "package_size": 100, "unit_amount": "5.00" }`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, _ := monigo.NormalizeTiers(json.RawMessage(`{"unit_amount":"5.00","package_size":100}`))
+	if string(a) != string(b) {
+		t.Errorf("expected equal forms, got %s and %s", a, b)
+	}
+	if n, _ := monigo.NormalizeTiers(json.RawMessage(`null`)); n != nil {
+		t.Errorf("expected nil for null tiers, got %s", n)
+	}
+	if _, err := monigo.NormalizeTiers(json.RawMessage(`{`)); err == nil {
+		t.Error("expected an error for malformed JSON")
+	}
+}
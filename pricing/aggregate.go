@@ -0,0 +1,179 @@
+package pricing
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+// Aggregate applies metric's aggregation to the events with timestamps in
+// [from, to), returning the quantity Calculate prices. Events for other
+// metrics, or that fail the metric's filters, are ignored; pass one
+// customer's events to get that customer's usage.
+func Aggregate(metric monigo.Metric, events []monigo.IngestEvent, from, to time.Time) string {
+	return Decimal(aggregate(metric, events, from, to))
+}
+
+func aggregate(m monigo.Metric, events []monigo.IngestEvent, from, to time.Time) *big.Rat {
+	var (
+		n      int64
+		result *big.Rat
+		sum    = new(big.Rat)
+		unique = make(map[string]bool)
+		points []point
+	)
+	for _, e := range events {
+		if e.EventName != m.EventName || e.Timestamp.Before(from) || !e.Timestamp.Before(to) || !matches(m.Filters, e.Properties) {
+			continue
+		}
+		if m.Aggregation == monigo.AggregationCount {
+			n++
+			continue
+		}
+		raw, ok := e.Properties[m.AggregationProperty]
+		if !ok {
+			continue
+		}
+		if m.Aggregation == monigo.AggregationUnique {
+			unique[fmt.Sprint(raw)] = true
+			continue
+		}
+		v, ok := number(raw)
+		if !ok {
+			continue
+		}
+		n++
+		sum.Add(sum, v)
+		points = append(points, point{e.Timestamp, v})
+		switch {
+		case result == nil:
+			result = v
+		case m.Aggregation == monigo.AggregationMax && v.Cmp(result) > 0:
+			result = v
+		case m.Aggregation == monigo.AggregationMin && v.Cmp(result) < 0:
+			result = v
+		}
+	}
+
+	switch m.Aggregation {
+	case monigo.AggregationCount:
+		return new(big.Rat).SetInt64(n)
+	case monigo.AggregationUnique:
+		return new(big.Rat).SetInt64(int64(len(unique)))
+	case monigo.AggregationSum:
+		return sum
+	case monigo.AggregationAverage:
+		if n == 0 {
+			return new(big.Rat)
+		}
+		return sum.Quo(sum, new(big.Rat).SetInt64(n))
+	case monigo.AggregationLatest:
+		if len(points) == 0 {
+			return new(big.Rat)
+		}
+		sort.SliceStable(points, func(i, j int) bool { return points[i].at.Before(points[j].at) })
+		return points[len(points)-1].v
+	case monigo.AggregationTimeWeighted:
+		return timeWeighted(points, from, to)
+	default:
+		if result == nil {
+			return new(big.Rat)
+		}
+		return result
+	}
+}
+
+type point struct {
+	at time.Time
+	v  *big.Rat
+}
+
+// timeWeighted averages points over [from, to), each value holding until the
+// next point. Time before the first point counts as zero.
+func timeWeighted(points []point, from, to time.Time) *big.Rat {
+	total := new(big.Rat)
+	span := to.Sub(from)
+	if len(points) == 0 || span <= 0 {
+		return total
+	}
+	sort.SliceStable(points, func(i, j int) bool { return points[i].at.Before(points[j].at) })
+	for i, p := range points {
+		until := to
+		if i+1 < len(points) {
+			until = points[i+1].at
+		}
+		held := new(big.Rat).SetInt64(int64(until.Sub(p.at)))
+		total.Add(total, held.Mul(held, p.v))
+	}
+	return total.Quo(total, new(big.Rat).SetInt64(int64(span)))
+}
+
+// matches reports whether props satisfy every filter.
+func matches(filters []monigo.MetricFilter, props map[string]any) bool {
+	for _, f := range filters {
+		v, ok := props[f.Property]
+		if f.Operator == monigo.MetricFilterExists {
+			if !ok {
+				return false
+			}
+			continue
+		}
+		if !ok {
+			return false
+		}
+		switch f.Operator {
+		case monigo.MetricFilterEq:
+			if fmt.Sprint(v) != fmt.Sprint(f.Value) {
+				return false
+			}
+		case monigo.MetricFilterNeq:
+			if fmt.Sprint(v) == fmt.Sprint(f.Value) {
+				return false
+			}
+		case monigo.MetricFilterIn:
+			vs, _ := f.Value.([]any)
+			found := false
+			for _, want := range vs {
+				found = found || fmt.Sprint(v) == fmt.Sprint(want)
+			}
+			if !found {
+				return false
+			}
+		default:
+			a, okA := number(v)
+			b, okB := number(f.Value)
+			if !okA || !okB {
+				return false
+			}
+			c := a.Cmp(b)
+			if (f.Operator == monigo.MetricFilterGt && c <= 0) ||
+				(f.Operator == monigo.MetricFilterGte && c < 0) ||
+				(f.Operator == monigo.MetricFilterLt && c >= 0) ||
+				(f.Operator == monigo.MetricFilterLte && c > 0) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// number converts a decoded JSON property value to a Rat. Floats go through
+// their shortest decimal form so 0.1 stays 0.1.
+func number(v any) (*big.Rat, bool) {
+	switch n := v.(type) {
+	case float64:
+		return new(big.Rat).SetString(strconv.FormatFloat(n, 'f', -1, 64))
+	case int:
+		return new(big.Rat).SetInt64(int64(n)), true
+	case int64:
+		return new(big.Rat).SetInt64(n), true
+	case string:
+		return new(big.Rat).SetString(n)
+	default:
+		return nil, false
+	}
+}
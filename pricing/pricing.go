@@ -0,0 +1,287 @@
+// Package pricing computes what a quantity of usage costs under a Monigo
+// price, using the same rules the API applies when it prices an invoice. It
+// makes no network calls, so it can back "estimated cost" displays and
+// tests:
+//
+//	plan, _ := client.Plans.Get(ctx, planID)
+//	res, err := pricing.Calculate(plan.Prices[0], "1250")
+//	fmt.Println(res.Amount) // e.g. "612.500000"
+//
+// Simulate and Compare apply a whole plan to historical usage, to evaluate
+// a pricing change before rolling it out.
+//
+// Amounts are decimal strings with six fractional digits, the precision of
+// price configuration; round them for display as your currency requires.
+package pricing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+// Result is the cost of a quantity under one price.
+type Result struct {
+	// Model is the price's pricing model.
+	Model monigo.PricingModel
+	// Quantity is the quantity that was priced, normalised.
+	Quantity string
+	// Amount is the total cost, the sum of the line amounts.
+	Amount string
+	// Lines break Amount down the way an invoice would: one line per tier
+	// reached, the base fee and overage separately, and so on.
+	Lines []Line
+}
+
+// Line is one component of a Result.
+type Line struct {
+	// Description names the component, e.g. "Tier 2 (51 – 100)" or "Overage".
+	Description string
+	// Quantity is the number of units (or packages) this line charges for.
+	Quantity string
+	// UnitPrice is the price of one unit (or package).
+	UnitPrice string
+	// Amount is Quantity × UnitPrice.
+	Amount string
+}
+
+// Calculate returns the cost of quantity units under price. quantity is a
+// non-negative decimal string such as "60" or "12.5". It supports the flat,
+// per-unit, tiered, volume, package, overage, and weighted-tiered models.
+func Calculate(price monigo.Price, quantity string) (*Result, error) {
+	qty, ok := new(big.Rat).SetString(quantity)
+	if !ok {
+		return nil, fmt.Errorf("pricing: invalid quantity %q", quantity)
+	}
+	if qty.Sign() < 0 {
+		return nil, fmt.Errorf("pricing: quantity %s is negative", quantity)
+	}
+
+	var (
+		lines []line
+		err   error
+	)
+	switch price.Model {
+	case monigo.PricingModelFlat, monigo.PricingModelPerUnit:
+		lines, err = flat(price, qty)
+	case monigo.PricingModelTiered:
+		lines, err = graduated(price, qty)
+	case monigo.PricingModelVolume:
+		lines, err = volume(price, qty)
+	case monigo.PricingModelWeightedTiered:
+		lines, err = weighted(price, qty)
+	case monigo.PricingModelPackage:
+		lines, err = pack(price, qty)
+	case monigo.PricingModelOverage:
+		lines, err = overage(price, qty)
+	default:
+		err = fmt.Errorf("unsupported pricing model %q", price.Model)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("pricing: price %s: %w", price.ID, err)
+	}
+
+	res := &Result{Model: price.Model, Quantity: Decimal(qty)}
+	total := new(big.Rat)
+	for _, l := range lines {
+		amount := new(big.Rat).Mul(l.qty, l.unit)
+		total.Add(total, amount)
+		res.Lines = append(res.Lines, Line{
+			Description: l.desc,
+			Quantity:    Decimal(l.qty),
+			UnitPrice:   l.unit.FloatString(6),
+			Amount:      amount.FloatString(6),
+		})
+	}
+	res.Amount = total.FloatString(6)
+	return res, nil
+}
+
+// Decimal formats r as a decimal string without trailing zeros, e.g. "60"
+// or "12.5", to at most six fractional digits.
+func Decimal(r *big.Rat) string {
+	if r.IsInt() {
+		return r.RatString()
+	}
+	s := r.FloatString(6)
+	for s[len(s)-1] == '0' {
+		s = s[:len(s)-1]
+	}
+	if s[len(s)-1] == '.' {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+type line struct {
+	desc      string
+	qty, unit *big.Rat
+}
+
+func flat(price monigo.Price, qty *big.Rat) ([]line, error) {
+	unit, err := amount("unit_price", price.UnitPrice)
+	if err != nil {
+		return nil, err
+	}
+	return []line{{"Usage", qty, unit}}, nil
+}
+
+// graduated charges each unit at the rate of the tier it falls into.
+func graduated(price monigo.Price, qty *big.Rat) ([]line, error) {
+	tiers, err := decodeTiers(price)
+	if err != nil {
+		return nil, err
+	}
+	var lines []line
+	prev := new(big.Rat)
+	for i, t := range tiers {
+		if qty.Cmp(prev) <= 0 && i > 0 {
+			break
+		}
+		upper := qty
+		if t.upTo != nil && t.upTo.Cmp(qty) < 0 {
+			upper = t.upTo
+		}
+		units := new(big.Rat).Sub(upper, prev)
+		if units.Sign() > 0 || i == 0 {
+			lines = append(lines, line{tierName(i, prev, t.upTo), units, t.unit})
+		}
+		if t.upTo != nil {
+			prev = t.upTo
+		}
+	}
+	return lines, nil
+}
+
+// volume charges every unit at the rate of the tier the total falls into.
+func volume(price monigo.Price, qty *big.Rat) ([]line, error) {
+	tiers, err := decodeTiers(price)
+	if err != nil {
+		return nil, err
+	}
+	prev := new(big.Rat)
+	for i, t := range tiers {
+		if t.upTo == nil || qty.Cmp(t.upTo) <= 0 {
+			return []line{{tierName(i, prev, t.upTo), qty, t.unit}}, nil
+		}
+		prev = t.upTo
+	}
+	panic("unreachable: ValidateTiers guarantees an unbounded last tier")
+}
+
+// weighted computes the graduated total and presents it as a single line at
+// the weighted-average unit price.
+func weighted(price monigo.Price, qty *big.Rat) ([]line, error) {
+	lines, err := graduated(price, qty)
+	if err != nil {
+		return nil, err
+	}
+	total := new(big.Rat)
+	for _, l := range lines {
+		total.Add(total, new(big.Rat).Mul(l.qty, l.unit))
+	}
+	unit := new(big.Rat)
+	if qty.Sign() > 0 {
+		unit.Quo(total, qty)
+	} else {
+		unit = lines[0].unit
+	}
+	return []line{{"Usage (weighted average)", qty, unit}}, nil
+}
+
+func pack(price monigo.Price, qty *big.Rat) ([]line, error) {
+	cfg, err := price.PackageConfig()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.PackageSize <= 0 {
+		return nil, errors.New("package_size must be positive")
+	}
+	unit, err := amount("package_price", cfg.PackagePrice)
+	if err != nil {
+		return nil, err
+	}
+	q := new(big.Rat).Quo(qty, new(big.Rat).SetInt64(cfg.PackageSize))
+	packages := new(big.Int).Quo(q.Num(), q.Denom())
+	if cfg.RoundsUp() && !q.IsInt() {
+		packages.Add(packages, big.NewInt(1))
+	}
+	desc := fmt.Sprintf("Packages of %d", cfg.PackageSize)
+	return []line{{desc, new(big.Rat).SetInt(packages), unit}}, nil
+}
+
+func overage(price monigo.Price, qty *big.Rat) ([]line, error) {
+	cfg, err := price.OverageConfig()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.IncludedUnits < 0 {
+		return nil, errors.New("included_units must not be negative")
+	}
+	base, err := amount("base_price", cfg.BasePrice)
+	if err != nil {
+		return nil, err
+	}
+	rate, err := amount("overage_price", cfg.OveragePrice)
+	if err != nil {
+		return nil, err
+	}
+	extra := new(big.Rat).Sub(qty, new(big.Rat).SetInt64(cfg.IncludedUnits))
+	if extra.Sign() < 0 {
+		extra.SetInt64(0)
+	}
+	return []line{
+		{fmt.Sprintf("Base (%d units included)", cfg.IncludedUnits), big.NewRat(1, 1), base},
+		{"Overage", extra, rate},
+	}, nil
+}
+
+type tier struct {
+	upTo *big.Rat // nil for the unbounded last tier
+	unit *big.Rat
+}
+
+// decodeTiers parses and validates a tiered, volume, or weighted-tiered
+// price.
+func decodeTiers(price monigo.Price) ([]tier, error) {
+	if len(price.Tiers) == 0 || string(price.Tiers) == "null" {
+		return nil, monigo.ErrNoTiers
+	}
+	var raw []monigo.PriceTier
+	if err := json.Unmarshal(price.Tiers, &raw); err != nil {
+		return nil, fmt.Errorf("decode tiers: %w", err)
+	}
+	if err := monigo.ValidateTiers(raw); err != nil {
+		return nil, err
+	}
+	tiers := make([]tier, len(raw))
+	for i, t := range raw {
+		tiers[i].unit, _ = new(big.Rat).SetString(t.UnitAmount)
+		if t.UpTo != nil {
+			tiers[i].upTo = new(big.Rat).SetInt64(*t.UpTo)
+		}
+	}
+	return tiers, nil
+}
+
+func tierName(i int, from, upTo *big.Rat) string {
+	lo := new(big.Rat).Add(from, big.NewRat(1, 1))
+	if upTo == nil {
+		return fmt.Sprintf("Tier %d (%s and above)", i+1, Decimal(lo))
+	}
+	return fmt.Sprintf("Tier %d (%s – %s)", i+1, Decimal(lo), Decimal(upTo))
+}
+
+func amount(field, s string) (*big.Rat, error) {
+	if s == "" {
+		return new(big.Rat), nil
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s %q", field, s)
+	}
+	return r, nil
+}
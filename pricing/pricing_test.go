@@ -0,0 +1,121 @@
+package pricing_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+	"github.com/monigo-africa/go-monigo/pricing"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func tiers(t *testing.T, model monigo.PricingModel) monigo.Price {
+	t.Helper()
+	b, err := json.Marshal([]monigo.PriceTier{
+		{UpTo: ptr(int64(50)), UnitAmount: "1.000000"},
+		{UpTo: ptr(int64(100)), UnitAmount: "0.500000"},
+		{UpTo: nil, UnitAmount: "0.250000"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return monigo.Price{ID: "price-1", Model: model, Tiers: b}
+}
+
+func config(t *testing.T, model monigo.PricingModel, v any) monigo.Price {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return monigo.Price{ID: "price-1", Model: model, Tiers: b}
+}
+
+func TestCalculate(t *testing.T) {
+	roundUp, truncate := true, false
+	cases := []struct {
+		name     string
+		price    monigo.Price
+		quantity string
+		amount   string
+		lines    int
+	}{
+		{"flat", monigo.Price{Model: monigo.PricingModelFlat, UnitPrice: "2.000000"}, "60", "120.000000", 1},
+		{"flat fractional", monigo.Price{Model: monigo.PricingModelPerUnit, UnitPrice: "0.100000"}, "12.5", "1.250000", 1},
+		{"tiered within first", tiers(t, monigo.PricingModelTiered), "40", "40.000000", 1},
+		{"tiered", tiers(t, monigo.PricingModelTiered), "120", "80.000000", 3},
+		{"volume", tiers(t, monigo.PricingModelVolume), "120", "30.000000", 1},
+		{"volume at boundary", tiers(t, monigo.PricingModelVolume), "100", "50.000000", 1},
+		{"weighted tiered", tiers(t, monigo.PricingModelWeightedTiered), "120", "80.000000", 1},
+		{"package", config(t, monigo.PricingModelPackage, monigo.PackageConfig{PackageSize: 25, PackagePrice: "100", RoundUpPartialBlock: &roundUp}), "60", "300.000000", 1},
+		{"package rounds up by default", config(t, monigo.PricingModelPackage, monigo.PackageConfig{PackageSize: 25, PackagePrice: "100"}), "60", "300.000000", 1},
+		{"package truncated", config(t, monigo.PricingModelPackage, monigo.PackageConfig{PackageSize: 25, PackagePrice: "100", RoundUpPartialBlock: &truncate}), "60", "200.000000", 1},
+		{"overage", config(t, monigo.PricingModelOverage, monigo.OverageConfig{IncludedUnits: 50, BasePrice: "20", OveragePrice: "1.5"}), "60", "35.000000", 2},
+		{"overage within quota", config(t, monigo.PricingModelOverage, monigo.OverageConfig{IncludedUnits: 50, BasePrice: "20", OveragePrice: "1.5"}), "10", "20.000000", 2},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := pricing.Calculate(tc.price, tc.quantity)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.Amount != tc.amount {
+				t.Errorf("amount: got %s, want %s", res.Amount, tc.amount)
+			}
+			if len(res.Lines) != tc.lines {
+				t.Errorf("expected %d lines, got %+v", tc.lines, res.Lines)
+			}
+		})
+	}
+}
+
+func TestCalculate_WeightedUnitPrice(t *testing.T) {
+	res, err := pricing.Calculate(tiers(t, monigo.PricingModelWeightedTiered), "160")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 50 × 1.00 + 50 × 0.50 + 60 × 0.25 = 90.00, averaged over 160 units.
+	if res.Amount != "90.000000" || res.Lines[0].UnitPrice != "0.562500" {
+		t.Errorf("unexpected result: %+v", res)
+	}
+}
+
+func TestCalculate_TierLines(t *testing.T) {
+	res, err := pricing.Calculate(tiers(t, monigo.PricingModelTiered), "120")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, l := range res.Lines {
+		got = append(got, l.Quantity+"@"+l.UnitPrice)
+	}
+	want := "50@1.000000,50@0.500000,20@0.250000"
+	if strings.Join(got, ",") != want {
+		t.Errorf("got %v, want %s", got, want)
+	}
+}
+
+func TestCalculate_Errors(t *testing.T) {
+	bounded, _ := json.Marshal([]monigo.PriceTier{{UpTo: ptr(int64(10)), UnitAmount: "1"}})
+	cases := []struct {
+		name     string
+		price    monigo.Price
+		quantity string
+	}{
+		{"bad quantity", monigo.Price{Model: monigo.PricingModelFlat}, "ten"},
+		{"negative quantity", monigo.Price{Model: monigo.PricingModelFlat}, "-1"},
+		{"unknown model", monigo.Price{Model: "bespoke"}, "1"},
+		{"no tiers", monigo.Price{Model: monigo.PricingModelTiered}, "1"},
+		{"last tier bounded", monigo.Price{Model: monigo.PricingModelTiered, Tiers: bounded}, "1"},
+		{"bad unit price", monigo.Price{Model: monigo.PricingModelFlat, UnitPrice: "free"}, "1"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := pricing.Calculate(tc.price, tc.quantity); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,339 @@
+package pricing
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+// Usage is the quantity of one metric a customer used in one billing
+// period. Build it from history with UsageFromRollups or UsageFromEvents.
+type Usage struct {
+	CustomerID  string
+	MetricID    string
+	PeriodStart time.Time
+	// Quantity is a non-negative decimal string, as Calculate takes.
+	Quantity string
+}
+
+// Simulation is what a plan would have billed for a set of usage.
+type Simulation struct {
+	// Customers holds one bill per customer, sorted by customer ID.
+	Customers []CustomerBill
+	// Total is the sum of every customer's Total.
+	Total string
+}
+
+// CustomerBill is what one customer would have been billed.
+type CustomerBill struct {
+	CustomerID string
+	// Periods holds one bill per billing period, oldest first.
+	Periods []PeriodBill
+	// Total is the sum of the periods' Totals.
+	Total string
+}
+
+// PeriodBill is what one customer would have been billed for one period.
+type PeriodBill struct {
+	PeriodStart time.Time
+	// Charges holds one result per priced metric with usage in the period.
+	Charges []Charge
+	// MinimumAdjustment is the amount added to reach the plan's
+	// MinimumAmount, or "" if the charges met it.
+	MinimumAdjustment string
+	// Total is the sum of the charges and MinimumAdjustment.
+	Total string
+}
+
+// Charge is the cost of one metric's usage under the plan's price for it.
+type Charge struct {
+	MetricID string
+	PriceID  string
+	Result
+}
+
+// Simulate computes what each customer would have been billed had plan
+// been in effect for usage, so that a pricing change can be evaluated
+// before rollout:
+//
+//	res, err := client.Usage.QueryRange(ctx, monigo.UsageParams{From: &from, To: &to}, 24*time.Hour)
+//	usage, err := pricing.UsageFromRollups(res.Rollups, candidate.BillingPeriod, tz)
+//	sim, err := pricing.Simulate(candidate, usage)
+//
+// Only the plan's prices in its own currency are used. Usage of metrics the
+// plan does not price is ignored. A period with usage of no priced metric
+// is still billed the plan's MinimumAmount.
+func Simulate(plan monigo.Plan, usage []Usage) (*Simulation, error) {
+	prices := make(map[string]monigo.Price)
+	for _, p := range plan.Prices {
+		if p.Currency == "" || strings.EqualFold(p.Currency, plan.Currency) {
+			prices[p.MetricID] = p
+		}
+	}
+	var minimum *big.Rat
+	if plan.MinimumAmount != "" {
+		var err error
+		if minimum, err = amount("minimum_amount", plan.MinimumAmount); err != nil {
+			return nil, fmt.Errorf("pricing: plan %s: %w", plan.ID, err)
+		}
+	}
+
+	type key struct {
+		customer string
+		start    time.Time
+	}
+	periods := make(map[key]*PeriodBill)
+	totals := make(map[key]*big.Rat)
+	for _, u := range usage {
+		k := key{u.CustomerID, u.PeriodStart.UTC()}
+		pb, ok := periods[k]
+		if !ok {
+			pb = &PeriodBill{PeriodStart: k.start}
+			periods[k] = pb
+			totals[k] = new(big.Rat)
+		}
+		price, ok := prices[u.MetricID]
+		if !ok {
+			continue
+		}
+		res, err := Calculate(price, u.Quantity)
+		if err != nil {
+			return nil, fmt.Errorf("%w (customer %s)", err, u.CustomerID)
+		}
+		amt, _ := new(big.Rat).SetString(res.Amount)
+		totals[k].Add(totals[k], amt)
+		pb.Charges = append(pb.Charges, Charge{MetricID: u.MetricID, PriceID: price.ID, Result: *res})
+	}
+
+	customers := make(map[string]*CustomerBill)
+	customerTotals := make(map[string]*big.Rat)
+	for k, pb := range periods {
+		total := totals[k]
+		if minimum != nil && total.Cmp(minimum) < 0 {
+			pb.MinimumAdjustment = new(big.Rat).Sub(minimum, total).FloatString(6)
+			total.Set(minimum)
+		}
+		pb.Total = total.FloatString(6)
+
+		cb, ok := customers[k.customer]
+		if !ok {
+			cb = &CustomerBill{CustomerID: k.customer}
+			customers[k.customer] = cb
+			customerTotals[k.customer] = new(big.Rat)
+		}
+		cb.Periods = append(cb.Periods, *pb)
+		customerTotals[k.customer].Add(customerTotals[k.customer], total)
+	}
+
+	sim := &Simulation{}
+	grand := new(big.Rat)
+	for id, cb := range customers {
+		sort.Slice(cb.Periods, func(i, j int) bool { return cb.Periods[i].PeriodStart.Before(cb.Periods[j].PeriodStart) })
+		cb.Total = customerTotals[id].FloatString(6)
+		grand.Add(grand, customerTotals[id])
+		sim.Customers = append(sim.Customers, *cb)
+	}
+	sort.Slice(sim.Customers, func(i, j int) bool { return sim.Customers[i].CustomerID < sim.Customers[j].CustomerID })
+	sim.Total = grand.FloatString(6)
+	return sim, nil
+}
+
+// Comparison is one customer's bill under two plans.
+type Comparison struct {
+	CustomerID string
+	Current    string
+	Candidate  string
+	// Difference is Candidate minus Current; negative when the candidate
+	// plan is cheaper for the customer.
+	Difference string
+}
+
+// Compare simulates usage under current and candidate and returns each
+// customer's totals side by side, sorted by customer ID.
+func Compare(current, candidate monigo.Plan, usage []Usage) ([]Comparison, error) {
+	before, err := Simulate(current, usage)
+	if err != nil {
+		return nil, err
+	}
+	after, err := Simulate(candidate, usage)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Comparison, len(before.Customers))
+	for i, b := range before.Customers {
+		// Both simulations bill the same customers in the same order.
+		a := after.Customers[i]
+		cur, _ := new(big.Rat).SetString(b.Total)
+		cand, _ := new(big.Rat).SetString(a.Total)
+		out[i] = Comparison{
+			CustomerID: b.CustomerID,
+			Current:    b.Total,
+			Candidate:  a.Total,
+			Difference: new(big.Rat).Sub(cand, cur).FloatString(6),
+		}
+	}
+	return out, nil
+}
+
+// UsageFromEvents aggregates raw events into per-period usage of metrics,
+// the way the API would have rolled them up for billing periods of the
+// given length. Periods are split as monigo.PeriodBounds splits them in tz,
+// which should be the organisation's billing timezone, so they match the
+// API's only for calendar-aligned billing.
+func UsageFromEvents(metrics []monigo.Metric, events []monigo.IngestEvent, period monigo.BillingPeriod, tz *time.Location) ([]Usage, error) {
+	type key struct {
+		customer string
+		start    time.Time
+	}
+	buckets := make(map[key][]monigo.IngestEvent)
+	for _, e := range events {
+		start, _, err := monigo.PeriodBounds(period, e.Timestamp, tz)
+		if err != nil {
+			return nil, err
+		}
+		k := key{e.CustomerID, start}
+		buckets[k] = append(buckets[k], e)
+	}
+
+	var out []Usage
+	for k, evs := range buckets {
+		_, end, _ := monigo.PeriodBounds(period, k.start, tz)
+		for _, m := range metrics {
+			has := false
+			for _, e := range evs {
+				if e.EventName == m.EventName {
+					has = true
+					break
+				}
+			}
+			if !has {
+				continue
+			}
+			out = append(out, Usage{
+				CustomerID:  k.customer,
+				MetricID:    m.ID,
+				PeriodStart: k.start,
+				Quantity:    Aggregate(m, evs, k.start, end),
+			})
+		}
+	}
+	sortUsage(out)
+	return out, nil
+}
+
+// UsageFromRollups combines usage rollups, such as those returned by
+// UsageService.QueryRange, into per-period usage for billing periods of the
+// given length, split in tz as UsageFromEvents splits them. Rollups are
+// combined according to their aggregation: sums
+// and counts add up, max and minimum take the extreme, averages are
+// weighted by event count, latest takes the rollup with the most recent
+// event, and time-weighted averages are weighted by rollup duration.
+// Unique counts cannot be combined, so more than one unique rollup in a
+// period is an error; query them with one rollup per period instead.
+func UsageFromRollups(rollups []monigo.UsageRollup, period monigo.BillingPeriod, tz *time.Location) ([]Usage, error) {
+	type key struct {
+		customer, metric string
+		start            time.Time
+	}
+	groups := make(map[key][]monigo.UsageRollup)
+	for _, r := range rollups {
+		start, _, err := monigo.PeriodBounds(period, r.PeriodStart, tz)
+		if err != nil {
+			return nil, err
+		}
+		k := key{r.CustomerID, r.MetricID, start}
+		groups[k] = append(groups[k], r)
+	}
+
+	out := make([]Usage, 0, len(groups))
+	for k, rs := range groups {
+		_, end, _ := monigo.PeriodBounds(period, k.start, tz)
+		v, err := combine(rs, k.start, end)
+		if err != nil {
+			return nil, fmt.Errorf("pricing: customer %s metric %s: %w", k.customer, k.metric, err)
+		}
+		out = append(out, Usage{
+			CustomerID:  k.customer,
+			MetricID:    k.metric,
+			PeriodStart: k.start,
+			Quantity:    strconv.FormatFloat(v, 'f', -1, 64),
+		})
+	}
+	sortUsage(out)
+	return out, nil
+}
+
+// combine merges the rollups of one customer and metric within [from, to).
+func combine(rs []monigo.UsageRollup, from, to time.Time) (float64, error) {
+	agg := rs[0].Aggregation
+	if len(rs) == 1 && agg != monigo.AggregationTimeWeighted {
+		return rs[0].Value, nil
+	}
+	switch agg {
+	case monigo.AggregationUnique:
+		return 0, fmt.Errorf("%d unique-count rollups in one period cannot be combined", len(rs))
+	case monigo.AggregationMax, monigo.AggregationMin:
+		v := rs[0].Value
+		for _, r := range rs[1:] {
+			if agg == monigo.AggregationMax && r.Value > v || agg == monigo.AggregationMin && r.Value < v {
+				v = r.Value
+			}
+		}
+		return v, nil
+	case monigo.AggregationAverage:
+		var sum float64
+		var n int64
+		for _, r := range rs {
+			sum += r.Value * float64(r.EventCount)
+			n += r.EventCount
+		}
+		if n == 0 {
+			return 0, nil
+		}
+		return sum / float64(n), nil
+	case monigo.AggregationLatest:
+		latest := rs[0]
+		for _, r := range rs[1:] {
+			if lastEvent(r).After(lastEvent(latest)) {
+				latest = r
+			}
+		}
+		return latest.Value, nil
+	case monigo.AggregationTimeWeighted:
+		var sum float64
+		for _, r := range rs {
+			sum += r.Value * r.PeriodEnd.Sub(r.PeriodStart).Seconds()
+		}
+		return sum / to.Sub(from).Seconds(), nil
+	default:
+		var sum float64
+		for _, r := range rs {
+			sum += r.Value
+		}
+		return sum, nil
+	}
+}
+
+func lastEvent(r monigo.UsageRollup) time.Time {
+	if r.LastEventAt != nil {
+		return *r.LastEventAt
+	}
+	return r.PeriodStart
+}
+
+func sortUsage(u []Usage) {
+	sort.Slice(u, func(i, j int) bool {
+		if u[i].CustomerID != u[j].CustomerID {
+			return u[i].CustomerID < u[j].CustomerID
+		}
+		if !u[i].PeriodStart.Equal(u[j].PeriodStart) {
+			return u[i].PeriodStart.Before(u[j].PeriodStart)
+		}
+		return u[i].MetricID < u[j].MetricID
+	})
+}
@@ -0,0 +1,128 @@
+package pricing_test
+
+import (
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+	"github.com/monigo-africa/go-monigo/pricing"
+)
+
+func TestSimulate_AppliesPricesAndMinimum(t *testing.T) {
+	plan := monigo.Plan{
+		ID:            "plan-1",
+		Currency:      "NGN",
+		MinimumAmount: "100",
+		Prices: []monigo.Price{
+			{ID: "price-calls", MetricID: "calls", Model: monigo.PricingModelPerUnit, UnitPrice: "2.000000"},
+			{ID: "price-calls-usd", MetricID: "calls", Model: monigo.PricingModelPerUnit, UnitPrice: "0.010000", Currency: "USD"},
+		},
+	}
+	jan := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := jan.AddDate(0, 1, 0)
+	usage := []pricing.Usage{
+		{CustomerID: "cust-a", MetricID: "calls", PeriodStart: jan, Quantity: "80"},
+		{CustomerID: "cust-a", MetricID: "calls", PeriodStart: feb, Quantity: "20"},
+		{CustomerID: "cust-a", MetricID: "storage", PeriodStart: feb, Quantity: "999"},
+	}
+
+	sim, err := pricing.Simulate(plan, usage)
+	if err != nil {
+		t.Fatalf("Simulate: %v", err)
+	}
+	if len(sim.Customers) != 1 || len(sim.Customers[0].Periods) != 2 {
+		t.Fatalf("expected one customer with two periods, got %+v", sim)
+	}
+	janBill, febBill := sim.Customers[0].Periods[0], sim.Customers[0].Periods[1]
+	if janBill.Total != "160.000000" || janBill.MinimumAdjustment != "" {
+		t.Errorf("unexpected January bill %+v", janBill)
+	}
+	if febBill.Total != "100.000000" || febBill.MinimumAdjustment != "60.000000" || len(febBill.Charges) != 1 {
+		t.Errorf("expected February to be topped up to the minimum, got %+v", febBill)
+	}
+	if sim.Total != "260.000000" {
+		t.Errorf("expected total 260, got %s", sim.Total)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	usage := []pricing.Usage{
+		{CustomerID: "cust-a", MetricID: "calls", Quantity: "100"},
+		{CustomerID: "cust-b", MetricID: "calls", Quantity: "10"},
+	}
+	current := monigo.Plan{Prices: []monigo.Price{{MetricID: "calls", Model: monigo.PricingModelPerUnit, UnitPrice: "1"}}}
+	candidate := monigo.Plan{Prices: []monigo.Price{tiers(t, monigo.PricingModelTiered)}}
+	candidate.Prices[0].MetricID = "calls"
+
+	got, err := pricing.Compare(current, candidate, usage)
+	if err != nil {
+		t.Fatalf("Compare: %v", err)
+	}
+	// Tiers: 50 × 1.00 + 50 × 0.50 = 75 for cust-a; 10 × 1.00 for cust-b.
+	if len(got) != 2 || got[0].Difference != "-25.000000" || got[1].Difference != "0.000000" {
+		t.Errorf("unexpected comparison %+v", got)
+	}
+}
+
+func TestUsageFromRollups_CombinesByAggregation(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	rollups := []monigo.UsageRollup{
+		{CustomerID: "c", MetricID: "calls", Aggregation: monigo.AggregationCount, PeriodStart: day(1), Value: 10},
+		{CustomerID: "c", MetricID: "calls", Aggregation: monigo.AggregationCount, PeriodStart: day(2), Value: 5},
+		{CustomerID: "c", MetricID: "peak", Aggregation: monigo.AggregationMax, PeriodStart: day(1), Value: 7},
+		{CustomerID: "c", MetricID: "peak", Aggregation: monigo.AggregationMax, PeriodStart: day(2), Value: 3},
+		{CustomerID: "c", MetricID: "latency", Aggregation: monigo.AggregationAverage, PeriodStart: day(1), Value: 10, EventCount: 1},
+		{CustomerID: "c", MetricID: "latency", Aggregation: monigo.AggregationAverage, PeriodStart: day(2), Value: 40, EventCount: 2},
+	}
+	usage, err := pricing.UsageFromRollups(rollups, monigo.BillingPeriodMonthly, nil)
+	if err != nil {
+		t.Fatalf("UsageFromRollups: %v", err)
+	}
+	want := map[string]string{"calls": "15", "peak": "7", "latency": "30"}
+	if len(usage) != len(want) {
+		t.Fatalf("expected %d usages, got %+v", len(want), usage)
+	}
+	for _, u := range usage {
+		if u.Quantity != want[u.MetricID] || !u.PeriodStart.Equal(day(1)) {
+			t.Errorf("%s: expected %s from March 1, got %+v", u.MetricID, want[u.MetricID], u)
+		}
+	}
+
+	unique := []monigo.UsageRollup{
+		{CustomerID: "c", MetricID: "users", Aggregation: monigo.AggregationUnique, PeriodStart: day(1), Value: 3},
+		{CustomerID: "c", MetricID: "users", Aggregation: monigo.AggregationUnique, PeriodStart: day(2), Value: 4},
+	}
+	if _, err := pricing.UsageFromRollups(unique, monigo.BillingPeriodMonthly, nil); err == nil {
+		t.Error("expected an error combining unique-count rollups")
+	}
+}
+
+func TestUsageFromEvents(t *testing.T) {
+	metrics := []monigo.Metric{
+		{ID: "gb", EventName: "storage", Aggregation: monigo.AggregationSum, AggregationProperty: "gb"},
+	}
+	events := []monigo.IngestEvent{
+		{EventName: "storage", CustomerID: "c", Timestamp: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), Properties: map[string]any{"gb": 1.5}},
+		{EventName: "storage", CustomerID: "c", Timestamp: time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC), Properties: map[string]any{"gb": 2.0}},
+		{EventName: "storage", CustomerID: "c", Timestamp: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), Properties: map[string]any{"gb": 4.0}},
+		{EventName: "other", CustomerID: "c", Timestamp: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	usage, err := pricing.UsageFromEvents(metrics, events, monigo.BillingPeriodMonthly, nil)
+	if err != nil {
+		t.Fatalf("UsageFromEvents: %v", err)
+	}
+	if len(usage) != 2 || usage[0].Quantity != "3.5" || usage[1].Quantity != "4" {
+		t.Errorf("unexpected usage %+v", usage)
+	}
+
+	// In WAT the 23:30 UTC event on 31 January falls in February.
+	late := monigo.IngestEvent{EventName: "storage", CustomerID: "c", Timestamp: time.Date(2026, 1, 31, 23, 30, 0, 0, time.UTC), Properties: map[string]any{"gb": 1.0}}
+	wat := time.FixedZone("WAT", 3600)
+	usage, err = pricing.UsageFromEvents(metrics, append(events, late), monigo.BillingPeriodMonthly, wat)
+	if err != nil {
+		t.Fatalf("UsageFromEvents: %v", err)
+	}
+	if len(usage) != 2 || usage[0].Quantity != "3.5" || usage[1].Quantity != "5" {
+		t.Errorf("expected the late event in February, got %+v", usage)
+	}
+}
@@ -0,0 +1,127 @@
+package monigo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrInvalidProperty is returned, wrapped, by Metric.CoerceProperties when an
+// event property is missing or cannot be coerced to its declared type.
+var ErrInvalidProperty = errors.New("monigo: invalid event property")
+
+// CoerceProperties applies the metric's PropertyRules to props the same way
+// the server does at ingest, and returns a copy with coerced values.
+// Properties without a rule are copied unchanged. Use it in producers to
+// catch format drift before events are sent:
+//
+//	props, err := metric.CoerceProperties(event.Properties)
+//	if errors.Is(err, monigo.ErrInvalidProperty) { ... }
+func (m Metric) CoerceProperties(props map[string]any) (map[string]any, error) {
+	out := make(map[string]any, len(props))
+	for k, v := range props {
+		out[k] = v
+	}
+	for _, r := range m.PropertyRules {
+		v, ok := props[r.Property]
+		if !ok || v == nil {
+			if r.Required {
+				return nil, fmt.Errorf("%w: %q is required by metric %s", ErrInvalidProperty, r.Property, m.Name)
+			}
+			continue
+		}
+		cv, err := r.coerce(v)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidProperty, r.Property, err)
+		}
+		out[r.Property] = cv
+	}
+	return out, nil
+}
+
+// coerce converts v to the rule's type, then scales numbers.
+func (r PropertyRule) coerce(v any) (any, error) {
+	switch r.Type {
+	case PropertyTypeNumber:
+		f, ok := toFloat(v)
+		if !ok {
+			s, isString := v.(string)
+			if !isString || !r.Coerce {
+				return nil, fmt.Errorf("want number, got %T", v)
+			}
+			var err error
+			if f, err = strconv.ParseFloat(s, 64); err != nil {
+				return nil, fmt.Errorf("cannot parse %q as a number", s)
+			}
+		}
+		if r.Scale != 0 {
+			f *= r.Scale
+		}
+		return f, nil
+	case PropertyTypeString:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+		if !r.Coerce {
+			return nil, fmt.Errorf("want string, got %T", v)
+		}
+		if f, ok := toFloat(v); ok {
+			return strconv.FormatFloat(f, 'f', -1, 64), nil
+		}
+		if b, ok := v.(bool); ok {
+			return strconv.FormatBool(b), nil
+		}
+		return nil, fmt.Errorf("cannot convert %T to string", v)
+	case PropertyTypeBoolean:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+		s, isString := v.(string)
+		if !isString || !r.Coerce {
+			return nil, fmt.Errorf("want boolean, got %T", v)
+		}
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %q as a boolean", s)
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("unknown property type %q", r.Type)
+	}
+}
+
+// toFloat reports the numeric value of v for any Go or JSON number type.
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,75 @@
+package monigo_test
+
+import (
+	"errors"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+var latencyMetric = monigo.Metric{
+	Name:                "Latency",
+	Aggregation:         monigo.AggregationSum,
+	AggregationProperty: "seconds",
+	PropertyRules: []monigo.PropertyRule{
+		{Property: "seconds", Type: monigo.PropertyTypeNumber, Required: true, Coerce: true, Scale: 0.001},
+		{Property: "region", Type: monigo.PropertyTypeString, Coerce: true},
+		{Property: "cached", Type: monigo.PropertyTypeBoolean, Coerce: true},
+	},
+}
+
+func TestMetric_CoerceProperties(t *testing.T) {
+	got, err := latencyMetric.CoerceProperties(map[string]any{
+		"seconds":  "1500",
+		"region":   42,
+		"cached":   "true",
+		"endpoint": "/v1/x",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got["seconds"] != 1.5 {
+		t.Errorf("seconds: got %v, want 1.5", got["seconds"])
+	}
+	if got["region"] != "42" {
+		t.Errorf("region: got %v, want \"42\"", got["region"])
+	}
+	if got["cached"] != true {
+		t.Errorf("cached: got %v, want true", got["cached"])
+	}
+	if got["endpoint"] != "/v1/x" {
+		t.Errorf("unruled property should be copied, got %v", got["endpoint"])
+	}
+}
+
+func TestMetric_CoerceProperties_NumberScaled(t *testing.T) {
+	got, err := latencyMetric.CoerceProperties(map[string]any{"seconds": 250})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got["seconds"] != 0.25 {
+		t.Errorf("seconds: got %v, want 0.25", got["seconds"])
+	}
+}
+
+func TestMetric_CoerceProperties_Errors(t *testing.T) {
+	strict := monigo.Metric{PropertyRules: []monigo.PropertyRule{
+		{Property: "units", Type: monigo.PropertyTypeNumber},
+	}}
+	cases := []struct {
+		name   string
+		metric monigo.Metric
+		props  map[string]any
+	}{
+		{"missing required", latencyMetric, map[string]any{"region": "eu"}},
+		{"unparseable", latencyMetric, map[string]any{"seconds": "fast"}},
+		{"no coercion", strict, map[string]any{"units": "12"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := tc.metric.CoerceProperties(tc.props); !errors.Is(err, monigo.ErrInvalidProperty) {
+				t.Errorf("expected ErrInvalidProperty, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package monigo
+
+import (
+	"context"
+	"net/url"
+)
+
+// QuotaService manages hard usage limits on subscriptions. Ingest reports
+// events that cross a quota in IngestResponse.OverQuota.
+type QuotaService struct {
+	client *Client
+}
+
+// Create sets a quota on a subscription's usage of a metric. Returns a 409
+// error (use IsConflict) if the subscription already has a quota for the
+// metric.
+func (s *QuotaService) Create(ctx context.Context, req CreateQuotaRequest, opts ...RequestOption) (*Quota, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	var wrapper struct {
+		Quota Quota `json:"quota"`
+	}
+	if err := s.client.do(ctx, "POST", "/v1/quotas", req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Quota, nil
+}
+
+// List returns quotas, optionally filtered by subscription or metric.
+func (s *QuotaService) List(ctx context.Context, params ListQuotasParams) (*ListQuotasResponse, error) {
+	q := url.Values{}
+	if params.SubscriptionID != "" {
+		q.Set("subscription_id", params.SubscriptionID)
+	}
+	if params.MetricID != "" {
+		q.Set("metric_id", params.MetricID)
+	}
+
+	path := "/v1/quotas"
+	if len(q) > 0 {
+		path = path + "?" + q.Encode()
+	}
+
+	var out ListQuotasResponse
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Get fetches a single quota by its UUID, including its CurrentUsage.
+func (s *QuotaService) Get(ctx context.Context, quotaID string) (*Quota, error) {
+	var wrapper struct {
+		Quota Quota `json:"quota"`
+	}
+	path, err := pathf("/v1/quotas/%s", quotaID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Quota, nil
+}
+
+// Update changes a quota's limit or behavior. A raised limit unblocks
+// further events immediately.
+func (s *QuotaService) Update(ctx context.Context, quotaID string, req UpdateQuotaRequest, opts ...RequestOption) (*Quota, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	var wrapper struct {
+		Quota Quota `json:"quota"`
+	}
+	path, err := pathf("/v1/quotas/%s", quotaID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "PATCH", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Quota, nil
+}
+
+// Delete removes a quota; the subscription's usage of the metric is
+// uncapped from then on.
+func (s *QuotaService) Delete(ctx context.Context, quotaID string) error {
+	path, err := pathf("/v1/quotas/%s", quotaID)
+	if err != nil {
+		return err
+	}
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
@@ -0,0 +1,123 @@
+package monigo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+var sampleQuota = monigo.Quota{
+	ID:             "quota-1",
+	OrgID:          "org-1",
+	SubscriptionID: "sub-1",
+	MetricID:       "metric-1",
+	Limit:          1000,
+	Behavior:       monigo.QuotaBehaviorBlock,
+	CurrentUsage:   250,
+}
+
+func TestQuotas_Create(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/quotas")
+
+		var req monigo.CreateQuotaRequest
+		decodeBody(t, r, &req)
+		if req.Limit != 1000 || req.Behavior != monigo.QuotaBehaviorBlock {
+			t.Errorf("unexpected body: %+v", req)
+		}
+		respondJSON(t, w, 201, map[string]any{"quota": sampleQuota})
+	}))
+
+	q, err := c.Quotas.Create(context.Background(), monigo.CreateQuotaRequest{
+		SubscriptionID: "sub-1",
+		MetricID:       "metric-1",
+		Limit:          1000,
+		Behavior:       monigo.QuotaBehaviorBlock,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q.ID != "quota-1" {
+		t.Errorf("expected quota-1, got %s", q.ID)
+	}
+}
+
+func TestQuotas_Create_Invalid(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected")
+	}))
+
+	_, err := c.Quotas.Create(context.Background(), monigo.CreateQuotaRequest{
+		SubscriptionID: "sub-1",
+		Limit:          -1,
+		Behavior:       "throttle",
+	})
+	var verr *monigo.RequestValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected RequestValidationError, got %v", err)
+	}
+	for _, field := range []string{"metric_id", "limit", "behavior"} {
+		if _, ok := verr.Fields[field]; !ok {
+			t.Errorf("expected an error for %s, got %v", field, verr.Fields)
+		}
+	}
+}
+
+func TestQuotas_List(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		if r.URL.Query().Get("subscription_id") != "sub-1" {
+			t.Errorf("expected subscription_id=sub-1, got %q", r.URL.Query().Get("subscription_id"))
+		}
+		respondJSON(t, w, 200, monigo.ListQuotasResponse{Quotas: []monigo.Quota{sampleQuota}, Count: 1})
+	}))
+
+	resp, err := c.Quotas.List(context.Background(), monigo.ListQuotasParams{SubscriptionID: "sub-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Quotas[0].CurrentUsage != 250 {
+		t.Errorf("expected usage 250, got %v", resp.Quotas[0].CurrentUsage)
+	}
+}
+
+func TestQuotas_Update(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "PATCH")
+		assertPath(t, r, "/v1/quotas/quota-1")
+
+		var body map[string]any
+		decodeBody(t, r, &body)
+		if body["limit"] != float64(5000) {
+			t.Errorf("limit: got %v, want 5000", body["limit"])
+		}
+		updated := sampleQuota
+		updated.Limit = 5000
+		respondJSON(t, w, 200, map[string]any{"quota": updated})
+	}))
+
+	limit := 5000.0
+	q, err := c.Quotas.Update(context.Background(), "quota-1", monigo.UpdateQuotaRequest{Limit: &limit})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q.Limit != 5000 {
+		t.Errorf("expected limit 5000, got %v", q.Limit)
+	}
+}
+
+func TestQuotas_Delete(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "DELETE")
+		assertPath(t, r, "/v1/quotas/quota-1")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	if err := c.Quotas.Delete(context.Background(), "quota-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
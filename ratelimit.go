@@ -0,0 +1,288 @@
+package monigo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimiter gates outgoing API requests. Wait blocks until the next request
+// may be sent, or returns an error if ctx is done first.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// rateLimitBackoff is implemented by limiters that want to be told when the
+// API answered 429, so every process sharing the limiter can pause together.
+type rateLimitBackoff interface {
+	Backoff(ctx context.Context, d time.Duration)
+}
+
+// WithRateLimiter makes the client call l.Wait before every request.
+func WithRateLimiter(l RateLimiter) Option {
+	return func(c *Client) {
+		c.limiter = l
+	}
+}
+
+// WithRateLimit paces the client's requests with an in-process token bucket
+// of rps requests per second and the given burst, so bulk jobs stay under
+// the organisation's limit without hand-rolled sleeps. If the API still
+// answers 429, every request from the client pauses for the Retry-After
+// delay and the rejected request is retried, up to three times. It is
+// shorthand for WithRateLimiter(NewTokenBucket(rps, burst)) plus the retry.
+//
+// Clients in different processes do not share the bucket; see
+// WithSharedRateLimiter for that.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *Client) {
+		c.limiter = NewTokenBucket(rps, burst)
+		c.rateLimitRetries = 3
+	}
+}
+
+// WithSharedRateLimiter coordinates request rate across every process that
+// uses the same Redis instance, so a fleet of pods collectively stays within
+// the organisation's rate limit. It is shorthand for
+//
+//	monigo.WithRateLimiter(monigo.NewRedisRateLimiter(redis, monigo.RedisRateLimiterConfig{
+//		Rate:  ratePerSecond,
+//		Burst: burst,
+//	}))
+func WithSharedRateLimiter(redis RedisScripter, ratePerSecond float64, burst int) Option {
+	return WithRateLimiter(NewRedisRateLimiter(redis, RedisRateLimiterConfig{
+		Rate:  ratePerSecond,
+		Burst: burst,
+	}))
+}
+
+// TokenBucket is an in-process RateLimiter that allows rate requests per
+// second with bursts of up to burst. It is safe for concurrent use; waiters
+// are served in the order they reserve a token.
+type TokenBucket struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64   // negative while waiters hold reservations
+	last   time.Time // time tokens was computed at; in the future after Backoff
+}
+
+// NewTokenBucket returns a full bucket. A non-positive rate defaults to 10
+// and a non-positive burst to 1.
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if rate <= 0 {
+		rate = 10
+	}
+	if burst <= 0 {
+		burst = 1
+	}
+	return &TokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// Wait reserves a token and blocks until it is due, or until ctx is done.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	d := b.reserve(time.Now())
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// reserve takes a token, going into debt if none is available, and returns
+// how long the caller must wait for it.
+func (b *TokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var wait time.Duration
+	if now.After(b.last) {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	} else {
+		wait = b.last.Sub(now)
+	}
+	b.tokens--
+	if b.tokens < 0 {
+		wait += time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	return wait
+}
+
+// Backoff empties the bucket and stops it refilling for d, or for one second
+// when the API gave no Retry-After, so later waiters queue behind the pause.
+// The client calls it on a 429.
+func (b *TokenBucket) Backoff(ctx context.Context, d time.Duration) {
+	if d <= 0 {
+		d = time.Second
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if until := time.Now().Add(d); until.After(b.last) {
+		b.last = until
+	}
+	if b.tokens > 0 {
+		b.tokens = 0
+	}
+}
+
+// RedisScripter is the subset of a Redis client used by RedisRateLimiter.
+// It keeps the SDK free of a Redis dependency; adapt your client with
+// RedisScripterFunc. For github.com/redis/go-redis:
+//
+//	monigo.RedisScripterFunc(func(ctx context.Context, script string, keys []string, args ...any) (any, error) {
+//		return rdb.Eval(ctx, script, keys, args...).Result()
+//	})
+type RedisScripter interface {
+	Eval(ctx context.Context, script string, keys []string, args ...any) (any, error)
+}
+
+// RedisScripterFunc adapts an ordinary function to the RedisScripter interface.
+type RedisScripterFunc func(ctx context.Context, script string, keys []string, args ...any) (any, error)
+
+// Eval calls f(ctx, script, keys, args...).
+func (f RedisScripterFunc) Eval(ctx context.Context, script string, keys []string, args ...any) (any, error) {
+	return f(ctx, script, keys, args...)
+}
+
+// RedisRateLimiterConfig configures NewRedisRateLimiter.
+type RedisRateLimiterConfig struct {
+	// Key is the Redis key prefix for the bucket. Processes sharing a key
+	// share a budget. Defaults to "monigo:ratelimit".
+	Key string
+	// Rate is the sustained number of requests per second across all
+	// processes. Defaults to 10.
+	Rate float64
+	// Burst is the bucket capacity. Defaults to 1.
+	Burst int
+}
+
+// RedisRateLimiter is a token bucket stored in Redis and safe for concurrent
+// use by any number of goroutines and processes. Time is taken from the
+// Redis server so clock skew between hosts does not matter.
+//
+// When the API still answers 429, the client records the Retry-After delay
+// in Redis and every process sharing the bucket waits it out.
+type RedisRateLimiter struct {
+	redis RedisScripter
+	key   string
+	rate  float64
+	burst int
+}
+
+// NewRedisRateLimiter returns a RedisRateLimiter using redis for storage.
+func NewRedisRateLimiter(redis RedisScripter, cfg RedisRateLimiterConfig) *RedisRateLimiter {
+	if cfg.Key == "" {
+		cfg.Key = "monigo:ratelimit"
+	}
+	if cfg.Rate <= 0 {
+		cfg.Rate = 10
+	}
+	if cfg.Burst <= 0 {
+		cfg.Burst = 1
+	}
+	return &RedisRateLimiter{redis: redis, key: cfg.Key, rate: cfg.Rate, burst: cfg.Burst}
+}
+
+// takeTokenScript atomically refills the bucket and takes one token. It
+// returns 0 when a token was taken, otherwise the milliseconds to wait
+// before trying again.
+//
+// KEYS[1] bucket hash, KEYS[2] 429 block marker; ARGV[1] rate/s, ARGV[2] burst.
+const takeTokenScript = `
+local blocked = redis.call("PTTL", KEYS[2])
+if blocked > 0 then return blocked end
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call("TIME")
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local state = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+  tokens = burst
+  ts = now
+end
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local wait = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+else
+  wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", tostring(now))
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return wait
+`
+
+// backoffScript extends the block marker to at least ARGV[1] milliseconds.
+const backoffScript = `
+local ms = tonumber(ARGV[1])
+if redis.call("PTTL", KEYS[1]) < ms then
+  redis.call("SET", KEYS[1], "1", "PX", ms)
+end
+return 0
+`
+
+// Wait blocks until a token is available in the shared bucket.
+func (l *RedisRateLimiter) Wait(ctx context.Context) error {
+	keys := []string{l.key + ":bucket", l.key + ":blocked"}
+	rate := strconv.FormatFloat(l.rate, 'f', -1, 64)
+	for {
+		res, err := l.redis.Eval(ctx, takeTokenScript, keys, rate, l.burst)
+		if err != nil {
+			return fmt.Errorf("monigo: rate limiter: %w", err)
+		}
+		ms, err := toInt64(res)
+		if err != nil {
+			return fmt.Errorf("monigo: rate limiter: %w", err)
+		}
+		if ms <= 0 {
+			return nil
+		}
+		t := time.NewTimer(time.Duration(ms) * time.Millisecond)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// Backoff blocks all processes sharing the bucket for d. The client calls it
+// when the API responds 429 with a Retry-After header.
+func (l *RedisRateLimiter) Backoff(ctx context.Context, d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	// Best effort: a failure here only means other pods find the 429 themselves.
+	_, _ = l.redis.Eval(ctx, backoffScript, []string{l.key + ":blocked"}, d.Milliseconds())
+}
+
+// toInt64 converts an integer reply from the various Redis client libraries.
+func toInt64(v any) (int64, error) {
+	switch n := v.(type) {
+	case int64:
+		return n, nil
+	case int:
+		return int64(n), nil
+	case string:
+		return strconv.ParseInt(n, 10, 64)
+	case []byte:
+		return strconv.ParseInt(string(n), 10, 64)
+	default:
+		return 0, fmt.Errorf("unexpected script result %T", v)
+	}
+}
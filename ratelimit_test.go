@@ -0,0 +1,195 @@
+package monigo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+// fakeRedis answers the rate limiter's scripts with canned wait times and
+// records Backoff calls.
+type fakeRedis struct {
+	mu      sync.Mutex
+	waits   []int64 // returned by successive token requests
+	calls   int
+	backoff []any
+}
+
+func (f *fakeRedis) Eval(ctx context.Context, script string, keys []string, args ...any) (any, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if strings.Contains(script, `"SET"`) {
+		f.backoff = append(f.backoff, args[0])
+		return int64(0), nil
+	}
+	f.calls++
+	if len(f.waits) == 0 {
+		return int64(0), nil
+	}
+	w := f.waits[0]
+	f.waits = f.waits[1:]
+	return w, nil
+}
+
+func TestRedisRateLimiter_WaitsUntilTokenAvailable(t *testing.T) {
+	r := &fakeRedis{waits: []int64{5, 5, 0}}
+	l := monigo.NewRedisRateLimiter(r, monigo.RedisRateLimiterConfig{Rate: 100})
+
+	start := time.Now()
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.calls != 3 {
+		t.Errorf("expected 3 token attempts, got %d", r.calls)
+	}
+	if elapsed := time.Since(start); elapsed < 10*time.Millisecond {
+		t.Errorf("expected to wait at least 10ms, waited %v", elapsed)
+	}
+}
+
+func TestRedisRateLimiter_ContextCancelled(t *testing.T) {
+	r := &fakeRedis{waits: []int64{60000}}
+	l := monigo.NewRedisRateLimiter(r, monigo.RedisRateLimiterConfig{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestRedisRateLimiter_EvalError(t *testing.T) {
+	r := monigo.RedisScripterFunc(func(ctx context.Context, script string, keys []string, args ...any) (any, error) {
+		return nil, errors.New("connection refused")
+	})
+	l := monigo.NewRedisRateLimiter(r, monigo.RedisRateLimiterConfig{})
+	if err := l.Wait(context.Background()); err == nil || !strings.Contains(err.Error(), "connection refused") {
+		t.Errorf("expected redis error, got %v", err)
+	}
+}
+
+func TestWithSharedRateLimiter_BacksOffOn429(t *testing.T) {
+	r := &fakeRedis{}
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Retry-After", "2")
+		respondError(t, w, http.StatusTooManyRequests, "rate limit exceeded")
+	}), monigo.WithSharedRateLimiter(r, 50, 10))
+
+	_, err := c.Customers.Get(context.Background(), "cust-abc")
+	if !monigo.IsRateLimited(err) {
+		t.Fatalf("expected rate limited error, got %v", err)
+	}
+	if r.calls != 1 {
+		t.Errorf("expected the limiter to be consulted once, got %d", r.calls)
+	}
+	if len(r.backoff) != 1 || r.backoff[0] != int64(2000) {
+		t.Errorf("expected a 2000ms backoff, got %v", r.backoff)
+	}
+}
+
+type limiterFunc func(context.Context) error
+
+func (f limiterFunc) Wait(ctx context.Context) error { return f(ctx) }
+
+func TestWithRateLimiter_ErrorAbortsRequest(t *testing.T) {
+	called := false
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}), monigo.WithRateLimiter(limiterFunc(func(context.Context) error {
+		return context.Canceled
+	})))
+
+	_, err := c.Customers.Get(context.Background(), "cust-abc")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if called {
+		t.Error("request should not be sent when the limiter fails")
+	}
+}
+
+func TestTokenBucket_SpacesRequests(t *testing.T) {
+	b := monigo.NewTokenBucket(100, 1)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := b.Wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("expected 3 requests at 100/s to take at least 20ms, took %v", elapsed)
+	}
+}
+
+func TestTokenBucket_BackoffBlocksWaiters(t *testing.T) {
+	b := monigo.NewTokenBucket(1000, 10)
+	b.Backoff(context.Background(), 30*time.Millisecond)
+
+	start := time.Now()
+	if err := b.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("expected Wait to block for the backoff, returned after %v", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	b.Backoff(context.Background(), time.Minute)
+	if err := b.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestWithRateLimit_RetriesAfter429(t *testing.T) {
+	var calls int
+	var keys []string
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if calls == 1 {
+			w.Header().Set("Retry-After", "1")
+			respondError(t, w, http.StatusTooManyRequests, "rate limit exceeded")
+			return
+		}
+		respondJSON(t, w, 201, map[string]any{"customer": sampleCustomer})
+	}), monigo.WithRateLimit(50, 5))
+
+	start := time.Now()
+	if _, err := c.Customers.Create(context.Background(), monigo.CreateCustomerRequest{Name: "Acme"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected the 429 to be retried once, got %d calls", calls)
+	}
+	if keys[0] == "" || keys[0] != keys[1] {
+		t.Errorf("expected the retry to reuse the idempotency key, got %q", keys)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected the retry to wait for Retry-After, took %v", elapsed)
+	}
+}
+
+func TestWithRateLimit_BackoffHonoursContext(t *testing.T) {
+	var calls int
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		respondError(t, w, http.StatusTooManyRequests, "rate limit exceeded")
+	}), monigo.WithRateLimit(50, 5))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := c.Customers.Get(ctx, "cust-abc")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the backoff to run into the deadline, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call before the deadline, got %d", calls)
+	}
+}
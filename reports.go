@@ -0,0 +1,40 @@
+package monigo
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// ReportService produces aggregate reports computed from billing data.
+type ReportService struct {
+	client *Client
+}
+
+// TrialConversions summarises trials started, converted, and expired per
+// plan over a period.
+func (s *ReportService) TrialConversions(ctx context.Context, params TrialConversionParams) (*TrialConversionReport, error) {
+	q := url.Values{}
+	if params.PlanID != "" {
+		q.Set("plan_id", params.PlanID)
+	}
+	if params.From != nil {
+		q.Set("from", params.From.UTC().Format(time.RFC3339))
+	}
+	if params.To != nil {
+		q.Set("to", params.To.UTC().Format(time.RFC3339))
+	}
+
+	path := "/v1/reports/trial-conversions"
+	if len(q) > 0 {
+		path = path + "?" + q.Encode()
+	}
+
+	var wrapper struct {
+		Report TrialConversionReport `json:"report"`
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Report, nil
+}
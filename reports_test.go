@@ -0,0 +1,56 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestReports_TrialConversions(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/reports/trial-conversions")
+		assertBearerToken(t, r)
+		q := r.URL.Query()
+		if q.Get("plan_id") != "plan-1" {
+			t.Errorf("plan_id: got %q, want plan-1", q.Get("plan_id"))
+		}
+		if q.Get("from") != "2026-01-01T00:00:00Z" {
+			t.Errorf("from: got %q", q.Get("from"))
+		}
+		respondJSON(t, w, 200, map[string]any{"report": monigo.TrialConversionReport{
+			From: from,
+			To:   to,
+			Plans: []monigo.PlanTrialConversion{{
+				PlanID:         "plan-1",
+				PlanName:       "API Pro",
+				TrialsStarted:  40,
+				Converted:      12,
+				Expired:        18,
+				InTrial:        10,
+				ConversionRate: 0.4,
+			}},
+		}})
+	}))
+
+	report, err := c.Reports.TrialConversions(context.Background(), monigo.TrialConversionParams{
+		PlanID: "plan-1",
+		From:   &from,
+		To:     &to,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.Plans) != 1 {
+		t.Fatalf("expected 1 plan row, got %d", len(report.Plans))
+	}
+	if report.Plans[0].Converted != 12 {
+		t.Errorf("expected 12 converted, got %d", report.Plans[0].Converted)
+	}
+}
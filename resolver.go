@@ -0,0 +1,132 @@
+package monigo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultResolverTTL is used when NewCustomerResolver is given a zero TTL.
+const defaultResolverTTL = 10 * time.Minute
+
+// resolverLookupTimeout bounds a shared lookup, which no single caller's
+// context controls.
+const resolverLookupTimeout = 30 * time.Second
+
+// CustomerResolver maps your own customer IDs (Customer.ExternalID) to
+// Monigo customer UUIDs, caching each mapping in memory for a TTL. Concurrent
+// lookups of the same uncached ID share a single API call, so a burst of
+// events for a new customer costs one request. Failed lookups are not cached.
+//
+// A CustomerResolver is safe for concurrent use.
+type CustomerResolver struct {
+	customers *CustomerService
+	ttl       time.Duration
+
+	mu       sync.Mutex
+	entries  map[string]resolverEntry
+	inflight map[string]*resolverCall
+	swept    time.Time
+}
+
+type resolverEntry struct {
+	id      string
+	expires time.Time
+}
+
+// resolverCall is a lookup in progress; waiters block on done.
+type resolverCall struct {
+	done chan struct{}
+	id   string
+	err  error
+}
+
+// NewCustomerResolver returns a resolver that looks customers up through
+// client and caches them for ttl. A zero ttl uses ten minutes.
+func NewCustomerResolver(client *Client, ttl time.Duration) *CustomerResolver {
+	if ttl <= 0 {
+		ttl = defaultResolverTTL
+	}
+	return &CustomerResolver{
+		customers: client.Customers,
+		ttl:       ttl,
+		entries:   make(map[string]resolverEntry),
+		inflight:  make(map[string]*resolverCall),
+	}
+}
+
+// Resolve returns the Monigo UUID of the customer with the given external
+// ID. It returns a 404 *APIError (use IsNotFound) when there is none.
+func (r *CustomerResolver) Resolve(ctx context.Context, externalID string) (string, error) {
+	r.mu.Lock()
+	if e, ok := r.entries[externalID]; ok && time.Now().Before(e.expires) {
+		r.mu.Unlock()
+		return e.id, nil
+	}
+	call, ok := r.inflight[externalID]
+	if !ok {
+		call = &resolverCall{done: make(chan struct{})}
+		r.inflight[externalID] = call
+		go r.lookup(ctx, externalID, call)
+	}
+	r.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.id, call.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// lookup resolves externalID for every caller waiting on call. It runs on
+// a context detached from the caller that started it, so that caller
+// giving up does not fail the others.
+func (r *CustomerResolver) lookup(ctx context.Context, externalID string, call *resolverCall) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), resolverLookupTimeout)
+	defer cancel()
+	c, err := r.customers.GetByExternalID(ctx, externalID)
+	if err == nil {
+		call.id = c.ID
+	}
+	call.err = err
+
+	r.mu.Lock()
+	delete(r.inflight, externalID)
+	if err == nil {
+		r.storeLocked(externalID, call.id)
+	}
+	r.mu.Unlock()
+	close(call.done)
+}
+
+// storeLocked caches a mapping. At most once per TTL it first drops every
+// expired entry, so IDs that are never looked up again do not accumulate.
+// The caller must hold r.mu.
+func (r *CustomerResolver) storeLocked(externalID, customerID string) {
+	now := time.Now()
+	if now.Sub(r.swept) >= r.ttl {
+		for k, e := range r.entries {
+			if now.After(e.expires) {
+				delete(r.entries, k)
+			}
+		}
+		r.swept = now
+	}
+	r.entries[externalID] = resolverEntry{id: customerID, expires: now.Add(r.ttl)}
+}
+
+// Set records a known mapping, e.g. right after Customers.Create, so the
+// first event for a new customer needs no lookup.
+func (r *CustomerResolver) Set(externalID, customerID string) {
+	r.mu.Lock()
+	r.storeLocked(externalID, customerID)
+	r.mu.Unlock()
+}
+
+// Invalidate forgets the cached mapping for externalID.
+func (r *CustomerResolver) Invalidate(externalID string) {
+	r.mu.Lock()
+	delete(r.entries, externalID)
+	r.mu.Unlock()
+}
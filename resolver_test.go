@@ -0,0 +1,143 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestCustomerResolver_CachesAndDeduplicates(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertPath(t, r, "/v1/customers")
+		if got := r.URL.Query().Get("external_id"); got != "user-001" {
+			t.Errorf("external_id: got %q", got)
+		}
+		calls.Add(1)
+		<-release
+		respondJSON(t, w, 200, monigo.ListCustomersResponse{
+			Customers: []monigo.Customer{{ID: "cust-abc", ExternalID: "user-001"}},
+			Count:     1,
+		})
+	}))
+	res := monigo.NewCustomerResolver(c, time.Minute)
+
+	var wg sync.WaitGroup
+	ids := make([]string, 10)
+	for i := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := res.Resolve(context.Background(), "user-001")
+			if err != nil {
+				t.Errorf("Resolve: %v", err)
+			}
+			ids[i] = id
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if id, _ := res.Resolve(context.Background(), "user-001"); id != "cust-abc" {
+		t.Errorf("cached id: got %q", id)
+	}
+	for _, id := range ids {
+		if id != "cust-abc" {
+			t.Errorf("expected cust-abc, got %q", id)
+		}
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("expected 1 API call, got %d", n)
+	}
+}
+
+func TestCustomerResolver_LeaderCancelDoesNotFailWaiters(t *testing.T) {
+	var calls atomic.Int32
+	arrived, release := make(chan struct{}), make(chan struct{})
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			close(arrived)
+		}
+		<-release
+		respondJSON(t, w, 200, monigo.ListCustomersResponse{
+			Customers: []monigo.Customer{{ID: "cust-abc", ExternalID: "user-001"}},
+			Count:     1,
+		})
+	}))
+	res := monigo.NewCustomerResolver(c, time.Minute)
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := res.Resolve(leaderCtx, "user-001")
+		leaderErr <- err
+	}()
+	<-arrived
+
+	waiter := make(chan string, 1)
+	go func() {
+		id, err := res.Resolve(context.Background(), "user-001")
+		if err != nil {
+			t.Errorf("waiter: %v", err)
+		}
+		waiter <- id
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	if err := <-leaderErr; err != context.Canceled {
+		t.Errorf("leader: expected context.Canceled, got %v", err)
+	}
+	close(release)
+
+	if id := <-waiter; id != "cust-abc" {
+		t.Errorf("waiter: expected cust-abc, got %q", id)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("expected 1 API call, got %d", n)
+	}
+}
+
+func TestCustomerResolver_NotFoundIsNotCached(t *testing.T) {
+	var calls atomic.Int32
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		respondJSON(t, w, 200, monigo.ListCustomersResponse{})
+	}))
+	res := monigo.NewCustomerResolver(c, time.Minute)
+
+	for range 2 {
+		if _, err := res.Resolve(context.Background(), "ghost"); !monigo.IsNotFound(err) {
+			t.Fatalf("expected IsNotFound, got %v", err)
+		}
+	}
+	if n := calls.Load(); n != 2 {
+		t.Errorf("expected 2 API calls, got %d", n)
+	}
+}
+
+func TestCustomerResolver_SetAndInvalidate(t *testing.T) {
+	var calls atomic.Int32
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		respondJSON(t, w, 200, monigo.ListCustomersResponse{
+			Customers: []monigo.Customer{{ID: "cust-new", ExternalID: "user-002"}},
+		})
+	}))
+	res := monigo.NewCustomerResolver(c, 0)
+
+	res.Set("user-002", "cust-old")
+	if id, _ := res.Resolve(context.Background(), "user-002"); id != "cust-old" || calls.Load() != 0 {
+		t.Fatalf("expected the preset mapping without a call, got %q after %d calls", id, calls.Load())
+	}
+	res.Invalidate("user-002")
+	if id, _ := res.Resolve(context.Background(), "user-002"); id != "cust-new" {
+		t.Errorf("after Invalidate: got %q", id)
+	}
+}
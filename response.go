@@ -0,0 +1,131 @@
+package monigo
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimit describes the caller's rate-limit budget as reported by the
+// X-RateLimit-* response headers.
+type RateLimit struct {
+	// Limit is the number of requests allowed in the current window.
+	Limit int
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+	// Reset is when the current window ends and Remaining is restored.
+	Reset time.Time
+}
+
+// ResponseMeta holds metadata parsed from an API response's headers.
+type ResponseMeta struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// RequestID is the server-assigned request ID. Quote it in support tickets.
+	RequestID string
+	// RateLimit is the rate-limit state after the request, or nil if the
+	// server did not send rate-limit headers.
+	RateLimit *RateLimit
+	// ETag is the entity tag of the returned representation, if any.
+	ETag string
+	// Header holds all response headers, for anything not parsed above such
+	// as pagination links or custom headers.
+	Header http.Header
+}
+
+type responseMetaKey struct{}
+
+// responseMetaSink serialises the writes of calls that share a context,
+// such as the concurrent windows of Usage.QueryRange.
+type responseMetaSink struct {
+	mu sync.Mutex
+	m  *ResponseMeta
+}
+
+func (s *responseMetaSink) store(meta ResponseMeta) {
+	s.mu.Lock()
+	*s.m = meta
+	s.mu.Unlock()
+}
+
+// ContextWithResponseMeta returns a context that makes every API call using
+// it record its response metadata into m, overwriting the previous value.
+// It works for all service methods, successful or not:
+//
+//	var meta monigo.ResponseMeta
+//	ctx := monigo.ContextWithResponseMeta(ctx, &meta)
+//	_, err := client.Customers.Get(ctx, id)
+//	log.Println("request", meta.RequestID)
+//	if meta.RateLimit != nil {
+//		log.Println("remaining", meta.RateLimit.Remaining)
+//	}
+//
+// When calls sharing the context run concurrently, m holds whichever
+// response arrived last. Read it only once they have all returned.
+func ContextWithResponseMeta(ctx context.Context, m *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaKey{}, &responseMetaSink{m: m})
+}
+
+// WithResponseCapture records the call's response metadata into m. It is
+// the per-call counterpart of ContextWithResponseMeta:
+//
+//	var meta monigo.ResponseMeta
+//	_, err := client.Customers.Create(ctx, req, monigo.WithResponseCapture(&meta))
+func WithResponseCapture(m *ResponseMeta) RequestOption {
+	return func(c *requestConfig) {
+		c.capture = m
+	}
+}
+
+func responseMetaFromContext(ctx context.Context) *responseMetaSink {
+	s, _ := ctx.Value(responseMetaKey{}).(*responseMetaSink)
+	return s
+}
+
+// newResponseMeta parses the metadata headers of resp.
+func newResponseMeta(resp *http.Response) ResponseMeta {
+	return ResponseMeta{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get(requestIDHeader),
+		RateLimit:  parseRateLimit(resp.Header),
+		ETag:       resp.Header.Get("ETag"),
+		Header:     resp.Header.Clone(),
+	}
+}
+
+// parseRateLimit reads the X-RateLimit-Limit, X-RateLimit-Remaining, and
+// X-RateLimit-Reset (Unix seconds) headers. It returns nil when none are set.
+func parseRateLimit(h http.Header) *RateLimit {
+	limit, lerr := strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	remaining, rerr := strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	reset, serr := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64)
+	if lerr != nil && rerr != nil && serr != nil {
+		return nil
+	}
+	rl := &RateLimit{Limit: limit, Remaining: remaining}
+	if serr == nil {
+		rl.Reset = time.Unix(reset, 0)
+	}
+	return rl
+}
+
+// parseRetryAfter reads the Retry-After header, which may be either a number
+// of seconds or an HTTP date. It returns zero when the header is absent or
+// malformed.
+func parseRetryAfter(h http.Header) time.Duration {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,104 @@
+package monigo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestContextWithResponseMeta(t *testing.T) {
+	reset := time.Now().Add(time.Minute).Unix()
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-abc")
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "97")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset, 10))
+		respondJSON(t, w, 200, map[string]any{"customer": sampleCustomer})
+	}))
+
+	var meta monigo.ResponseMeta
+	ctx := monigo.ContextWithResponseMeta(context.Background(), &meta)
+	if _, err := c.Customers.Get(ctx, "cust-abc"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if meta.StatusCode != 200 {
+		t.Errorf("status: got %d, want 200", meta.StatusCode)
+	}
+	if meta.RequestID != "req-abc" {
+		t.Errorf("request_id: got %q, want req-abc", meta.RequestID)
+	}
+	if meta.RateLimit == nil {
+		t.Fatal("expected RateLimit to be parsed")
+	}
+	if meta.RateLimit.Limit != 100 || meta.RateLimit.Remaining != 97 {
+		t.Errorf("unexpected rate limit: %+v", meta.RateLimit)
+	}
+	if meta.RateLimit.Reset.Unix() != reset {
+		t.Errorf("reset: got %d, want %d", meta.RateLimit.Reset.Unix(), reset)
+	}
+}
+
+func TestAPIError_HeaderMetadata(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-429")
+		w.Header().Set("Retry-After", "7")
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		respondError(t, w, 429, "too many requests")
+	}))
+
+	_, err := c.Customers.Get(context.Background(), "cust-abc")
+	var apiErr *monigo.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %v", err)
+	}
+	if apiErr.RequestID != "req-429" {
+		t.Errorf("request_id: got %q, want req-429", apiErr.RequestID)
+	}
+	if apiErr.RetryAfter != 7*time.Second {
+		t.Errorf("retry_after: got %v, want 7s", apiErr.RetryAfter)
+	}
+	if apiErr.RateLimit == nil || apiErr.RateLimit.Remaining != 0 {
+		t.Errorf("unexpected rate limit: %+v", apiErr.RateLimit)
+	}
+}
+
+func TestResponseMeta_NoRateLimitHeaders(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, 200, map[string]any{"customer": sampleCustomer})
+	}))
+
+	var meta monigo.ResponseMeta
+	ctx := monigo.ContextWithResponseMeta(context.Background(), &meta)
+	if _, err := c.Customers.Get(ctx, "cust-abc"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if meta.RateLimit != nil {
+		t.Errorf("expected nil RateLimit, got %+v", meta.RateLimit)
+	}
+}
+
+func TestWithResponseCapture(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v3"`)
+		w.Header().Set("Link", `</v1/customers?cursor=abc>; rel="next"`)
+		respondJSON(t, w, 201, map[string]any{"customer": sampleCustomer})
+	}))
+
+	var meta monigo.ResponseMeta
+	_, err := c.Customers.Create(context.Background(), monigo.CreateCustomerRequest{Name: "Acme"}, monigo.WithResponseCapture(&meta))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if meta.StatusCode != 201 || meta.ETag != `"v3"` {
+		t.Errorf("unexpected meta: %+v", meta)
+	}
+	if got := meta.Header.Get("Link"); got == "" {
+		t.Error("expected the Link header to be captured")
+	}
+}
@@ -0,0 +1,214 @@
+package monigo
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Outcomes counted by the monigo_ingest_events_total metric.
+const (
+	StatsOutcomeIngested  = "ingested"
+	StatsOutcomeDuplicate = "duplicate"
+	StatsOutcomeBlocked   = "blocked"
+	StatsOutcomeFailed    = "failed"
+)
+
+// statsLatencyBuckets are the upper bounds, in seconds, of the ingest latency
+// histogram.
+var statsLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Stats records how the client's usage reporting is doing, so operators can
+// alert when it falls behind. It serves the Prometheus text exposition
+// format, so it can be scraped directly without a Prometheus client library:
+//
+//	stats := monigo.NewStats()
+//	client := monigo.New(apiKey, monigo.WithStats(stats))
+//	http.Handle("/metrics/monigo", stats)
+//
+// It exports:
+//
+//	monigo_ingest_events_total{outcome}     events per outcome (ingested, duplicate, blocked, failed)
+//	monigo_ingest_batch_duration_seconds    histogram of POST /v1/ingest latency
+//	monigo_request_retries_total            requests retried after a 429
+//
+// plus any gauge added with RegisterGauge, such as an eventqueue depth. A
+// Stats is safe for concurrent use and may be shared by several clients.
+type Stats struct {
+	mu      sync.Mutex
+	events  map[string]uint64
+	buckets []uint64 // cumulative counts, one per statsLatencyBuckets entry
+	count   uint64
+	sum     float64
+	retries uint64
+	gauges  map[string]statsGauge
+}
+
+type statsGauge struct {
+	help string
+	fn   func() float64
+}
+
+// NewStats returns an empty Stats.
+func NewStats() *Stats {
+	return &Stats{
+		events:  make(map[string]uint64),
+		buckets: make([]uint64, len(statsLatencyBuckets)),
+		gauges:  make(map[string]statsGauge),
+	}
+}
+
+// WithStats records the client's ingest outcomes, ingest latency and
+// retries in s.
+func WithStats(s *Stats) Option {
+	return func(c *Client) {
+		c.stats = s
+	}
+}
+
+// RegisterGauge exports the value returned by fn under name each time the
+// Stats is scraped. It replaces any gauge already registered under name:
+//
+//	stats.RegisterGauge("monigo_eventqueue_depth", "Batches waiting in the offline queue.", func() float64 {
+//		n, _ := q.Len()
+//		return float64(n)
+//	})
+func (s *Stats) RegisterGauge(name, help string, fn func() float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gauges[name] = statsGauge{help: help, fn: fn}
+}
+
+// Events returns the number of events counted with outcome, one of the
+// StatsOutcomeXxx constants.
+func (s *Stats) Events(outcome string) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.events[outcome]
+}
+
+// Retries returns the number of requests retried after a 429.
+func (s *Stats) Retries() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.retries
+}
+
+// observeIngest records one POST /v1/ingest call of n events.
+func (s *Stats) observeIngest(n int, resp *IngestResponse, err error, latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	secs := latency.Seconds()
+	for i, le := range statsLatencyBuckets {
+		if secs <= le {
+			s.buckets[i]++
+		}
+	}
+	s.count++
+	s.sum += secs
+
+	if err != nil {
+		s.events[StatsOutcomeFailed] += uint64(n)
+		return
+	}
+	var blocked int
+	for _, q := range resp.OverQuota {
+		if q.Behavior == QuotaBehaviorBlock {
+			blocked++
+		}
+	}
+	s.events[StatsOutcomeIngested] += uint64(len(resp.Ingested))
+	s.events[StatsOutcomeDuplicate] += uint64(len(resp.Duplicates))
+	s.events[StatsOutcomeBlocked] += uint64(blocked)
+}
+
+// observeRetry records a request retried after a 429.
+func (s *Stats) observeRetry() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.retries++
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format.
+func (s *Stats) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	s.WriteTo(w)
+}
+
+// WriteTo writes the metrics to w in the Prometheus text exposition format.
+func (s *Stats) WriteTo(w io.Writer) (int64, error) {
+	s.mu.Lock()
+	events := make(map[string]uint64, len(s.events))
+	for k, v := range s.events {
+		events[k] = v
+	}
+	buckets := append([]uint64(nil), s.buckets...)
+	count, sum, retries := s.count, s.sum, s.retries
+	names := make([]string, 0, len(s.gauges))
+	gauges := make(map[string]statsGauge, len(s.gauges))
+	for name, g := range s.gauges {
+		names = append(names, name)
+		gauges[name] = g
+	}
+	s.mu.Unlock()
+	sort.Strings(names)
+
+	cw := &countingWriter{w: w}
+	bw := bufio.NewWriter(cw)
+
+	fmt.Fprintln(bw, "# HELP monigo_ingest_events_total Usage events sent to the ingest endpoint, by outcome.")
+	fmt.Fprintln(bw, "# TYPE monigo_ingest_events_total counter")
+	for _, outcome := range []string{StatsOutcomeIngested, StatsOutcomeDuplicate, StatsOutcomeBlocked, StatsOutcomeFailed} {
+		fmt.Fprintf(bw, "monigo_ingest_events_total{outcome=%q} %d\n", outcome, events[outcome])
+	}
+
+	fmt.Fprintln(bw, "# HELP monigo_ingest_batch_duration_seconds Latency of ingest requests.")
+	fmt.Fprintln(bw, "# TYPE monigo_ingest_batch_duration_seconds histogram")
+	for i, le := range statsLatencyBuckets {
+		fmt.Fprintf(bw, "monigo_ingest_batch_duration_seconds_bucket{le=%q} %d\n", formatFloat(le), buckets[i])
+	}
+	fmt.Fprintf(bw, "monigo_ingest_batch_duration_seconds_bucket{le=\"+Inf\"} %d\n", count)
+	fmt.Fprintf(bw, "monigo_ingest_batch_duration_seconds_sum %s\n", formatFloat(sum))
+	fmt.Fprintf(bw, "monigo_ingest_batch_duration_seconds_count %d\n", count)
+
+	fmt.Fprintln(bw, "# HELP monigo_request_retries_total Requests retried after a 429 response.")
+	fmt.Fprintln(bw, "# TYPE monigo_request_retries_total counter")
+	fmt.Fprintf(bw, "monigo_request_retries_total %d\n", retries)
+
+	for _, name := range names {
+		g := gauges[name]
+		fmt.Fprintf(bw, "# HELP %s %s\n", name, g.help)
+		fmt.Fprintf(bw, "# TYPE %s gauge\n", name)
+		fmt.Fprintf(bw, "%s %s\n", name, formatFloat(g.fn()))
+	}
+
+	err := bw.Flush()
+	return cw.n, err
+}
+
+// formatFloat formats v as the exposition format expects.
+func formatFloat(v float64) string {
+	if math.IsInf(v, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
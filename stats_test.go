@@ -0,0 +1,68 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestStats_IngestOutcomes(t *testing.T) {
+	fail := false
+	stats := monigo.NewStats()
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			respondError(t, w, 500, "boom")
+			return
+		}
+		respondJSON(t, w, 202, map[string]any{
+			"ingested":   []string{"key-1"},
+			"duplicates": []string{"key-2"},
+			"over_quota": []map[string]any{{"idempotency_key": "key-3", "behavior": "block"}},
+		})
+	}), monigo.WithStats(stats))
+
+	now := time.Now()
+	events := []monigo.IngestEvent{
+		{EventName: "api_call", CustomerID: "cust-1", IdempotencyKey: "key-1", Timestamp: now, Properties: map[string]any{}},
+		{EventName: "api_call", CustomerID: "cust-1", IdempotencyKey: "key-2", Timestamp: now, Properties: map[string]any{}},
+		{EventName: "api_call", CustomerID: "cust-1", IdempotencyKey: "key-3", Timestamp: now, Properties: map[string]any{}},
+	}
+	if _, err := c.Events.Ingest(context.Background(), monigo.IngestRequest{Events: events}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fail = true
+	if _, err := c.Events.Ingest(context.Background(), monigo.IngestRequest{Events: events[:2]}); err == nil {
+		t.Fatal("expected error")
+	}
+
+	for outcome, want := range map[string]uint64{
+		monigo.StatsOutcomeIngested:  1,
+		monigo.StatsOutcomeDuplicate: 1,
+		monigo.StatsOutcomeBlocked:   1,
+		monigo.StatsOutcomeFailed:    2,
+	} {
+		if got := stats.Events(outcome); got != want {
+			t.Errorf("%s: expected %d, got %d", outcome, want, got)
+		}
+	}
+
+	stats.RegisterGauge("monigo_eventqueue_depth", "Batches waiting in the offline queue.", func() float64 { return 4 })
+	rec := httptest.NewRecorder()
+	stats.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body := rec.Body.String()
+	for _, want := range []string{
+		`monigo_ingest_events_total{outcome="failed"} 2`,
+		`monigo_ingest_batch_duration_seconds_count 2`,
+		`monigo_request_retries_total 0`,
+		"# TYPE monigo_eventqueue_depth gauge\nmonigo_eventqueue_depth 4\n",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected %q in output:\n%s", want, body)
+		}
+	}
+}
@@ -0,0 +1,368 @@
+package monigo
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Statuses reported in EventAck.Status.
+const (
+	EventAckIngested  = "ingested"
+	EventAckDuplicate = "duplicate"
+	EventAckRejected  = "rejected"
+)
+
+// ErrStreamClosed is returned by EventStream.Send after Close.
+var ErrStreamClosed = errors.New("monigo: event stream closed")
+
+// StreamOptions configures Events.Stream. The zero value is usable.
+type StreamOptions struct {
+	// BatchSize is the number of events buffered before they are sent with
+	// Events.Ingest when the server does not support streaming. Defaults
+	// to 500.
+	BatchSize int
+	// FlushInterval is the longest an event waits in the fallback buffer.
+	// Defaults to one second.
+	FlushInterval time.Duration
+	// AckBuffer is the capacity of the Acks channel. Defaults to 1024.
+	AckBuffer int
+}
+
+// EventAck acknowledges a single event sent on an EventStream.
+type EventAck struct {
+	IdempotencyKey string `json:"idempotency_key"`
+	// Status is one of EventAckIngested, EventAckDuplicate or
+	// EventAckRejected.
+	Status string `json:"status"`
+	// Error explains why a rejected event was not ingested.
+	Error string `json:"error,omitempty"`
+}
+
+// EventStream is a long-lived ingestion session opened by Events.Stream.
+// Events are written to a single POST request as newline-delimited JSON
+// and acknowledged individually on Acks. When the server does not offer
+// streaming, the session falls back to batched Events.Ingest calls and
+// synthesizes the same acks.
+//
+// Send is safe for concurrent use. Acks must be drained: the stream stops
+// accepting events once the channel is full.
+type EventStream struct {
+	svc  *EventService
+	ctx  context.Context
+	opts StreamOptions
+	acks chan EventAck
+
+	mu     sync.Mutex
+	closed bool
+	err    error // first error that ended the session
+
+	// Streaming mode.
+	pw       *io.PipeWriter
+	enc      *json.Encoder
+	readDone chan struct{}
+
+	// Fallback mode.
+	fallback bool
+	pending  []IngestEvent
+	inflight sync.WaitGroup
+	stop     chan struct{}
+	loopDone chan struct{}
+}
+
+// Stream opens a persistent ingestion session for high-frequency telemetry,
+// avoiding the per-request overhead of Ingest. The session is opened with
+// POST /v1/ingest/streams; events then flow on one long-lived request
+// while acks flow back on its response, both directions at once over
+// HTTP/2. If the server answers the open call with 404, 405 or 501 the
+// session transparently uses batched POSTs instead (see
+// EventStream.Fallback).
+//
+// The session lives until Close is called or ctx is cancelled. opts apply
+// to the open call; a WithTimeout bounds the open, not the session.
+func (s *EventService) Stream(ctx context.Context, opts StreamOptions, reqOpts ...RequestOption) (*EventStream, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 500
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = time.Second
+	}
+	if opts.AckBuffer <= 0 {
+		opts.AckBuffer = 1024
+	}
+	st := &EventStream{
+		svc:  s,
+		ctx:  ctx,
+		opts: opts,
+		acks: make(chan EventAck, opts.AckBuffer),
+	}
+
+	var wrapper struct {
+		Stream struct {
+			ID string `json:"id"`
+		} `json:"stream"`
+	}
+	if err := s.client.do(ctx, "POST", "/v1/ingest/streams", nil, &wrapper, reqOpts...); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && streamingUnsupported(apiErr.StatusCode) {
+			st.startFallback()
+			return st, nil
+		}
+		return nil, err
+	}
+	path, err := pathf("/v1/ingest/streams/%s", wrapper.Stream.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	resp, err := s.client.openStream(ctx, path, pr, reqOpts)
+	if err != nil {
+		pw.Close()
+		return nil, err
+	}
+	st.pw = pw
+	st.enc = json.NewEncoder(pw)
+	st.readDone = make(chan struct{})
+	go st.readAcks(resp.Body)
+	return st, nil
+}
+
+// streamingUnsupported reports whether status means the server has no
+// streaming ingestion endpoint.
+func streamingUnsupported(status int) bool {
+	return status == http.StatusNotFound ||
+		status == http.StatusMethodNotAllowed ||
+		status == http.StatusNotImplemented
+}
+
+// openStream starts a POST to path whose NDJSON body is read from body for
+// as long as the request lives. It goes through the same scope check, rate
+// limiter, logging and response metadata as do, but returns the response
+// as soon as its headers arrive so the caller can read it incrementally.
+func (c *Client) openStream(ctx context.Context, path string, body io.Reader, opts []RequestOption) (*http.Response, error) {
+	cfg := c.requestConfig(ctx, opts)
+	if err := c.checkScope("POST", path); err != nil {
+		return nil, err
+	}
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	req, err := c.newRequest(ctx, cfg, "POST", path, body, "application/x-ndjson")
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logRequest(ctx, req, nil, time.Since(start), nil, nil, err)
+		return nil, fmt.Errorf("monigo: execute request: %w", err)
+	}
+	meta := recordResponseMeta(ctx, cfg, resp)
+	if resp.StatusCode >= 400 {
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		c.logRequest(ctx, req, resp, time.Since(start), nil, respBody, err)
+		return nil, newAPIError(resp, meta, respBody)
+	}
+	c.logRequest(ctx, req, resp, time.Since(start), nil, nil, nil)
+	return resp, nil
+}
+
+// Fallback reports whether the session is sending batched POSTs because
+// the server does not support streaming.
+func (st *EventStream) Fallback() bool {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.fallback
+}
+
+// Acks returns the channel on which each sent event is acknowledged. It is
+// closed once Close has returned or the stream has failed.
+func (st *EventStream) Acks() <-chan EventAck { return st.acks }
+
+// Send validates e and pushes it onto the stream. In test mode the event is
+// marked IsTest, as with Ingest. The outcome is reported on Acks.
+//
+// A failed batch in fallback mode ends the session like a broken
+// connection does: its events are acked as rejected and Send and Close
+// return the error from then on.
+func (st *EventStream) Send(e IngestEvent) error {
+	if err := (IngestRequest{Events: []IngestEvent{e}}).Validate(); err != nil {
+		return err
+	}
+	if st.svc.client.testMode {
+		e.IsTest = true
+	}
+
+	st.mu.Lock()
+	if st.closed {
+		st.mu.Unlock()
+		return ErrStreamClosed
+	}
+	if st.err != nil {
+		err := st.err
+		st.mu.Unlock()
+		return err
+	}
+	if st.fallback {
+		st.pending = append(st.pending, e)
+		batch := st.takeLocked(st.opts.BatchSize)
+		st.mu.Unlock()
+		st.flush(batch)
+		return nil
+	}
+	defer st.mu.Unlock()
+	if err := st.enc.Encode(e); err != nil {
+		return fmt.Errorf("monigo: write event: %w", err)
+	}
+	return nil
+}
+
+// Close stops accepting events, waits for every outstanding ack to be
+// delivered and closes Acks. It returns the error that ended the stream,
+// if any.
+func (st *EventStream) Close() error {
+	st.mu.Lock()
+	if st.closed {
+		st.mu.Unlock()
+		return nil
+	}
+	st.closed = true
+	fallback := st.fallback
+	st.mu.Unlock()
+
+	if fallback {
+		close(st.stop)
+		<-st.loopDone
+		st.mu.Lock()
+		batch := st.takeLocked(1)
+		st.mu.Unlock()
+		st.flush(batch)
+		st.inflight.Wait()
+		close(st.acks)
+	} else {
+		st.pw.Close()
+		<-st.readDone
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.err
+}
+
+// readAcks decodes one EventAck per line of the response until the server
+// ends the stream.
+func (st *EventStream) readAcks(body io.ReadCloser) {
+	defer close(st.readDone)
+	defer close(st.acks)
+	defer body.Close()
+
+	sc := bufio.NewScanner(body)
+	for sc.Scan() {
+		line := sc.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var ack EventAck
+		if err := json.Unmarshal(line, &ack); err != nil {
+			st.fail(fmt.Errorf("monigo: decode ack: %w", err))
+			return
+		}
+		st.acks <- ack
+	}
+	if err := sc.Err(); err != nil {
+		st.fail(fmt.Errorf("monigo: read acks: %w", err))
+	}
+}
+
+// fail ends the session with err. In streaming mode it also closes the
+// request body, unblocking a Send stuck on a dead connection before taking
+// the lock that Send holds.
+func (st *EventStream) fail(err error) {
+	if st.pw != nil {
+		st.pw.CloseWithError(err)
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.err == nil {
+		st.err = err
+	}
+}
+
+// startFallback switches the session to batched Ingest calls flushed every
+// FlushInterval.
+func (st *EventStream) startFallback() {
+	st.fallback = true
+	st.stop = make(chan struct{})
+	st.loopDone = make(chan struct{})
+	go func() {
+		defer close(st.loopDone)
+		t := time.NewTicker(st.opts.FlushInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-st.stop:
+				return
+			case <-st.ctx.Done():
+				return
+			case <-t.C:
+				st.mu.Lock()
+				batch := st.takeLocked(1)
+				st.mu.Unlock()
+				st.flush(batch)
+			}
+		}
+	}()
+}
+
+// takeLocked removes and returns the pending events once there are at least
+// atLeast of them, registering the batch with inflight. The caller must hold
+// st.mu.
+func (st *EventStream) takeLocked(atLeast int) []IngestEvent {
+	if len(st.pending) == 0 || len(st.pending) < atLeast {
+		return nil
+	}
+	batch := st.pending
+	st.pending = nil
+	st.inflight.Add(1)
+	return batch
+}
+
+// flush sends a batch taken by takeLocked with Ingest and acks each event.
+// It runs without st.mu so a slow Acks reader cannot block Send.
+func (st *EventStream) flush(batch []IngestEvent) {
+	if batch == nil {
+		return
+	}
+	defer st.inflight.Done()
+
+	resp, err := st.svc.Ingest(st.ctx, IngestRequest{Events: batch})
+	if err != nil {
+		st.fail(err)
+		for _, e := range batch {
+			st.acks <- EventAck{IdempotencyKey: e.IdempotencyKey, Status: EventAckRejected, Error: err.Error()}
+		}
+		return
+	}
+	for _, key := range resp.Ingested {
+		st.acks <- EventAck{IdempotencyKey: key, Status: EventAckIngested}
+	}
+	for _, key := range resp.Duplicates {
+		st.acks <- EventAck{IdempotencyKey: key, Status: EventAckDuplicate}
+	}
+	for _, q := range resp.OverQuota {
+		if q.Behavior == QuotaBehaviorBlock {
+			st.acks <- EventAck{IdempotencyKey: q.IdempotencyKey, Status: EventAckRejected, Error: "quota exceeded"}
+		}
+	}
+}
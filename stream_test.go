@@ -0,0 +1,191 @@
+package monigo_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func streamEvent(key string) monigo.IngestEvent {
+	return monigo.IngestEvent{
+		EventName:      "api_call",
+		CustomerID:     "cust-1",
+		IdempotencyKey: key,
+		Timestamp:      time.Now(),
+		Properties:     map[string]any{},
+	}
+}
+
+func TestEvents_Stream_PerEventAcks(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertBearerToken(t, r)
+		if r.URL.Path == "/v1/ingest/streams" {
+			respondJSON(t, w, 201, map[string]any{"stream": map[string]any{"id": "str-1"}})
+			return
+		}
+		assertPath(t, r, "/v1/ingest/streams/str-1")
+		if got := r.Header.Get("Content-Type"); got != "application/x-ndjson" {
+			t.Errorf("expected ndjson content type, got %q", got)
+		}
+
+		rc := http.NewResponseController(w)
+		if err := rc.EnableFullDuplex(); err != nil {
+			t.Fatalf("EnableFullDuplex: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.WriteHeader(http.StatusOK)
+		rc.Flush()
+
+		dec := json.NewDecoder(r.Body)
+		enc := json.NewEncoder(w)
+		for {
+			var e monigo.IngestEvent
+			if err := dec.Decode(&e); err != nil {
+				return
+			}
+			status := monigo.EventAckIngested
+			if e.IdempotencyKey == "dup" {
+				status = monigo.EventAckDuplicate
+			}
+			enc.Encode(monigo.EventAck{IdempotencyKey: e.IdempotencyKey, Status: status})
+			rc.Flush()
+		}
+	}))
+
+	st, err := c.Events.Stream(context.Background(), monigo.StreamOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if st.Fallback() {
+		t.Fatal("expected a streaming session")
+	}
+
+	// Each ack must arrive before the next event is sent.
+	for _, key := range []string{"key-1", "dup"} {
+		if err := st.Send(streamEvent(key)); err != nil {
+			t.Fatalf("Send(%s): %v", key, err)
+		}
+		select {
+		case ack := <-st.Acks():
+			if ack.IdempotencyKey != key {
+				t.Errorf("expected ack for %s, got %s", key, ack.IdempotencyKey)
+			}
+			if key == "dup" && ack.Status != monigo.EventAckDuplicate {
+				t.Errorf("expected duplicate, got %s", ack.Status)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for ack of %s", key)
+		}
+	}
+
+	if err := st.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if _, ok := <-st.Acks(); ok {
+		t.Error("expected Acks to be closed")
+	}
+	if err := st.Send(streamEvent("late")); err != monigo.ErrStreamClosed {
+		t.Errorf("expected ErrStreamClosed, got %v", err)
+	}
+}
+
+func TestEvents_Stream_FallbackToBatches(t *testing.T) {
+	var batches int
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/ingest/streams":
+			respondError(t, w, http.StatusNotFound, "not found")
+		case "/v1/ingest":
+			batches++
+			var body monigo.IngestRequest
+			decodeBody(t, r, &body)
+			resp := map[string]any{"ingested": []string{}, "duplicates": []string{}}
+			var ingested []string
+			for _, e := range body.Events {
+				ingested = append(ingested, e.IdempotencyKey)
+			}
+			resp["ingested"] = ingested
+			respondJSON(t, w, 202, resp)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	st, err := c.Events.Stream(context.Background(), monigo.StreamOptions{BatchSize: 2, FlushInterval: time.Hour})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !st.Fallback() {
+		t.Fatal("expected fallback mode")
+	}
+	for _, key := range []string{"key-1", "key-2", "key-3"} {
+		if err := st.Send(streamEvent(key)); err != nil {
+			t.Fatalf("Send(%s): %v", key, err)
+		}
+	}
+	if err := st.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	var acks []monigo.EventAck
+	for ack := range st.Acks() {
+		acks = append(acks, ack)
+	}
+	if len(acks) != 3 {
+		t.Fatalf("expected 3 acks, got %d", len(acks))
+	}
+	for _, ack := range acks {
+		if ack.Status != monigo.EventAckIngested {
+			t.Errorf("expected ingested, got %s for %s", ack.Status, ack.IdempotencyKey)
+		}
+	}
+	if batches != 2 {
+		t.Errorf("expected 2 batches, got %d", batches)
+	}
+}
+
+func TestEvents_Stream_Unauthorized(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respondError(t, w, http.StatusUnauthorized, "invalid api key")
+	}))
+
+	_, err := c.Events.Stream(context.Background(), monigo.StreamOptions{})
+	if !monigo.IsUnauthorized(err) {
+		t.Fatalf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestEvents_Stream_FallbackReportsFailedBatch(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/ingest/streams" {
+			respondError(t, w, http.StatusNotImplemented, "streaming not supported")
+			return
+		}
+		respondError(t, w, http.StatusInternalServerError, "boom")
+	}))
+
+	st, err := c.Events.Stream(context.Background(), monigo.StreamOptions{BatchSize: 1, FlushInterval: time.Hour})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := st.Send(streamEvent("key-1")); err != nil {
+		t.Fatalf("first Send: %v", err)
+	}
+	ack := <-st.Acks()
+	if ack.Status != monigo.EventAckRejected || ack.Error == "" {
+		t.Errorf("expected rejected ack with error, got %+v", ack)
+	}
+	if err := st.Send(streamEvent("key-2")); err == nil {
+		t.Error("expected Send to report the failed batch")
+	}
+	var apiErr *monigo.APIError
+	if err := st.Close(); !errors.As(err, &apiErr) || apiErr.StatusCode != 500 {
+		t.Errorf("expected Close to return the 500, got %v", err)
+	}
+}
@@ -2,8 +2,10 @@ package monigo
 
 import (
 	"context"
-	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // SubscriptionService links customers to billing plans.
@@ -14,6 +16,9 @@ type SubscriptionService struct {
 // Create subscribes a customer to a plan. Returns a 409 Conflict error
 // (use IsConflict) if the customer already has an active subscription.
 func (s *SubscriptionService) Create(ctx context.Context, req CreateSubscriptionRequest, opts ...RequestOption) (*Subscription, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	var wrapper struct {
 		Subscription Subscription `json:"subscription"`
 	}
@@ -23,21 +28,11 @@ func (s *SubscriptionService) Create(ctx context.Context, req CreateSubscription
 	return &wrapper.Subscription, nil
 }
 
-// List returns subscriptions, optionally filtered by customer, plan, or status.
+// List returns subscriptions, optionally filtered by customer, plan, status,
+// metadata, creation time, or renewal date, sorted and paginated server-side.
 func (s *SubscriptionService) List(ctx context.Context, params ListSubscriptionsParams) (*ListSubscriptionsResponse, error) {
-	q := url.Values{}
-	if params.CustomerID != "" {
-		q.Set("customer_id", params.CustomerID)
-	}
-	if params.PlanID != "" {
-		q.Set("plan_id", params.PlanID)
-	}
-	if params.Status != "" {
-		q.Set("status", params.Status)
-	}
-
 	path := "/v1/subscriptions"
-	if len(q) > 0 {
+	if q := params.values(); len(q) > 0 {
 		path = path + "?" + q.Encode()
 	}
 
@@ -48,12 +43,39 @@ func (s *SubscriptionService) List(ctx context.Context, params ListSubscriptions
 	return &out, nil
 }
 
-// Get fetches a single subscription by its UUID.
-func (s *SubscriptionService) Get(ctx context.Context, subscriptionID string) (*Subscription, error) {
+// Get fetches a single subscription by its UUID. Pass ExpandCustomer or
+// ExpandPlan to have the related objects embedded in the result.
+func (s *SubscriptionService) Get(ctx context.Context, subscriptionID string, expand ...string) (*Subscription, error) {
 	var wrapper struct {
 		Subscription Subscription `json:"subscription"`
 	}
-	if err := s.client.do(ctx, "GET", fmt.Sprintf("/v1/subscriptions/%s", subscriptionID), nil, &wrapper); err != nil {
+	path, err := pathf("/v1/subscriptions/%s", subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(expand) > 0 {
+		path += "?" + url.Values{"expand": {strings.Join(expand, ",")}}.Encode()
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Subscription, nil
+}
+
+// Update changes a subscription's status or contract minimum. Only fields
+// set in req are sent.
+func (s *SubscriptionService) Update(ctx context.Context, subscriptionID string, req UpdateSubscriptionRequest, opts ...RequestOption) (*Subscription, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	var wrapper struct {
+		Subscription Subscription `json:"subscription"`
+	}
+	path, err := pathf("/v1/subscriptions/%s", subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "PATCH", path, req, &wrapper, opts...); err != nil {
 		return nil, err
 	}
 	return &wrapper.Subscription, nil
@@ -61,18 +83,184 @@ func (s *SubscriptionService) Get(ctx context.Context, subscriptionID string) (*
 
 // UpdateStatus changes the status of a subscription.
 // Use the SubscriptionStatusXxx constants: active, paused, canceled.
-func (s *SubscriptionService) UpdateStatus(ctx context.Context, subscriptionID, status string, opts ...RequestOption) (*Subscription, error) {
-	body := map[string]string{"status": status}
+func (s *SubscriptionService) UpdateStatus(ctx context.Context, subscriptionID string, status SubscriptionStatus, opts ...RequestOption) (*Subscription, error) {
+	body := map[string]SubscriptionStatus{"status": status}
+	var wrapper struct {
+		Subscription Subscription `json:"subscription"`
+	}
+	path, err := pathf("/v1/subscriptions/%s", subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "PATCH", path, body, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Subscription, nil
+}
+
+// Pause pauses a subscription, optionally scheduling it to resume
+// automatically at opts.ResumeAt. opts.Behavior chooses whether usage keeps
+// accruing while paused or is dropped.
+func (s *SubscriptionService) Pause(ctx context.Context, subscriptionID string, opts PauseOptions, reqOpts ...RequestOption) (*Subscription, error) {
 	var wrapper struct {
 		Subscription Subscription `json:"subscription"`
 	}
-	if err := s.client.do(ctx, "PATCH", fmt.Sprintf("/v1/subscriptions/%s", subscriptionID), body, &wrapper, opts...); err != nil {
+	path, err := pathf("/v1/subscriptions/%s/pause", subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, opts, &wrapper, reqOpts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Subscription, nil
+}
+
+// Resume reactivates a paused subscription immediately, cancelling any
+// scheduled resume.
+func (s *SubscriptionService) Resume(ctx context.Context, subscriptionID string, opts ...RequestOption) (*Subscription, error) {
+	var wrapper struct {
+		Subscription Subscription `json:"subscription"`
+	}
+	path, err := pathf("/v1/subscriptions/%s/resume", subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, nil, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Subscription, nil
+}
+
+// EndTrialNow ends the subscription's trial immediately; billing starts from
+// now. Returns a 409 error (use IsConflict) if the subscription is not in a
+// trial.
+func (s *SubscriptionService) EndTrialNow(ctx context.Context, subscriptionID string, opts ...RequestOption) (*Subscription, error) {
+	var wrapper struct {
+		Subscription Subscription `json:"subscription"`
+	}
+	path, err := pathf("/v1/subscriptions/%s/end-trial", subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, nil, &wrapper, opts...); err != nil {
 		return nil, err
 	}
 	return &wrapper.Subscription, nil
 }
 
+// AddItem attaches an add-on plan or price to the subscription. It is billed
+// from the current period onwards as separate invoice line items.
+func (s *SubscriptionService) AddItem(ctx context.Context, subscriptionID string, req AddSubscriptionItemRequest, opts ...RequestOption) (*SubscriptionItem, error) {
+	var wrapper struct {
+		Item SubscriptionItem `json:"item"`
+	}
+	path, err := pathf("/v1/subscriptions/%s/items", subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Item, nil
+}
+
+// ListItems returns the add-ons attached to the subscription.
+func (s *SubscriptionService) ListItems(ctx context.Context, subscriptionID string) (*ListSubscriptionItemsResponse, error) {
+	var out ListSubscriptionItemsResponse
+	path, err := pathf("/v1/subscriptions/%s/items", subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveItem detaches an add-on from the subscription. Usage already
+// recorded against it is billed on the next invoice.
+func (s *SubscriptionService) RemoveItem(ctx context.Context, subscriptionID, itemID string) error {
+	path, err := pathf("/v1/subscriptions/%s/items/%s", subscriptionID, itemID)
+	if err != nil {
+		return err
+	}
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 // Delete cancels and removes a subscription record.
 func (s *SubscriptionService) Delete(ctx context.Context, subscriptionID string) error {
-	return s.client.do(ctx, "DELETE", fmt.Sprintf("/v1/subscriptions/%s", subscriptionID), nil, nil)
+	path, err := pathf("/v1/subscriptions/%s", subscriptionID)
+	if err != nil {
+		return err
+	}
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// ApplyCoupon attaches a coupon to a subscription, replacing any coupon
+// already applied. The discount takes effect from the next generated invoice.
+func (s *SubscriptionService) ApplyCoupon(ctx context.Context, subscriptionID string, req ApplyCouponRequest, opts ...RequestOption) (*Subscription, error) {
+	var wrapper struct {
+		Subscription Subscription `json:"subscription"`
+	}
+	path, err := pathf("/v1/subscriptions/%s/coupon", subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Subscription, nil
+}
+
+// RemoveCoupon detaches the coupon from a subscription. Invoices already
+// generated keep their discount.
+func (s *SubscriptionService) RemoveCoupon(ctx context.Context, subscriptionID string) (*Subscription, error) {
+	var wrapper struct {
+		Subscription Subscription `json:"subscription"`
+	}
+	path, err := pathf("/v1/subscriptions/%s/coupon", subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "DELETE", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Subscription, nil
+}
+
+// values encodes p as query parameters.
+func (p ListSubscriptionsParams) values() url.Values {
+	q := url.Values{}
+	if p.CustomerID != "" {
+		q.Set("customer_id", p.CustomerID)
+	}
+	if p.PlanID != "" {
+		q.Set("plan_id", p.PlanID)
+	}
+	if p.Status != "" {
+		q.Set("status", string(p.Status))
+	}
+	setMetadataFilter(q, p.MetadataFilter)
+	if len(p.Expand) > 0 {
+		q.Set("expand", strings.Join(p.Expand, ","))
+	}
+	if p.CreatedAfter != nil {
+		q.Set("created_after", p.CreatedAfter.UTC().Format(time.RFC3339))
+	}
+	if p.CreatedBefore != nil {
+		q.Set("created_before", p.CreatedBefore.UTC().Format(time.RFC3339))
+	}
+	if p.CurrentPeriodEndBefore != nil {
+		q.Set("current_period_end_before", p.CurrentPeriodEndBefore.UTC().Format(time.RFC3339))
+	}
+	if p.Sort != "" {
+		q.Set("sort", p.Sort)
+	}
+	if p.Page > 0 {
+		q.Set("page", strconv.Itoa(p.Page))
+	}
+	if p.PerPage > 0 {
+		q.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	return q
 }
@@ -2,6 +2,7 @@ package monigo_test
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"testing"
 	"time"
@@ -128,6 +129,103 @@ func TestSubscriptions_Get(t *testing.T) {
 	}
 }
 
+func TestSubscriptions_List_RenewalWindow(t *testing.T) {
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	due := time.Date(2026, 7, 8, 0, 0, 0, 0, time.FixedZone("WAT", 3600))
+
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		want := map[string]string{
+			"created_after":             "2026-01-01T00:00:00Z",
+			"current_period_end_before": "2026-07-07T23:00:00Z",
+			"sort":                      "current_period_end",
+			"page":                      "2",
+			"per_page":                  "50",
+		}
+		for k, v := range want {
+			if q.Get(k) != v {
+				t.Errorf("%s: got %q, want %q", k, q.Get(k), v)
+			}
+		}
+		if q.Has("created_before") {
+			t.Error("created_before should not be sent")
+		}
+		respondJSON(t, w, 200, monigo.ListSubscriptionsResponse{
+			Subscriptions: []monigo.Subscription{sampleSubscription},
+			Count:         1,
+			Total:         51,
+		})
+	}))
+
+	resp, err := c.Subscriptions.List(context.Background(), monigo.ListSubscriptionsParams{
+		CreatedAfter:           &after,
+		CurrentPeriodEndBefore: &due,
+		Sort:                   "current_period_end",
+		Page:                   2,
+		PerPage:                50,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Total != 51 {
+		t.Errorf("expected total 51, got %d", resp.Total)
+	}
+}
+
+func TestSubscriptions_List_Expand(t *testing.T) {
+	expanded := sampleSubscription
+	expanded.Customer = &sampleCustomer
+	expanded.Plan = &samplePlan
+
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("expand"); got != "customer,plan" {
+			t.Errorf("expand: got %q, want customer,plan", got)
+		}
+		respondJSON(t, w, 200, monigo.ListSubscriptionsResponse{
+			Subscriptions: []monigo.Subscription{expanded},
+			Count:         1,
+		})
+	}))
+
+	resp, err := c.Subscriptions.List(context.Background(), monigo.ListSubscriptionsParams{
+		Expand: []string{monigo.ExpandCustomer, monigo.ExpandPlan},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sub := resp.Subscriptions[0]
+	if sub.Customer == nil || sub.Customer.ID != sampleCustomer.ID {
+		t.Errorf("customer not expanded: %+v", sub.Customer)
+	}
+	if sub.Plan == nil || sub.Plan.ID != samplePlan.ID {
+		t.Errorf("plan not expanded: %+v", sub.Plan)
+	}
+}
+
+func TestSubscriptions_Get_Expand(t *testing.T) {
+	expanded := sampleSubscription
+	expanded.Customer = &sampleCustomer
+
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertPath(t, r, "/v1/subscriptions/sub-1")
+		if got := r.URL.Query().Get("expand"); got != "customer" {
+			t.Errorf("expand: got %q, want customer", got)
+		}
+		respondJSON(t, w, 200, map[string]any{"subscription": expanded})
+	}))
+
+	sub, err := c.Subscriptions.Get(context.Background(), "sub-1", monigo.ExpandCustomer)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sub.Customer == nil || sub.Customer.Name != sampleCustomer.Name {
+		t.Errorf("customer not expanded: %+v", sub.Customer)
+	}
+	if sub.Plan != nil {
+		t.Errorf("plan should not be expanded, got %+v", sub.Plan)
+	}
+}
+
 func TestSubscriptions_UpdateStatus(t *testing.T) {
 	paused := sampleSubscription
 	paused.Status = monigo.SubscriptionStatusPaused
@@ -174,3 +272,244 @@ func TestSubscriptions_Get_NotFound(t *testing.T) {
 		t.Errorf("expected IsNotFound=true; err=%v", err)
 	}
 }
+
+func TestSubscriptions_Pause(t *testing.T) {
+	resumeAt := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/subscriptions/sub-1/pause")
+
+		var req monigo.PauseOptions
+		decodeBody(t, r, &req)
+		if req.ResumeAt == nil || !req.ResumeAt.Equal(resumeAt) {
+			t.Errorf("resume_at: got %v, want %v", req.ResumeAt, resumeAt)
+		}
+		if req.Behavior != monigo.PauseBehaviorDrop {
+			t.Errorf("behavior: got %q, want drop", req.Behavior)
+		}
+		sub := sampleSubscription
+		sub.Status = monigo.SubscriptionStatusPaused
+		sub.ResumeAt = req.ResumeAt
+		sub.PauseBehavior = req.Behavior
+		respondJSON(t, w, 200, map[string]any{"subscription": sub})
+	}))
+
+	sub, err := c.Subscriptions.Pause(context.Background(), "sub-1", monigo.PauseOptions{
+		ResumeAt: &resumeAt,
+		Behavior: monigo.PauseBehaviorDrop,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sub.Status != monigo.SubscriptionStatusPaused || sub.ResumeAt == nil {
+		t.Errorf("unexpected subscription: %+v", sub)
+	}
+}
+
+func TestSubscriptions_Resume(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/subscriptions/sub-1/resume")
+		respondJSON(t, w, 200, map[string]any{"subscription": sampleSubscription})
+	}))
+
+	sub, err := c.Subscriptions.Resume(context.Background(), "sub-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sub.Status != monigo.SubscriptionStatusActive {
+		t.Errorf("status: got %q, want active", sub.Status)
+	}
+}
+
+func TestSubscriptions_Create_Scheduled(t *testing.T) {
+	startAt := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req monigo.CreateSubscriptionRequest
+		decodeBody(t, r, &req)
+		if req.StartAt == nil || !req.StartAt.Equal(startAt) {
+			t.Errorf("start_at: got %v, want %v", req.StartAt, startAt)
+		}
+		if req.BackdateTo != nil {
+			t.Errorf("backdate_to should be omitted, got %v", req.BackdateTo)
+		}
+		sub := sampleSubscription
+		sub.Status = monigo.SubscriptionStatusScheduled
+		sub.ScheduledStartAt = req.StartAt
+		respondJSON(t, w, 201, map[string]any{"subscription": sub})
+	}))
+
+	sub, err := c.Subscriptions.Create(context.Background(), monigo.CreateSubscriptionRequest{
+		CustomerID: "cust-abc",
+		PlanID:     "plan-1",
+		StartAt:    &startAt,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sub.Status != monigo.SubscriptionStatusScheduled || sub.ScheduledStartAt == nil {
+		t.Errorf("unexpected subscription: %+v", sub)
+	}
+}
+
+func TestSubscriptions_Create_BillingCycleAnchor(t *testing.T) {
+	anchor := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req monigo.CreateSubscriptionRequest
+		decodeBody(t, r, &req)
+		if req.BillingCycleAnchor == nil || !req.BillingCycleAnchor.Equal(anchor) || req.ProrationBehavior != monigo.ProrationBehaviorNone {
+			t.Errorf("unexpected anchor settings: %v %q", req.BillingCycleAnchor, req.ProrationBehavior)
+		}
+		sub := sampleSubscription
+		sub.BillingCycleAnchor = req.BillingCycleAnchor
+		sub.CurrentPeriodEnd = anchor
+		respondJSON(t, w, 201, map[string]any{"subscription": sub})
+	}))
+
+	sub, err := c.Subscriptions.Create(context.Background(), monigo.CreateSubscriptionRequest{
+		CustomerID:         "cust-abc",
+		PlanID:             "plan-1",
+		BillingCycleAnchor: &anchor,
+		ProrationBehavior:  monigo.ProrationBehaviorNone,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !sub.CurrentPeriodEnd.Equal(anchor) {
+		t.Errorf("expected the first period to end on the anchor, got %v", sub.CurrentPeriodEnd)
+	}
+
+	_, err = c.Subscriptions.Create(context.Background(), monigo.CreateSubscriptionRequest{
+		CustomerID:        "cust-abc",
+		PlanID:            "plan-1",
+		ProrationBehavior: monigo.ProrationBehaviorProrate,
+	})
+	if !errors.Is(err, monigo.ErrInvalidRequest) {
+		t.Errorf("expected proration_behavior without an anchor to be rejected, got %v", err)
+	}
+}
+
+func TestSubscriptions_Create_TrialOverride(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		decodeBody(t, r, &body)
+		if got, ok := body["trial_days"]; !ok || got != float64(0) {
+			t.Errorf("trial_days: got %v (present=%v), want explicit 0", got, ok)
+		}
+		respondJSON(t, w, 201, map[string]any{"subscription": sampleSubscription})
+	}))
+
+	noTrial := int32(0)
+	_, err := c.Subscriptions.Create(context.Background(), monigo.CreateSubscriptionRequest{
+		CustomerID: "cust-abc",
+		PlanID:     "plan-1",
+		TrialDays:  &noTrial,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSubscriptions_EndTrialNow(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/subscriptions/sub-1/end-trial")
+		respondJSON(t, w, 200, map[string]any{"subscription": sampleSubscription})
+	}))
+
+	sub, err := c.Subscriptions.EndTrialNow(context.Background(), "sub-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sub.TrialEndsAt != nil {
+		t.Errorf("expected no trial end, got %v", sub.TrialEndsAt)
+	}
+}
+
+func TestSubscriptions_AddItem(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/subscriptions/sub-1/items")
+
+		var req monigo.AddSubscriptionItemRequest
+		decodeBody(t, r, &req)
+		if req.PriceID != "price-seats" || req.Quantity != 5 {
+			t.Errorf("unexpected request: %+v", req)
+		}
+		respondJSON(t, w, 201, map[string]any{"item": monigo.SubscriptionItem{
+			ID: "item-1", SubscriptionID: "sub-1", PriceID: req.PriceID, Quantity: req.Quantity,
+		}})
+	}))
+
+	item, err := c.Subscriptions.AddItem(context.Background(), "sub-1", monigo.AddSubscriptionItemRequest{
+		PriceID:  "price-seats",
+		Quantity: 5,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if item.ID != "item-1" || item.Quantity != 5 {
+		t.Errorf("unexpected item: %+v", item)
+	}
+}
+
+func TestSubscriptions_ListItems(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/subscriptions/sub-1/items")
+		respondJSON(t, w, 200, monigo.ListSubscriptionItemsResponse{
+			Items: []monigo.SubscriptionItem{{ID: "item-1", PlanID: "plan-support", Quantity: 1}},
+			Count: 1,
+		})
+	}))
+
+	resp, err := c.Subscriptions.ListItems(context.Background(), "sub-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Count != 1 || resp.Items[0].PlanID != "plan-support" {
+		t.Errorf("unexpected items: %+v", resp)
+	}
+}
+
+func TestSubscriptions_RemoveItem(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "DELETE")
+		assertPath(t, r, "/v1/subscriptions/sub-1/items/item-1")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	if err := c.Subscriptions.RemoveItem(context.Background(), "sub-1", "item-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSubscriptions_Update_MinimumAmount(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "PATCH")
+		assertPath(t, r, "/v1/subscriptions/sub-1")
+
+		var body map[string]any
+		decodeBody(t, r, &body)
+		if body["minimum_amount"] != "300000.00" {
+			t.Errorf("minimum_amount: got %v", body["minimum_amount"])
+		}
+		if _, ok := body["status"]; ok {
+			t.Error("status should be omitted when unset")
+		}
+		sub := sampleSubscription
+		sub.MinimumAmount = "300000.00"
+		respondJSON(t, w, 200, map[string]any{"subscription": sub})
+	}))
+
+	min := "300000.00"
+	sub, err := c.Subscriptions.Update(context.Background(), "sub-1", monigo.UpdateSubscriptionRequest{
+		MinimumAmount: &min,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sub.MinimumAmount != "300000.00" {
+		t.Errorf("minimum_amount: got %q", sub.MinimumAmount)
+	}
+}
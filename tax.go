@@ -0,0 +1,86 @@
+package monigo
+
+import (
+	"context"
+	"net/url"
+)
+
+// TaxService configures the VAT and withholding tax rates applied to
+// invoices. Rates are matched to customers by jurisdiction; override them on
+// a single draft with InvoiceService.SetTaxOverride.
+type TaxService struct {
+	client *Client
+}
+
+// CreateRate defines a tax rate for a jurisdiction.
+func (s *TaxService) CreateRate(ctx context.Context, req CreateTaxRateRequest, opts ...RequestOption) (*TaxRate, error) {
+	var wrapper struct {
+		TaxRate TaxRate `json:"tax_rate"`
+	}
+	if err := s.client.do(ctx, "POST", "/v1/tax-rates", req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.TaxRate, nil
+}
+
+// ListRates returns tax rates, optionally filtered by jurisdiction or type.
+func (s *TaxService) ListRates(ctx context.Context, params ListTaxRatesParams) (*ListTaxRatesResponse, error) {
+	q := url.Values{}
+	if params.Jurisdiction != "" {
+		q.Set("jurisdiction", params.Jurisdiction)
+	}
+	if params.Type != "" {
+		q.Set("type", params.Type)
+	}
+
+	path := "/v1/tax-rates"
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	var out ListTaxRatesResponse
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRate fetches a single tax rate by its UUID.
+func (s *TaxService) GetRate(ctx context.Context, taxRateID string) (*TaxRate, error) {
+	var wrapper struct {
+		TaxRate TaxRate `json:"tax_rate"`
+	}
+	path, err := pathf("/v1/tax-rates/%s", taxRateID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.TaxRate, nil
+}
+
+// UpdateRate changes a tax rate's name, percentage, or active flag.
+func (s *TaxService) UpdateRate(ctx context.Context, taxRateID string, req UpdateTaxRateRequest, opts ...RequestOption) (*TaxRate, error) {
+	var wrapper struct {
+		TaxRate TaxRate `json:"tax_rate"`
+	}
+	path, err := pathf("/v1/tax-rates/%s", taxRateID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "PUT", path, req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.TaxRate, nil
+}
+
+// DeleteRate removes a tax rate. Finalized invoices keep the tax they were
+// issued with.
+func (s *TaxService) DeleteRate(ctx context.Context, taxRateID string) error {
+	path, err := pathf("/v1/tax-rates/%s", taxRateID)
+	if err != nil {
+		return err
+	}
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
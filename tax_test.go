@@ -0,0 +1,149 @@
+package monigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+var sampleTaxRate = monigo.TaxRate{
+	ID:           "tax-1",
+	OrgID:        "org-1",
+	Name:         "VAT",
+	Type:         monigo.TaxTypeVAT,
+	Jurisdiction: "NG",
+	Percent:      "7.5",
+	Active:       true,
+	CreatedAt:    time.Now(),
+	UpdatedAt:    time.Now(),
+}
+
+func TestTax_CreateRate(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/tax-rates")
+		assertBearerToken(t, r)
+
+		var req monigo.CreateTaxRateRequest
+		decodeBody(t, r, &req)
+		if req.Jurisdiction != "NG" || req.Percent != "7.5" || req.Type != monigo.TaxTypeVAT {
+			t.Errorf("unexpected request: %+v", req)
+		}
+		respondJSON(t, w, 201, map[string]any{"tax_rate": sampleTaxRate})
+	}))
+
+	rate, err := c.Tax.CreateRate(context.Background(), monigo.CreateTaxRateRequest{
+		Name:         "VAT",
+		Type:         monigo.TaxTypeVAT,
+		Jurisdiction: "NG",
+		Percent:      "7.5",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rate.ID != "tax-1" {
+		t.Errorf("expected tax-1, got %s", rate.ID)
+	}
+}
+
+func TestTax_ListRates(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/tax-rates")
+		if got := r.URL.Query().Get("jurisdiction"); got != "NG" {
+			t.Errorf("jurisdiction: got %q, want NG", got)
+		}
+		respondJSON(t, w, 200, monigo.ListTaxRatesResponse{TaxRates: []monigo.TaxRate{sampleTaxRate}, Count: 1})
+	}))
+
+	resp, err := c.Tax.ListRates(context.Background(), monigo.ListTaxRatesParams{Jurisdiction: "NG"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Count != 1 {
+		t.Errorf("expected 1 rate, got %d", resp.Count)
+	}
+}
+
+func TestTax_GetRate(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/tax-rates/tax-1")
+		respondJSON(t, w, 200, map[string]any{"tax_rate": sampleTaxRate})
+	}))
+
+	rate, err := c.Tax.GetRate(context.Background(), "tax-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rate.Percent != "7.5" {
+		t.Errorf("percent: got %q", rate.Percent)
+	}
+}
+
+func TestTax_UpdateRate(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "PUT")
+		assertPath(t, r, "/v1/tax-rates/tax-1")
+
+		var body map[string]any
+		decodeBody(t, r, &body)
+		if body["active"] != false {
+			t.Errorf("active: got %v, want explicit false", body["active"])
+		}
+		rate := sampleTaxRate
+		rate.Active = false
+		respondJSON(t, w, 200, map[string]any{"tax_rate": rate})
+	}))
+
+	inactive := false
+	rate, err := c.Tax.UpdateRate(context.Background(), "tax-1", monigo.UpdateTaxRateRequest{Active: &inactive})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rate.Active {
+		t.Error("expected inactive rate")
+	}
+}
+
+func TestTax_DeleteRate(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "DELETE")
+		assertPath(t, r, "/v1/tax-rates/tax-1")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	if err := c.Tax.DeleteRate(context.Background(), "tax-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestInvoices_SetTaxOverride(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "PUT")
+		assertPath(t, r, "/v1/invoices/inv-1/tax")
+
+		var req monigo.InvoiceTaxOverride
+		decodeBody(t, r, &req)
+		if !req.Exempt || req.ExemptReason == "" {
+			t.Errorf("unexpected override: %+v", req)
+		}
+		inv := sampleInvoice
+		inv.TaxExempt = true
+		respondJSON(t, w, 200, map[string]any{"invoice": inv})
+	}))
+
+	inv, err := c.Invoices.SetTaxOverride(context.Background(), "inv-1", monigo.InvoiceTaxOverride{
+		Exempt:       true,
+		ExemptReason: "cert #1234",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !inv.TaxExempt {
+		t.Error("expected tax-exempt invoice")
+	}
+}
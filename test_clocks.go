@@ -0,0 +1,92 @@
+package monigo
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// TestClockService manages test clocks, which let a test-mode integration
+// fast-forward billing time. Attach a customer to a clock with
+// CreateCustomerRequest.TestClockID, then Advance the clock to roll its
+// subscriptions into the next period, end trials, and generate invoices
+// without waiting for real time to pass. Test clocks require a test-mode
+// API key.
+type TestClockService struct {
+	client *Client
+}
+
+// Create starts a new test clock frozen at req.FrozenTime.
+func (s *TestClockService) Create(ctx context.Context, req CreateTestClockRequest, opts ...RequestOption) (*TestClock, error) {
+	var wrapper struct {
+		TestClock TestClock `json:"test_clock"`
+	}
+	if err := s.client.do(ctx, "POST", "/v1/test-clocks", req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.TestClock, nil
+}
+
+// Get fetches a test clock by its ID.
+func (s *TestClockService) Get(ctx context.Context, clockID string) (*TestClock, error) {
+	var wrapper struct {
+		TestClock TestClock `json:"test_clock"`
+	}
+	path, err := pathf("/v1/test-clocks/%s", clockID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.TestClock, nil
+}
+
+// Advance moves the clock forward to to. The API processes everything that
+// falls due in between asynchronously; the returned clock is usually
+// TestClockStatusAdvancing. Use WaitForAdvance to block until it is ready.
+func (s *TestClockService) Advance(ctx context.Context, clockID string, to time.Time, opts ...RequestOption) (*TestClock, error) {
+	var wrapper struct {
+		TestClock TestClock `json:"test_clock"`
+	}
+	path, err := pathf("/v1/test-clocks/%s/advance", clockID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, AdvanceTestClockRequest{FrozenTime: to.UTC()}, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.TestClock, nil
+}
+
+// WaitForAdvance polls a test clock until it is ready and returns it. A clock
+// that fails to advance is returned together with an error wrapping
+// ErrJobFailed. opts.Progress is not used.
+func (s *TestClockService) WaitForAdvance(ctx context.Context, clockID string, opts PollOptions) (*TestClock, error) {
+	var clock *TestClock
+	err := poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		c, err := s.Get(ctx, clockID)
+		if err != nil {
+			return false, err
+		}
+		clock = c
+		switch c.Status {
+		case TestClockStatusReady:
+			return true, nil
+		case TestClockStatusFailed:
+			return true, fmt.Errorf("%w: test clock %s: %s", ErrJobFailed, clockID, c.ErrorMessage)
+		}
+		return false, nil
+	})
+	return clock, err
+}
+
+// Delete removes a test clock together with the customers, subscriptions,
+// and invoices attached to it.
+func (s *TestClockService) Delete(ctx context.Context, clockID string) error {
+	path, err := pathf("/v1/test-clocks/%s", clockID)
+	if err != nil {
+		return err
+	}
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
@@ -0,0 +1,93 @@
+package monigo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestTestClocks_Create(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/test-clocks")
+		var req monigo.CreateTestClockRequest
+		decodeBody(t, r, &req)
+		if !req.FrozenTime.Equal(start) {
+			t.Errorf("frozen_time: got %v", req.FrozenTime)
+		}
+		respondJSON(t, w, 201, map[string]any{"test_clock": monigo.TestClock{ID: "clock-1", FrozenTime: start, Status: monigo.TestClockStatusReady}})
+	}))
+
+	clock, err := c.TestClocks.Create(context.Background(), monigo.CreateTestClockRequest{Name: "trial expiry", FrozenTime: start})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if clock.ID != "clock-1" {
+		t.Errorf("unexpected clock: %+v", clock)
+	}
+}
+
+func TestTestClocks_AdvanceAndWait(t *testing.T) {
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	var polls atomic.Int32
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			assertPath(t, r, "/v1/test-clocks/clock-1/advance")
+			var req monigo.AdvanceTestClockRequest
+			decodeBody(t, r, &req)
+			if !req.FrozenTime.Equal(to) {
+				t.Errorf("frozen_time: got %v", req.FrozenTime)
+			}
+			respondJSON(t, w, 200, map[string]any{"test_clock": monigo.TestClock{ID: "clock-1", Status: monigo.TestClockStatusAdvancing}})
+		case "GET":
+			assertPath(t, r, "/v1/test-clocks/clock-1")
+			status := monigo.TestClockStatusAdvancing
+			if polls.Add(1) >= 2 {
+				status = monigo.TestClockStatusReady
+			}
+			respondJSON(t, w, 200, map[string]any{"test_clock": monigo.TestClock{ID: "clock-1", FrozenTime: to, Status: status}})
+		}
+	}))
+
+	ctx := context.Background()
+	if _, err := c.TestClocks.Advance(ctx, "clock-1", to); err != nil {
+		t.Fatalf("advance: %v", err)
+	}
+	clock, err := c.TestClocks.WaitForAdvance(ctx, "clock-1", monigo.PollOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+	if clock.Status != monigo.TestClockStatusReady || polls.Load() != 2 {
+		t.Errorf("expected ready after 2 polls, got %s after %d", clock.Status, polls.Load())
+	}
+}
+
+func TestTestClocks_WaitForAdvance_Failed(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, 200, map[string]any{"test_clock": monigo.TestClock{ID: "clock-1", Status: monigo.TestClockStatusFailed, ErrorMessage: "boom"}})
+	}))
+
+	_, err := c.TestClocks.WaitForAdvance(context.Background(), "clock-1", monigo.PollOptions{Interval: time.Millisecond})
+	if !errors.Is(err, monigo.ErrJobFailed) {
+		t.Errorf("expected ErrJobFailed, got %v", err)
+	}
+}
+
+func TestTestClocks_Delete(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "DELETE")
+		assertPath(t, r, "/v1/test-clocks/clock-1")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	if err := c.TestClocks.Delete(context.Background(), "clock-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -11,12 +11,12 @@ import (
 
 // mockServer spins up an in-process HTTP server backed by handler, creates a
 // Client pointed at it, and registers cleanup to shut down the server when the
-// test finishes.
-func mockServer(t *testing.T, handler http.Handler) *monigo.Client {
+// test finishes. Extra opts are applied after the base URL.
+func mockServer(t *testing.T, handler http.Handler, opts ...monigo.Option) *monigo.Client {
 	t.Helper()
 	srv := httptest.NewServer(handler)
 	t.Cleanup(srv.Close)
-	return monigo.New("test_key_abc", monigo.WithBaseURL(srv.URL))
+	return monigo.New("test_key_abc", append([]monigo.Option{monigo.WithBaseURL(srv.URL)}, opts...)...)
 }
 
 // respondJSON writes status and v (encoded as JSON) to w.
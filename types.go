@@ -10,12 +10,21 @@ import (
 // ---------------------------------------------------------------------------
 
 const (
-	AggregationCount   = "count"
-	AggregationSum     = "sum"
-	AggregationMax     = "max"
-	AggregationMin     = "minimum"
-	AggregationAverage = "average"
-	AggregationUnique  = "unique"
+	AggregationCount   Aggregation = "count"
+	AggregationSum     Aggregation = "sum"
+	AggregationMax     Aggregation = "max"
+	AggregationMin     Aggregation = "minimum"
+	AggregationAverage Aggregation = "average"
+	AggregationUnique  Aggregation = "unique"
+	// AggregationLatest takes the property value of the most recent event in
+	// the period, e.g. a gauge such as the current plan seat count.
+	AggregationLatest Aggregation = "latest"
+	// AggregationTimeWeighted averages the property over the period, weighting
+	// each value by how long it was in effect: a value holds from its event
+	// until the next one, and the period before the first event counts as
+	// zero. 10 seats for the first half of a month and 20 for the second bill
+	// as 15. Multiply by the period length in hours for GB-hour style units.
+	AggregationTimeWeighted Aggregation = "time_weighted"
 )
 
 // ---------------------------------------------------------------------------
@@ -24,19 +33,26 @@ const (
 
 const (
 	// PricingModelFlat charges a fixed unit_price per unit, regardless of volume.
-	PricingModelFlat = "flat_unit"
+	PricingModelFlat PricingModel = "flat_unit"
 	// PricingModelPerUnit is an alias for PricingModelFlat.
-	PricingModelPerUnit = "per_unit"
+	PricingModelPerUnit PricingModel = "per_unit"
 	// PricingModelTiered applies graduated rates: each unit is charged at the
 	// rate of the tier it falls into. Requires a []PriceTier in Tiers.
-	PricingModelTiered = "tiered"
+	PricingModelTiered PricingModel = "tiered"
 	// PricingModelPackage charges per bundle of N units. Partial bundles are
 	// rounded up. Requires a PackageConfig in Tiers.
-	PricingModelPackage = "package"
+	PricingModelPackage PricingModel = "package"
 	// PricingModelOverage includes a free quota (IncludedUnits) covered by a
 	// flat BasePrice, then charges OveragePrice per unit beyond the quota.
 	// Requires an OverageConfig in Tiers.
-	PricingModelOverage = "overage"
+	PricingModelOverage PricingModel = "overage"
+	// PricingModelVolume charges every unit at the rate of the tier the total
+	// quantity falls into. Requires a VolumeConfig in Tiers.
+	PricingModelVolume PricingModel = "volume"
+	// PricingModelWeightedTiered computes the graduated tiered amount and
+	// bills it as one line at the weighted-average unit price. Requires a
+	// WeightedTieredConfig in Tiers.
+	PricingModelWeightedTiered PricingModel = "weighted_tiered"
 )
 
 // ---------------------------------------------------------------------------
@@ -44,14 +60,37 @@ const (
 // ---------------------------------------------------------------------------
 
 const (
-	PlanTypeCollection = "collection"
-	PlanTypePayout     = "payout"
+	PlanTypeCollection PlanType = "collection"
+	PlanTypePayout     PlanType = "payout"
+
+	BillingPeriodDaily     BillingPeriod = "daily"
+	BillingPeriodWeekly    BillingPeriod = "weekly"
+	BillingPeriodMonthly   BillingPeriod = "monthly"
+	BillingPeriodQuarterly BillingPeriod = "quarterly"
+	BillingPeriodAnnually  BillingPeriod = "annually"
+
+	// TaxBehaviorExclusive adds tax on top of plan prices. This is the default.
+	TaxBehaviorExclusive = "exclusive"
+	// TaxBehaviorInclusive treats plan prices as already including tax.
+	TaxBehaviorInclusive = "inclusive"
+
+	// PlanStatusActive plans accept new subscriptions.
+	PlanStatusActive = "active"
+	// PlanStatusArchived plans keep billing existing subscriptions but reject
+	// new ones.
+	PlanStatusArchived = "archived"
+)
+
+// ---------------------------------------------------------------------------
+// Customer status constants
+// ---------------------------------------------------------------------------
 
-	BillingPeriodDaily     = "daily"
-	BillingPeriodWeekly    = "weekly"
-	BillingPeriodMonthly   = "monthly"
-	BillingPeriodQuarterly = "quarterly"
-	BillingPeriodAnnually  = "annually"
+const (
+	// CustomerStatusActive customers are invoiced normally.
+	CustomerStatusActive = "active"
+	// CustomerStatusArchived customers keep their billing history but are no
+	// longer invoiced and are hidden from List by default.
+	CustomerStatusArchived = "archived"
 )
 
 // ---------------------------------------------------------------------------
@@ -59,9 +98,37 @@ const (
 // ---------------------------------------------------------------------------
 
 const (
-	SubscriptionStatusActive   = "active"
-	SubscriptionStatusPaused   = "paused"
-	SubscriptionStatusCanceled = "canceled"
+	SubscriptionStatusActive   SubscriptionStatus = "active"
+	SubscriptionStatusPaused   SubscriptionStatus = "paused"
+	SubscriptionStatusCanceled SubscriptionStatus = "canceled"
+	// SubscriptionStatusScheduled subscriptions have a future StartAt and
+	// become active on ScheduledStartAt.
+	SubscriptionStatusScheduled SubscriptionStatus = "scheduled"
+)
+
+// Related objects that subscription reads can embed with Expand.
+const (
+	ExpandCustomer = "customer"
+	ExpandPlan     = "plan"
+)
+
+// Pause behaviours for PauseOptions.Behavior.
+const (
+	// PauseBehaviorAccrue keeps recording usage while paused; it is billed on
+	// the first invoice after the subscription resumes.
+	PauseBehaviorAccrue = "accrue"
+	// PauseBehaviorDrop discards usage events received while paused.
+	PauseBehaviorDrop = "drop"
+)
+
+// Proration behaviours for CreateSubscriptionRequest.ProrationBehavior.
+const (
+	// ProrationBehaviorProrate bills the partial first period up to the
+	// billing cycle anchor at its share of a full period: the plan's
+	// MinimumAmount is scaled by Invoice.ProrationFactor.
+	ProrationBehaviorProrate ProrationBehavior = "prorate"
+	// ProrationBehaviorNone bills the partial first period like a full one.
+	ProrationBehaviorNone ProrationBehavior = "none"
 )
 
 // ---------------------------------------------------------------------------
@@ -69,10 +136,33 @@ const (
 // ---------------------------------------------------------------------------
 
 const (
-	InvoiceStatusDraft     = "draft"
-	InvoiceStatusFinalized = "finalized"
-	InvoiceStatusPaid      = "paid"
-	InvoiceStatusVoid      = "void"
+	InvoiceStatusDraft     InvoiceStatus = "draft"
+	InvoiceStatusFinalized InvoiceStatus = "finalized"
+	InvoiceStatusPaid      InvoiceStatus = "paid"
+	InvoiceStatusVoid      InvoiceStatus = "void"
+)
+
+// Payment terms set how long after finalization an invoice falls due.
+const (
+	PaymentTermsDueOnReceipt = "due_on_receipt"
+	PaymentTermsNet7         = "net_7"
+	PaymentTermsNet15        = "net_15"
+	PaymentTermsNet30        = "net_30"
+	PaymentTermsNet60        = "net_60"
+)
+
+// Dunning statuses track collection of unpaid invoices.
+const (
+	// DunningStatusNone means the invoice is not overdue.
+	DunningStatusNone = "none"
+	// DunningStatusActive means the invoice is overdue and reminders or
+	// retries are in progress.
+	DunningStatusActive = "active"
+	// DunningStatusRecovered means an overdue invoice was eventually paid.
+	DunningStatusRecovered = "recovered"
+	// DunningStatusExhausted means every reminder and retry was used without
+	// payment.
+	DunningStatusExhausted = "exhausted"
 )
 
 // ---------------------------------------------------------------------------
@@ -80,8 +170,32 @@ const (
 // ---------------------------------------------------------------------------
 
 const (
-	PayoutMethodBankTransfer  = "bank_transfer"
-	PayoutMethodMobileMoney   = "mobile_money"
+	PayoutMethodBankTransfer PayoutMethod = "bank_transfer"
+	PayoutMethodMobileMoney  PayoutMethod = "mobile_money"
+)
+
+// ---------------------------------------------------------------------------
+// Payment method type constants
+// ---------------------------------------------------------------------------
+
+const (
+	// PaymentMethodTypeCard is a reusable card authorization.
+	PaymentMethodTypeCard PaymentMethodType = "card"
+	// PaymentMethodTypeBankDebit is a direct-debit mandate on a bank account.
+	PaymentMethodTypeBankDebit PaymentMethodType = "bank_debit"
+)
+
+// ---------------------------------------------------------------------------
+// Locale constants
+// ---------------------------------------------------------------------------
+
+const (
+	// LocaleEnglish renders invoices in English. This is the default.
+	LocaleEnglish = "en"
+	// LocaleFrench renders invoices in French.
+	LocaleFrench = "fr"
+	// LocaleSwahili renders invoices in Swahili.
+	LocaleSwahili = "sw"
 )
 
 // ---------------------------------------------------------------------------
@@ -103,6 +217,14 @@ type IngestEvent struct {
 	// Properties is an arbitrary map of key-value pairs attached to the event.
 	// Use this for dimensions like endpoint, region, tier, etc.
 	Properties map[string]any `json:"properties"`
+	// Sequence is an optional per-customer, monotonically increasing number
+	// starting at 1. When set, the server records it so missing ranges can be
+	// found with EventService.ListSequenceGaps. Zero means unsequenced.
+	Sequence int64 `json:"sequence,omitempty"`
+	// IsTest marks the event as test data, excluded from live usage and
+	// invoices. Events.Ingest sets it on every event when the client is in
+	// test mode.
+	IsTest bool `json:"is_test,omitempty"`
 }
 
 // IngestRequest is the body sent to POST /v1/ingest.
@@ -117,6 +239,145 @@ type IngestResponse struct {
 	// Duplicates contains the IdempotencyKeys of events that were skipped
 	// because they were already ingested.
 	Duplicates []string `json:"duplicates"`
+	// OverQuota lists the events that took a subscription past one of its
+	// quotas. Events over a QuotaBehaviorBlock quota were rejected and
+	// appear in neither Ingested nor Duplicates; the others were ingested.
+	OverQuota []OverQuotaEvent `json:"over_quota,omitempty"`
+}
+
+// Blocked reports whether the event with the given IdempotencyKey was
+// rejected by a QuotaBehaviorBlock quota. Use it to cut off access as soon
+// as a customer runs out.
+func (r *IngestResponse) Blocked(idempotencyKey string) bool {
+	for _, q := range r.OverQuota {
+		if q.IdempotencyKey == idempotencyKey && q.Behavior == QuotaBehaviorBlock {
+			return true
+		}
+	}
+	return false
+}
+
+// Diagnostic codes reported by EventService.Validate.
+const (
+	// EventDiagnosticUnknownCustomer means CustomerID matches no customer.
+	EventDiagnosticUnknownCustomer = "unknown_customer"
+	// EventDiagnosticUnmatchedMetric means no metric counts events with
+	// this EventName, so the event would be stored but never billed.
+	EventDiagnosticUnmatchedMetric = "unmatched_metric"
+	// EventDiagnosticTimestampOutsideWindow means the timestamp is older
+	// than the replay window or too far in the future.
+	EventDiagnosticTimestampOutsideWindow = "timestamp_outside_window"
+	// EventDiagnosticInvalidProperty means a property failed a metric's
+	// PropertyRules.
+	EventDiagnosticInvalidProperty = "invalid_property"
+	// EventDiagnosticDuplicate means the IdempotencyKey was already ingested.
+	EventDiagnosticDuplicate = "duplicate"
+)
+
+// EventIssue is one problem found with an event during validation.
+type EventIssue struct {
+	// Code is one of the EventDiagnosticXxx constants.
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// Property names the offending property for EventDiagnosticInvalidProperty.
+	Property string `json:"property,omitempty"`
+}
+
+// EventDiagnostic is the validation result for one event.
+type EventDiagnostic struct {
+	// Index is the event's position in the request.
+	Index          int    `json:"index"`
+	IdempotencyKey string `json:"idempotency_key"`
+	// Valid is true when the event would be ingested and billed. Warnings
+	// such as EventDiagnosticDuplicate may still appear in Issues.
+	Valid bool `json:"valid"`
+	// MatchedMetricIDs lists the metrics that would count the event.
+	MatchedMetricIDs []string     `json:"matched_metric_ids"`
+	Issues           []EventIssue `json:"issues,omitempty"`
+}
+
+// ValidateEventsResponse is returned by POST /v1/ingest/validate.
+type ValidateEventsResponse struct {
+	Results      []EventDiagnostic `json:"results"`
+	ValidCount   int               `json:"valid_count"`
+	InvalidCount int               `json:"invalid_count"`
+}
+
+// SequenceGapsParams are the query parameters for GET /v1/events/sequence-gaps.
+type SequenceGapsParams struct {
+	// CustomerID restricts gap detection to one customer. Optional.
+	CustomerID string
+	// From is the inclusive lower bound on event timestamps. Optional.
+	From *time.Time
+	// To is the exclusive upper bound on event timestamps. Optional.
+	To *time.Time
+}
+
+// SequenceGap is a contiguous range of sequence numbers that have not been
+// received for a customer.
+type SequenceGap struct {
+	CustomerID string `json:"customer_id"`
+	// FromSequence is the first missing sequence number (inclusive).
+	FromSequence int64 `json:"from_sequence"`
+	// ToSequence is the last missing sequence number (inclusive).
+	ToSequence int64 `json:"to_sequence"`
+	// DetectedAt is when the server first observed the gap.
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+// ListSequenceGapsResponse is returned by GET /v1/events/sequence-gaps.
+type ListSequenceGapsResponse struct {
+	Gaps  []SequenceGap `json:"gaps"`
+	Count int           `json:"count"`
+}
+
+// Event is a raw usage event as stored by Monigo, before aggregation.
+type Event struct {
+	ID             string         `json:"id"`
+	CustomerID     string         `json:"customer_id"`
+	EventName      string         `json:"event_name"`
+	IdempotencyKey string         `json:"idempotency_key"`
+	Timestamp      time.Time      `json:"timestamp"`
+	Properties     map[string]any `json:"properties"`
+	Sequence       int64          `json:"sequence,omitempty"`
+	// IngestedAt is when the server received the event.
+	IngestedAt time.Time `json:"ingested_at"`
+	IsTest     bool      `json:"is_test"`
+}
+
+// AmendEventRequest is the body for PATCH /v1/events/{idempotency_key}.
+type AmendEventRequest struct {
+	// Properties replaces the event's properties entirely.
+	Properties map[string]any `json:"properties"`
+}
+
+// ListEventsParams are the optional query parameters for GET /v1/events.
+type ListEventsParams struct {
+	CustomerID string
+	EventName  string
+	// From is the inclusive lower bound on event timestamps.
+	From *time.Time
+	// To is the exclusive upper bound on event timestamps.
+	To *time.Time
+	// Filter restricts events by property, e.g.
+	// monigo.Where("properties.region").Eq("lagos").
+	Filter Filter
+	// IsTest, when set, restricts the list to test (true) or live (false)
+	// events. Nil returns both.
+	IsTest *bool
+	// Cursor continues a previous listing; pass the NextCursor of the last
+	// page.
+	Cursor string
+	// Limit is the page size. Zero uses the server default.
+	Limit int
+}
+
+// ListEventsResponse is returned by GET /v1/events.
+type ListEventsResponse struct {
+	Events []Event `json:"events"`
+	Count  int     `json:"count"`
+	// NextCursor fetches the following page. Empty on the last page.
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // ---------------------------------------------------------------------------
@@ -131,10 +392,23 @@ type Customer struct {
 	Name       string          `json:"name"`
 	Email      string          `json:"email"`
 	// Phone is the customer's phone number in E.164 format (e.g. +2348012345678).
-	Phone      string          `json:"phone"`
-	Metadata   json.RawMessage `json:"metadata,omitempty"`
-	CreatedAt  time.Time       `json:"created_at"`
-	UpdatedAt  time.Time       `json:"updated_at"`
+	Phone string `json:"phone"`
+	// Locale controls the language, date, and number formatting of the
+	// customer's invoices and PDFs. See the LocaleXxx constants.
+	Locale   string   `json:"locale,omitempty"`
+	Metadata Metadata `json:"metadata,omitempty"`
+	// Status is CustomerStatusActive or CustomerStatusArchived.
+	Status string `json:"status,omitempty"`
+	// ArchivedAt is set while the customer is archived.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// TestClockID is the test clock the customer's billing runs on, if any.
+	TestClockID string `json:"test_clock_id,omitempty"`
+	// ParentCustomerID is the parent account of a child customer. When the
+	// parent's BillingSettings.ConsolidateChildren is set, the child's usage
+	// is billed on the parent's invoice.
+	ParentCustomerID string    `json:"parent_customer_id,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
 }
 
 // CreateCustomerRequest is the body for POST /v1/customers.
@@ -147,8 +421,19 @@ type CreateCustomerRequest struct {
 	Email string `json:"email,omitempty"`
 	// Phone is the customer's phone number in E.164 format (e.g. +2348012345678). Optional.
 	Phone string `json:"phone,omitempty"`
-	// Metadata is an optional JSON blob of arbitrary data.
-	Metadata json.RawMessage `json:"metadata,omitempty"`
+	// Locale sets the language used for line-item descriptions, dates, and
+	// number formats on the customer's invoices. Use the LocaleXxx constants.
+	// Defaults to "en".
+	Locale string `json:"locale,omitempty"`
+	// Metadata is optional arbitrary key-value data.
+	Metadata Metadata `json:"metadata,omitempty"`
+	// TestClockID attaches the customer, and every subscription created for
+	// it, to a test clock. Test mode only.
+	TestClockID string `json:"test_clock_id,omitempty"`
+	// ParentCustomerID makes the customer a child of another customer.
+	// Hierarchies are one level deep: the parent must not itself have a
+	// parent.
+	ParentCustomerID string `json:"parent_customer_id,omitempty"`
 }
 
 // UpdateCustomerRequest is the body for PUT /v1/customers/{id}.
@@ -157,31 +442,190 @@ type UpdateCustomerRequest struct {
 	Name     string          `json:"name,omitempty"`
 	Email    string          `json:"email,omitempty"`
 	// Phone is the customer's phone number in E.164 format (e.g. +2348012345678). Optional.
-	Phone    string          `json:"phone,omitempty"`
-	Metadata json.RawMessage `json:"metadata,omitempty"`
+	Phone string `json:"phone,omitempty"`
+	// Locale changes the language of future invoices. Use the LocaleXxx constants.
+	Locale string `json:"locale,omitempty"`
+	// Metadata replaces the customer's metadata when non-nil. Point it at
+	// an empty Metadata to remove all keys.
+	Metadata *Metadata `json:"metadata,omitempty"`
+	// ParentCustomerID moves the customer under a parent. Set it to a
+	// pointer to "" to detach the customer from its parent.
+	ParentCustomerID *string `json:"parent_customer_id,omitempty"`
+}
+
+// ListCustomersParams are optional query parameters for GET /v1/customers.
+// All set fields must match.
+type ListCustomersParams struct {
+	// Query is a free-text search over name, email, and external ID.
+	Query string
+	// Email filters to customers with this exact email address.
+	Email string
+	// ExternalID filters to the customer with this external ID.
+	ExternalID string
+	// ParentCustomerID filters to the children of this customer.
+	ParentCustomerID string
+	// CreatedAfter is the inclusive lower bound on creation time.
+	CreatedAfter *time.Time
+	// CreatedBefore is the exclusive upper bound on creation time.
+	CreatedBefore *time.Time
+	// MetadataFilter matches customers whose metadata has every given
+	// key set to the given value.
+	MetadataFilter map[string]string
+	// Page is the 1-based page number. Zero means the first page.
+	Page int
+	// PerPage is the page size. Zero uses the server default.
+	PerPage int
+	// IncludeArchived also returns archived customers, which are omitted by
+	// default.
+	IncludeArchived bool
 }
 
 // ListCustomersResponse is returned by GET /v1/customers.
 type ListCustomersResponse struct {
 	Customers []Customer `json:"customers"`
-	Count     int        `json:"count"`
+	// Count is the number of customers in this response.
+	Count int `json:"count"`
+	// Total is the number of customers matching the filters across all
+	// pages. It is only set when the request was paginated.
+	Total int `json:"total,omitempty"`
+}
+
+// CustomerOverview is a customer together with the billing state support
+// tooling usually needs alongside it. It is returned by
+// CustomerService.Overview.
+type CustomerOverview struct {
+	Customer Customer
+	// Subscriptions are the customer's active subscriptions.
+	Subscriptions []Subscription
+	// Usage holds the customer's rollups for the current billing period.
+	Usage []UsageRollup
+	// OpenInvoices are the customer's finalized, unpaid invoices.
+	OpenInvoices []Invoice
+	// DefaultPayoutAccount is the account marked IsDefault, or nil if the
+	// customer has none.
+	DefaultPayoutAccount *PayoutAccount
+}
+
+// ---------------------------------------------------------------------------
+// Customer billing settings
+// ---------------------------------------------------------------------------
+
+const (
+	// InvoiceDeliveryEmail emails invoices to the customer (and any CC addresses).
+	InvoiceDeliveryEmail = "email"
+	// InvoiceDeliveryPortal publishes invoices to the customer portal only.
+	InvoiceDeliveryPortal = "portal"
+	// InvoiceDeliveryNone does not deliver invoices; you send them yourself.
+	InvoiceDeliveryNone = "none"
+)
+
+// BillingSettings are per-customer overrides of how invoices are produced
+// and delivered.
+type BillingSettings struct {
+	CustomerID string `json:"customer_id"`
+	// ConsolidateSubscriptions combines all of the customer's subscriptions
+	// into a single invoice per period instead of one per subscription.
+	ConsolidateSubscriptions bool `json:"consolidate_subscriptions"`
+	// ConsolidateChildren bills the usage of the customer's child accounts
+	// on the customer's own invoice, one line per child and metric, instead
+	// of invoicing each child separately.
+	ConsolidateChildren bool `json:"consolidate_children"`
+	// InvoiceDayOfMonth is the day (1–28) invoices are generated on. Zero
+	// means invoices follow each subscription's own billing period.
+	InvoiceDayOfMonth int `json:"invoice_day_of_month,omitempty"`
+	// DeliveryChannel is one of the InvoiceDeliveryXxx constants.
+	DeliveryChannel string `json:"delivery_channel"`
+	// CCEmails receive a copy of every invoice sent to the customer.
+	CCEmails  []string  `json:"cc_emails,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// UpdateBillingSettingsRequest is the body for PUT /v1/customers/{id}/billing-settings.
+// Nil and empty fields are left unchanged.
+type UpdateBillingSettingsRequest struct {
+	ConsolidateSubscriptions *bool `json:"consolidate_subscriptions,omitempty"`
+	ConsolidateChildren      *bool `json:"consolidate_children,omitempty"`
+	// InvoiceDayOfMonth must be between 1 and 28; set it to 0 to clear the override.
+	InvoiceDayOfMonth *int   `json:"invoice_day_of_month,omitempty"`
+	DeliveryChannel   string `json:"delivery_channel,omitempty"`
+	// CCEmails replaces the full CC list when non-nil. Point it at an empty
+	// slice to clear it.
+	CCEmails *[]string `json:"cc_emails,omitempty"`
 }
 
 // ---------------------------------------------------------------------------
 // Metric types
 // ---------------------------------------------------------------------------
 
+// Property types for PropertyRule.Type.
+const (
+	PropertyTypeNumber  = "number"
+	PropertyTypeString  = "string"
+	PropertyTypeBoolean = "boolean"
+)
+
+// PropertyRule declares the expected type of one event property and how
+// values in other formats are coerced into it. The server applies the rules
+// at ingest; Metric.CoerceProperties applies the same rules client-side.
+type PropertyRule struct {
+	// Property is the IngestEvent.Properties key the rule applies to.
+	Property string `json:"property"`
+	// Type is one of the PropertyTypeXxx constants.
+	Type string `json:"type"`
+	// Required rejects events that do not carry the property.
+	Required bool `json:"required,omitempty"`
+	// Coerce converts values of the wrong type where the conversion is
+	// unambiguous, e.g. the string "12.5" to the number 12.5. Without it
+	// such values are rejected.
+	Coerce bool `json:"coerce,omitempty"`
+	// Scale multiplies number values after coercion, e.g. 0.001 to convert
+	// milliseconds to seconds. Zero means no scaling.
+	Scale float64 `json:"scale,omitempty"`
+}
+
 // Metric defines what usage is counted and how.
 type Metric struct {
-	ID                  string    `json:"id"`
-	OrgID               string    `json:"org_id"`
-	Name                string    `json:"name"`
-	EventName           string    `json:"event_name"`
-	Aggregation         string    `json:"aggregation"`
-	AggregationProperty string    `json:"aggregation_property,omitempty"`
-	Description         string    `json:"description,omitempty"`
-	CreatedAt           time.Time `json:"created_at"`
-	UpdatedAt           time.Time `json:"updated_at"`
+	ID                  string      `json:"id"`
+	OrgID               string      `json:"org_id"`
+	Name                string      `json:"name"`
+	EventName           string      `json:"event_name"`
+	Aggregation         Aggregation `json:"aggregation"`
+	AggregationProperty string      `json:"aggregation_property,omitempty"`
+	Description         string      `json:"description,omitempty"`
+	// PropertyRules declare the expected property types for this metric's
+	// events. See CoerceProperties.
+	PropertyRules []PropertyRule `json:"property_rules,omitempty"`
+	// Filters restrict the metric to events matching every filter.
+	Filters []MetricFilter `json:"filters,omitempty"`
+	// GroupBy lists the property keys usage is split by. Rollups carry the
+	// values in UsageRollup.Dimensions.
+	GroupBy   []string  `json:"group_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Operators for MetricFilter.
+const (
+	MetricFilterEq     = "eq"
+	MetricFilterNeq    = "neq"
+	MetricFilterGt     = "gt"
+	MetricFilterGte    = "gte"
+	MetricFilterLt     = "lt"
+	MetricFilterLte    = "lte"
+	MetricFilterIn     = "in"
+	MetricFilterExists = "exists"
+)
+
+// MetricFilter is one condition an event must meet to count toward a metric,
+// e.g. MetricFilter{Property: "region", Operator: MetricFilterEq, Value: "eu"}.
+type MetricFilter struct {
+	// Property is the IngestEvent.Properties key to test.
+	Property string `json:"property"`
+	// Operator is one of the MetricFilterXxx constants.
+	Operator string `json:"operator"`
+	// Value is compared with the property. MetricFilterIn takes a slice;
+	// MetricFilterExists takes no value.
+	Value any `json:"value,omitempty"`
 }
 
 // CreateMetricRequest is the body for POST /v1/metrics.
@@ -192,21 +636,67 @@ type CreateMetricRequest struct {
 	EventName string `json:"event_name"`
 	// Aggregation determines how events are counted.
 	// Use the AggregationXxx constants: count, sum, max, minimum, average, unique.
-	Aggregation string `json:"aggregation"`
+	Aggregation Aggregation `json:"aggregation"`
 	// Description is optional documentation.
 	Description string `json:"description,omitempty"`
 	// AggregationProperty is the Properties key whose value is used for
 	// sum/max/min/average aggregations.
 	AggregationProperty string `json:"aggregation_property,omitempty"`
+	// PropertyRules optionally declare property types and coercions.
+	PropertyRules []PropertyRule `json:"property_rules,omitempty"`
+	// Filters optionally restrict the metric to matching events, e.g. only
+	// events where region is "eu".
+	Filters []MetricFilter `json:"filters,omitempty"`
+	// GroupBy optionally splits usage by property values, e.g. "endpoint".
+	GroupBy []string `json:"group_by,omitempty"`
 }
 
 // UpdateMetricRequest is the body for PUT /v1/metrics/{id}.
 type UpdateMetricRequest struct {
-	Name                string `json:"name,omitempty"`
-	EventName           string `json:"event_name,omitempty"`
-	Aggregation         string `json:"aggregation,omitempty"`
-	Description         string `json:"description,omitempty"`
-	AggregationProperty string `json:"aggregation_property,omitempty"`
+	Name                string      `json:"name,omitempty"`
+	EventName           string      `json:"event_name,omitempty"`
+	Aggregation         Aggregation `json:"aggregation,omitempty"`
+	Description         string      `json:"description,omitempty"`
+	AggregationProperty string      `json:"aggregation_property,omitempty"`
+	// PropertyRules replaces the metric's rules when non-empty.
+	PropertyRules []PropertyRule `json:"property_rules,omitempty"`
+	// Filters replaces the metric's filters when non-empty.
+	Filters []MetricFilter `json:"filters,omitempty"`
+	// GroupBy replaces the metric's dimensions when non-empty.
+	GroupBy []string `json:"group_by,omitempty"`
+}
+
+// PreviewMetricRequest is the body for POST /v1/metrics/preview.
+type PreviewMetricRequest struct {
+	// Metric is the prospective definition to evaluate. Nothing is saved.
+	Metric CreateMetricRequest `json:"metric"`
+	// WindowSeconds is how far back from now to read raw events.
+	WindowSeconds int64 `json:"window_seconds"`
+}
+
+// MetricPreview is what a prospective metric would have measured over the
+// preview window.
+type MetricPreview struct {
+	WindowStart time.Time `json:"window_start"`
+	WindowEnd   time.Time `json:"window_end"`
+	// EventsScanned is the number of events with the metric's EventName in
+	// the window; EventsMatched is how many of those passed its Filters.
+	EventsScanned int64 `json:"events_scanned"`
+	EventsMatched int64 `json:"events_matched"`
+	// Samples are rollup values for a sample of customers (and dimension
+	// values, for grouped metrics).
+	Samples []MetricPreviewSample `json:"samples"`
+	// Warnings flag likely mistakes, such as an AggregationProperty that is
+	// missing or non-numeric on most events.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// MetricPreviewSample is one customer's usage in a MetricPreview.
+type MetricPreviewSample struct {
+	CustomerID string            `json:"customer_id"`
+	Dimensions map[string]string `json:"dimensions,omitempty"`
+	Value      float64           `json:"value"`
+	EventCount int64             `json:"event_count"`
 }
 
 // ListMetricsResponse is returned by GET /v1/metrics.
@@ -239,8 +729,15 @@ type PackageConfig struct {
 	// PackagePrice is the price per complete bundle, as a 6-decimal string.
 	PackagePrice string `json:"package_price"`
 	// RoundUpPartialBlock controls whether partial bundles are rounded up
-	// (true) or down/truncated (false). Defaults to true.
-	RoundUpPartialBlock bool `json:"round_up_partial_block"`
+	// (true) or down/truncated (false). Nil leaves it to the default of
+	// true; use RoundsUp to read the effective value.
+	RoundUpPartialBlock *bool `json:"round_up_partial_block,omitempty"`
+}
+
+// RoundsUp reports whether a partial bundle is billed as a whole one,
+// applying the default when RoundUpPartialBlock is nil.
+func (c PackageConfig) RoundsUp() bool {
+	return c.RoundUpPartialBlock == nil || *c.RoundUpPartialBlock
 }
 
 // OverageConfig is the price configuration for PricingModelOverage.
@@ -258,12 +755,23 @@ type OverageConfig struct {
 	OveragePrice string `json:"overage_price"`
 }
 
+// VolumeConfig is the price configuration for PricingModelVolume. The tiers
+// have the same shape as for PricingModelTiered, but the whole quantity is
+// charged at the UnitAmount of the single tier it falls into. Marshal it to
+// JSON and set it as CreatePriceRequest.Tiers.
+type VolumeConfig []PriceTier
+
+// WeightedTieredConfig is the price configuration for
+// PricingModelWeightedTiered. Marshal it to JSON and set it as
+// CreatePriceRequest.Tiers.
+type WeightedTieredConfig []PriceTier
+
 // CreatePriceRequest describes one price to attach to a plan.
 type CreatePriceRequest struct {
 	// MetricID is the UUID of the metric this price is based on.
 	MetricID string `json:"metric_id"`
 	// Model is the pricing model. Use PricingModelXxx constants.
-	Model string `json:"model"`
+	Model PricingModel `json:"model"`
 	// UnitPrice is the flat price per unit for PricingModelFlat / PricingModelPerUnit.
 	// Express as a 6-decimal string, e.g. "2.500000".
 	UnitPrice string `json:"unit_price,omitempty"`
@@ -272,6 +780,11 @@ type CreatePriceRequest struct {
 	//   • PricingModelPackage → json.Marshal(PackageConfig{...})
 	//   • PricingModelOverage → json.Marshal(OverageConfig{...})
 	Tiers json.RawMessage `json:"tiers,omitempty"`
+	// Currency prices the metric in a currency other than the plan's. A plan
+	// may carry one price per metric per currency; each subscription picks
+	// one with CreateSubscriptionRequest.Currency. Defaults to the plan
+	// currency.
+	Currency string `json:"currency,omitempty"`
 }
 
 // UpdatePriceRequest describes an updated price for a plan.
@@ -279,36 +792,70 @@ type UpdatePriceRequest struct {
 	// ID is the UUID of the price to update. Omit to add a new price.
 	ID        string          `json:"id,omitempty"`
 	MetricID  string          `json:"metric_id,omitempty"`
-	Model     string          `json:"model,omitempty"`
+	Model     PricingModel    `json:"model,omitempty"`
 	UnitPrice string          `json:"unit_price,omitempty"`
 	Tiers     json.RawMessage `json:"tiers,omitempty"`
+	Currency  string          `json:"currency,omitempty"`
 }
 
 // Price is a pricing rule attached to a plan.
+// Use TieredConfig, PackageConfig, or OverageConfig to decode Tiers according
+// to Model instead of unmarshalling it by hand.
 type Price struct {
 	ID        string          `json:"id"`
 	PlanID    string          `json:"plan_id"`
 	MetricID  string          `json:"metric_id"`
-	Model     string          `json:"model"`
+	Model     PricingModel    `json:"model"`
 	UnitPrice string          `json:"unit_price"`
 	Tiers     json.RawMessage `json:"tiers,omitempty"`
-	CreatedAt time.Time       `json:"created_at"`
-	UpdatedAt time.Time       `json:"updated_at"`
+	// Currency is the price's currency; empty means the plan currency.
+	Currency  string    `json:"currency,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CommissionRule is the platform's take-rate on a payout plan: Monigo
+// withholds Percent of the metered payout amount and shows the split on each
+// payout slip.
+type CommissionRule struct {
+	// Percent is the share withheld, as a decimal string (e.g. "12.5").
+	Percent string `json:"percent"`
+	// MetricIDs restricts the commission to the amounts metered by these
+	// metrics. Empty applies it to every price on the plan.
+	MetricIDs []string `json:"metric_ids,omitempty"`
+	// MinAmount is the minimum commission per payout slip. Optional.
+	MinAmount string `json:"min_amount,omitempty"`
+	// MaxAmount caps the commission per payout slip. Optional.
+	MaxAmount string `json:"max_amount,omitempty"`
 }
 
 // Plan is a billing plan that defines pricing for one or more metrics.
 type Plan struct {
-	ID              string    `json:"id"`
-	OrgID           string    `json:"org_id"`
-	Name            string    `json:"name"`
-	Description     string    `json:"description,omitempty"`
-	Currency        string    `json:"currency"`
-	PlanType        string    `json:"plan_type"`
-	BillingPeriod   string    `json:"billing_period"`
-	TrialPeriodDays int32     `json:"trial_period_days"`
-	Prices          []Price   `json:"prices,omitempty"`
-	CreatedAt       time.Time `json:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at"`
+	ID              string        `json:"id"`
+	OrgID           string        `json:"org_id"`
+	Name            string        `json:"name"`
+	Description     string        `json:"description,omitempty"`
+	Currency        string        `json:"currency"`
+	PlanType        PlanType      `json:"plan_type"`
+	BillingPeriod   BillingPeriod `json:"billing_period"`
+	TrialPeriodDays int32         `json:"trial_period_days"`
+	Prices          []Price       `json:"prices,omitempty"`
+	// Commission is the take-rate applied by a payout plan. Nil for
+	// collection plans and payout plans without a commission.
+	Commission *CommissionRule `json:"commission,omitempty"`
+	// MinimumAmount is the spend floor per billing period, as a decimal
+	// string. Empty means no minimum.
+	MinimumAmount string `json:"minimum_amount,omitempty"`
+	// TaxBehavior is TaxBehaviorExclusive or TaxBehaviorInclusive.
+	TaxBehavior string `json:"tax_behavior,omitempty"`
+	// Status is PlanStatusActive or PlanStatusArchived.
+	Status string `json:"status,omitempty"`
+	// ArchivedAt is set while the plan is archived.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// Metadata is arbitrary key-value data set on the plan.
+	Metadata  Metadata  `json:"metadata,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 // CreatePlanRequest is the body for POST /v1/plans.
@@ -321,12 +868,23 @@ type CreatePlanRequest struct {
 	Currency string `json:"currency,omitempty"`
 	// PlanType is either "collection" (billing customers) or "payout" (paying out to vendors).
 	// Defaults to "collection".
-	PlanType string `json:"plan_type,omitempty"`
+	PlanType PlanType `json:"plan_type,omitempty"`
 	// BillingPeriod controls the invoice cadence. Use BillingPeriodXxx constants.
 	// Defaults to "monthly".
-	BillingPeriod string `json:"billing_period,omitempty"`
+	BillingPeriod BillingPeriod `json:"billing_period,omitempty"`
 	// Prices is an optional list of pricing rules to attach immediately.
 	Prices []CreatePriceRequest `json:"prices,omitempty"`
+	// Commission sets a take-rate on a payout plan. Only valid when PlanType
+	// is "payout".
+	Commission *CommissionRule `json:"commission,omitempty"`
+	// MinimumAmount sets a spend floor per billing period, e.g. "50000.00".
+	// Invoices below it get a minimum commitment line item.
+	MinimumAmount string `json:"minimum_amount,omitempty"`
+	// TaxBehavior says whether prices include tax. Use TaxBehaviorXxx.
+	// Defaults to TaxBehaviorExclusive.
+	TaxBehavior string `json:"tax_behavior,omitempty"`
+	// Metadata is optional arbitrary key-value data.
+	Metadata Metadata `json:"metadata,omitempty"`
 }
 
 // UpdatePlanRequest is the body for PUT /v1/plans/{id}.
@@ -334,9 +892,37 @@ type UpdatePlanRequest struct {
 	Name          string               `json:"name,omitempty"`
 	Description   string               `json:"description,omitempty"`
 	Currency      string               `json:"currency,omitempty"`
-	PlanType      string               `json:"plan_type,omitempty"`
-	BillingPeriod string               `json:"billing_period,omitempty"`
+	PlanType      PlanType             `json:"plan_type,omitempty"`
+	BillingPeriod BillingPeriod        `json:"billing_period,omitempty"`
 	Prices        []UpdatePriceRequest `json:"prices,omitempty"`
+	// Commission replaces the payout plan's take-rate. Takes effect from the
+	// next payout slip.
+	Commission *CommissionRule `json:"commission,omitempty"`
+	// MinimumAmount changes the plan's spend floor from the next billing
+	// period. Point it at "" to remove the minimum.
+	MinimumAmount *string `json:"minimum_amount,omitempty"`
+	// TaxBehavior changes whether prices include tax.
+	TaxBehavior string `json:"tax_behavior,omitempty"`
+	// Metadata replaces the plan's metadata when non-nil. Point it at an
+	// empty Metadata to remove all keys.
+	Metadata *Metadata `json:"metadata,omitempty"`
+}
+
+// ClonePlanRequest is the body for POST /v1/plans/{id}/clone. The clone
+// copies the source plan and its prices; set fields override the copy.
+type ClonePlanRequest struct {
+	// Name of the new plan. Defaults to the source name with " (copy)" appended.
+	Name          string        `json:"name,omitempty"`
+	Description   string        `json:"description,omitempty"`
+	Currency      string        `json:"currency,omitempty"`
+	BillingPeriod BillingPeriod `json:"billing_period,omitempty"`
+}
+
+// ListPlansParams are optional query parameters for GET /v1/plans.
+type ListPlansParams struct {
+	// MetadataFilter matches plans whose metadata has every given key set
+	// to the given value.
+	MetadataFilter map[string]string
 }
 
 // ListPlansResponse is returned by GET /v1/plans.
@@ -351,16 +937,91 @@ type ListPlansResponse struct {
 
 // Subscription links a customer to a billing plan.
 type Subscription struct {
-	ID                 string     `json:"id"`
-	OrgID              string     `json:"org_id"`
-	CustomerID         string     `json:"customer_id"`
-	PlanID             string     `json:"plan_id"`
-	Status             string     `json:"status"`
-	CurrentPeriodStart time.Time  `json:"current_period_start"`
-	CurrentPeriodEnd   time.Time  `json:"current_period_end"`
-	TrialEndsAt        *time.Time `json:"trial_ends_at,omitempty"`
-	CreatedAt          time.Time  `json:"created_at"`
-	UpdatedAt          time.Time  `json:"updated_at"`
+	ID                 string             `json:"id"`
+	OrgID              string             `json:"org_id"`
+	CustomerID         string             `json:"customer_id"`
+	PlanID             string             `json:"plan_id"`
+	Status             SubscriptionStatus `json:"status"`
+	CurrentPeriodStart time.Time          `json:"current_period_start"`
+	CurrentPeriodEnd   time.Time          `json:"current_period_end"`
+	TrialEndsAt        *time.Time         `json:"trial_ends_at,omitempty"`
+	// ScheduledStartAt is when a scheduled subscription starts billing.
+	ScheduledStartAt *time.Time `json:"scheduled_start_at,omitempty"`
+	// BillingCycleAnchor is the date billing periods are aligned to, if
+	// one was set, and ProrationBehavior how the first, partial period was
+	// billed.
+	BillingCycleAnchor *time.Time        `json:"billing_cycle_anchor,omitempty"`
+	ProrationBehavior  ProrationBehavior `json:"proration_behavior,omitempty"`
+	// CouponID is the coupon currently discounting this subscription, if any.
+	CouponID string `json:"coupon_id,omitempty"`
+	// PausedAt is set while the subscription is paused.
+	PausedAt *time.Time `json:"paused_at,omitempty"`
+	// ResumeAt is when a paused subscription will resume automatically.
+	ResumeAt *time.Time `json:"resume_at,omitempty"`
+	// PauseBehavior is the PauseBehaviorXxx in effect while paused.
+	PauseBehavior string `json:"pause_behavior,omitempty"`
+	// MinimumAmount is the contract minimum for this subscription, overriding
+	// the plan's. Empty means the plan's MinimumAmount applies.
+	MinimumAmount string `json:"minimum_amount,omitempty"`
+	// Currency is the currency the subscription is billed in.
+	Currency string `json:"currency,omitempty"`
+	// Metadata is arbitrary key-value data set on the subscription.
+	Metadata Metadata `json:"metadata,omitempty"`
+	// AutoCharge charges finalized invoices to the customer's payment
+	// method instead of waiting for them to be paid some other way.
+	AutoCharge bool `json:"auto_charge"`
+	// PaymentMethodID is the payment method AutoCharge uses. Empty means
+	// the customer's default payment method.
+	PaymentMethodID string `json:"payment_method_id,omitempty"`
+	// Customer and Plan are the full related objects, set only when
+	// requested with ExpandCustomer and ExpandPlan.
+	Customer *Customer `json:"customer,omitempty"`
+	Plan     *Plan     `json:"plan,omitempty"`
+	// Items are the add-ons billed alongside the base plan.
+	Items     []SubscriptionItem `json:"items,omitempty"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// SubscriptionItem is an add-on billed on a subscription in addition to its
+// base plan, e.g. extra seats or premium support. Each item appears as its
+// own invoice line item.
+type SubscriptionItem struct {
+	ID             string `json:"id"`
+	SubscriptionID string `json:"subscription_id"`
+	// PlanID is set when the item adds every price of another plan.
+	PlanID string `json:"plan_id,omitempty"`
+	// PriceID is set when the item adds a single price.
+	PriceID string `json:"price_id,omitempty"`
+	// Quantity multiplies the item's charges, e.g. the number of extra seats.
+	Quantity  int64     `json:"quantity"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AddSubscriptionItemRequest is the body for POST /v1/subscriptions/{id}/items.
+// Set exactly one of PlanID or PriceID.
+type AddSubscriptionItemRequest struct {
+	PlanID  string `json:"plan_id,omitempty"`
+	PriceID string `json:"price_id,omitempty"`
+	// Quantity defaults to 1.
+	Quantity int64 `json:"quantity,omitempty"`
+}
+
+// ListSubscriptionItemsResponse is returned by GET /v1/subscriptions/{id}/items.
+type ListSubscriptionItemsResponse struct {
+	Items []SubscriptionItem `json:"items"`
+	Count int                `json:"count"`
+}
+
+// PauseOptions is the body for POST /v1/subscriptions/{id}/pause.
+type PauseOptions struct {
+	// ResumeAt schedules an automatic resume. Nil pauses indefinitely until
+	// Resume is called.
+	ResumeAt *time.Time `json:"resume_at,omitempty"`
+	// Behavior controls usage received while paused. Use the
+	// PauseBehaviorXxx constants. Defaults to PauseBehaviorAccrue.
+	Behavior string `json:"behavior,omitempty"`
 }
 
 // CreateSubscriptionRequest is the body for POST /v1/subscriptions.
@@ -369,6 +1030,60 @@ type CreateSubscriptionRequest struct {
 	CustomerID string `json:"customer_id"`
 	// PlanID is the UUID of the plan to subscribe the customer to.
 	PlanID string `json:"plan_id"`
+	// StartAt schedules the subscription to start billing in the future.
+	// Until then its status is SubscriptionStatusScheduled. Nil starts now.
+	StartAt *time.Time `json:"start_at,omitempty"`
+	// BackdateTo starts the first billing period in the past, so usage
+	// since then is billed on the first invoice. Mutually exclusive with
+	// StartAt.
+	BackdateTo *time.Time `json:"backdate_to,omitempty"`
+	// TrialDays overrides the plan's TrialPeriodDays for this subscription.
+	// Point it at 0 to skip the trial. Nil uses the plan default.
+	TrialDays *int32 `json:"trial_days,omitempty"`
+	// TrialEndsAt ends the trial at an exact time instead of after a number
+	// of days. Mutually exclusive with TrialDays.
+	TrialEndsAt *time.Time `json:"trial_ends_at,omitempty"`
+	// BillingCycleAnchor aligns the subscription's billing periods to a
+	// date, e.g. the 1st of next month to bill every customer on the 1st.
+	// The first period runs from the start to the next occurrence of the
+	// anchor and is billed according to ProrationBehavior; later periods
+	// are full periods starting on the anchor. Nil starts periods on the
+	// subscription's start date.
+	BillingCycleAnchor *time.Time `json:"billing_cycle_anchor,omitempty"`
+	// ProrationBehavior is how the partial first period up to
+	// BillingCycleAnchor is billed. Use the ProrationBehaviorXxx
+	// constants. Defaults to ProrationBehaviorProrate.
+	ProrationBehavior ProrationBehavior `json:"proration_behavior,omitempty"`
+	// MinimumAmount sets a contract minimum for this subscription,
+	// overriding the plan's MinimumAmount.
+	MinimumAmount string `json:"minimum_amount,omitempty"`
+	// Currency bills the subscription in one of the plan's price currencies.
+	// Defaults to the plan currency.
+	Currency string `json:"currency,omitempty"`
+	// Metadata is optional arbitrary key-value data.
+	Metadata Metadata `json:"metadata,omitempty"`
+	// AutoCharge charges each finalized invoice to PaymentMethodID, or the
+	// customer's default payment method if it is empty.
+	AutoCharge      bool   `json:"auto_charge,omitempty"`
+	PaymentMethodID string `json:"payment_method_id,omitempty"`
+}
+
+// UpdateSubscriptionRequest is the body for PATCH /v1/subscriptions/{id}.
+// Nil or empty fields are left unchanged.
+type UpdateSubscriptionRequest struct {
+	// Status changes the subscription status. Use SubscriptionStatusXxx.
+	Status SubscriptionStatus `json:"status,omitempty"`
+	// MinimumAmount changes the contract minimum from the next billing
+	// period. Point it at "" to fall back to the plan's minimum.
+	MinimumAmount *string `json:"minimum_amount,omitempty"`
+	// Metadata replaces the subscription's metadata when non-nil. Point it at an
+	// empty Metadata to remove all keys.
+	Metadata *Metadata `json:"metadata,omitempty"`
+	// AutoCharge turns automatic collection on or off.
+	AutoCharge *bool `json:"auto_charge,omitempty"`
+	// PaymentMethodID changes the payment method AutoCharge uses. Point it
+	// at "" to fall back to the customer's default.
+	PaymentMethodID *string `json:"payment_method_id,omitempty"`
 }
 
 // ListSubscriptionsParams are the optional query parameters for GET /v1/subscriptions.
@@ -378,13 +1093,86 @@ type ListSubscriptionsParams struct {
 	// PlanID filters subscriptions to a specific plan.
 	PlanID string
 	// Status filters by subscription status (active, paused, canceled).
-	Status string
+	Status SubscriptionStatus
+	// MetadataFilter matches subscriptions whose metadata has every given
+	// key set to the given value.
+	MetadataFilter map[string]string
+	// Expand embeds related objects in each subscription. Use ExpandCustomer
+	// and ExpandPlan.
+	Expand []string
+	// CreatedAfter is the inclusive lower bound on creation time.
+	CreatedAfter *time.Time
+	// CreatedBefore is the exclusive upper bound on creation time.
+	CreatedBefore *time.Time
+	// CurrentPeriodEndBefore restricts the list to subscriptions whose
+	// current period ends before this time, i.e. those due for renewal.
+	CurrentPeriodEndBefore *time.Time
+	// Sort orders the results by a field: "created_at" or
+	// "current_period_end". Prefix with "-" for descending order. Defaults
+	// to "-created_at".
+	Sort string
+	// Page is the 1-based page number. Zero means the first page.
+	Page int
+	// PerPage is the page size. Zero uses the server default.
+	PerPage int
 }
 
 // ListSubscriptionsResponse is returned by GET /v1/subscriptions.
 type ListSubscriptionsResponse struct {
 	Subscriptions []Subscription `json:"subscriptions"`
-	Count         int            `json:"count"`
+	// Count is the number of subscriptions in this response.
+	Count int `json:"count"`
+	// Total is the number of subscriptions matching the filters across all
+	// pages. It is only set when the request was paginated.
+	Total int `json:"total,omitempty"`
+}
+
+// ---------------------------------------------------------------------------
+// Payment method types
+// ---------------------------------------------------------------------------
+
+// PaymentMethod is a card or bank-debit authorization that invoices can be
+// charged to. The SDK never sees card or account numbers: the method is
+// created from a token issued by the payment provider.
+type PaymentMethod struct {
+	ID         string            `json:"id"`
+	CustomerID string            `json:"customer_id"`
+	OrgID      string            `json:"org_id"`
+	Type       PaymentMethodType `json:"type"`
+	// Provider is the payment provider holding the authorization, e.g.
+	// "paystack" or "flutterwave".
+	Provider string `json:"provider"`
+	// Brand and Last4 describe a card, e.g. "visa" and "4081".
+	Brand    string `json:"brand,omitempty"`
+	Last4    string `json:"last4,omitempty"`
+	ExpMonth int    `json:"exp_month,omitempty"`
+	ExpYear  int    `json:"exp_year,omitempty"`
+	// BankName is set for bank-debit methods.
+	BankName  string    `json:"bank_name,omitempty"`
+	IsDefault bool      `json:"is_default"`
+	Metadata  Metadata  `json:"metadata,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreatePaymentMethodRequest is the body for POST /v1/customers/{id}/payment-methods.
+type CreatePaymentMethodRequest struct {
+	Type PaymentMethodType `json:"type"`
+	// Provider is the payment provider that issued ProviderToken.
+	Provider string `json:"provider"`
+	// ProviderToken is the reusable authorization the provider returned
+	// after the customer's first payment or mandate, e.g. a Paystack
+	// authorization code.
+	ProviderToken string `json:"provider_token"`
+	// IsDefault makes this the customer's default payment method. A
+	// customer's first payment method is always the default.
+	IsDefault bool     `json:"is_default,omitempty"`
+	Metadata  Metadata `json:"metadata,omitempty"`
+}
+
+// ListPaymentMethodsResponse is returned by GET /v1/customers/{id}/payment-methods.
+type ListPaymentMethodsResponse struct {
+	PaymentMethods []PaymentMethod `json:"payment_methods"`
+	Count          int             `json:"count"`
 }
 
 // ---------------------------------------------------------------------------
@@ -393,20 +1181,25 @@ type ListSubscriptionsResponse struct {
 
 // PayoutAccount is a bank or mobile-money account that a customer can be paid to.
 type PayoutAccount struct {
-	ID                string          `json:"id"`
-	CustomerID        string          `json:"customer_id"`
-	OrgID             string          `json:"org_id"`
-	AccountName       string          `json:"account_name"`
-	BankName          string          `json:"bank_name,omitempty"`
-	BankCode          string          `json:"bank_code,omitempty"`
-	AccountNumber     string          `json:"account_number,omitempty"`
-	MobileMoneyNumber string          `json:"mobile_money_number,omitempty"`
-	PayoutMethod      string          `json:"payout_method"`
-	Currency          string          `json:"currency"`
-	IsDefault         bool            `json:"is_default"`
-	Metadata          json.RawMessage `json:"metadata,omitempty"`
-	CreatedAt         time.Time       `json:"created_at"`
-	UpdatedAt         time.Time       `json:"updated_at"`
+	ID                string       `json:"id"`
+	CustomerID        string       `json:"customer_id"`
+	OrgID             string       `json:"org_id"`
+	AccountName       string       `json:"account_name"`
+	BankName          string       `json:"bank_name,omitempty"`
+	BankCode          string       `json:"bank_code,omitempty"`
+	AccountNumber     string       `json:"account_number,omitempty"`
+	MobileMoneyNumber string       `json:"mobile_money_number,omitempty"`
+	PayoutMethod      PayoutMethod `json:"payout_method"`
+	Currency          string       `json:"currency"`
+	IsDefault         bool         `json:"is_default"`
+	// Verified is true when the bank confirmed the account number and the
+	// name on the account. Unverified accounts are more likely to fail at
+	// payout time.
+	Verified   bool       `json:"verified"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	Metadata   Metadata   `json:"metadata,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
 }
 
 // CreatePayoutAccountRequest is the body for POST /v1/customers/{id}/payout-accounts.
@@ -414,25 +1207,47 @@ type CreatePayoutAccountRequest struct {
 	// AccountName is the name on the account.
 	AccountName string `json:"account_name"`
 	// PayoutMethod is either "bank_transfer" or "mobile_money".
-	PayoutMethod      string          `json:"payout_method"`
-	BankName          string          `json:"bank_name,omitempty"`
-	BankCode          string          `json:"bank_code,omitempty"`
-	AccountNumber     string          `json:"account_number,omitempty"`
-	MobileMoneyNumber string          `json:"mobile_money_number,omitempty"`
-	Currency          string          `json:"currency,omitempty"`
-	IsDefault         bool            `json:"is_default,omitempty"`
-	Metadata          json.RawMessage `json:"metadata,omitempty"`
+	PayoutMethod      PayoutMethod `json:"payout_method"`
+	BankName          string       `json:"bank_name,omitempty"`
+	BankCode          string       `json:"bank_code,omitempty"`
+	AccountNumber     string       `json:"account_number,omitempty"`
+	MobileMoneyNumber string       `json:"mobile_money_number,omitempty"`
+	Currency          string       `json:"currency,omitempty"`
+	IsDefault         bool         `json:"is_default,omitempty"`
+	Metadata          Metadata     `json:"metadata,omitempty"`
 }
 
 // UpdatePayoutAccountRequest is the body for PUT /v1/customers/{id}/payout-accounts/{account_id}.
 type UpdatePayoutAccountRequest struct {
-	AccountName       string          `json:"account_name,omitempty"`
-	PayoutMethod      string          `json:"payout_method,omitempty"`
-	BankName          string          `json:"bank_name,omitempty"`
-	AccountNumber     string          `json:"account_number,omitempty"`
-	Currency          string          `json:"currency,omitempty"`
-	IsDefault         bool            `json:"is_default,omitempty"`
-	Metadata          json.RawMessage `json:"metadata,omitempty"`
+	AccountName   string       `json:"account_name,omitempty"`
+	PayoutMethod  PayoutMethod `json:"payout_method,omitempty"`
+	BankName      string       `json:"bank_name,omitempty"`
+	AccountNumber string       `json:"account_number,omitempty"`
+	Currency      string       `json:"currency,omitempty"`
+	IsDefault     bool         `json:"is_default,omitempty"`
+	// Metadata replaces the account's metadata when non-nil. Point it at
+	// an empty Metadata to remove all keys.
+	Metadata *Metadata `json:"metadata,omitempty"`
+}
+
+// VerifyAccountRequest is the body for POST /v1/payout-accounts/verify.
+type VerifyAccountRequest struct {
+	// BankCode is the bank's code as returned by BankService.List, e.g.
+	// "011" for First Bank Nigeria.
+	BankCode      string `json:"bank_code"`
+	AccountNumber string `json:"account_number"`
+}
+
+// AccountVerification is the result of resolving a bank account.
+type AccountVerification struct {
+	// Valid is false when the bank does not recognise the account number.
+	Valid bool `json:"valid"`
+	// AccountName is the name the bank holds for the account. Empty when
+	// Valid is false.
+	AccountName   string `json:"account_name,omitempty"`
+	BankCode      string `json:"bank_code"`
+	BankName      string `json:"bank_name,omitempty"`
+	AccountNumber string `json:"account_number"`
 }
 
 // ListPayoutAccountsResponse is returned by GET /v1/customers/{id}/payout-accounts.
@@ -441,21 +1256,196 @@ type ListPayoutAccountsResponse struct {
 	Count          int             `json:"count"`
 }
 
+// ---------------------------------------------------------------------------
+// Payout types
+// ---------------------------------------------------------------------------
+
+// Payout slip statuses.
+const (
+	// PayoutSlipStatusDraft means the slip was generated and awaits review.
+	PayoutSlipStatusDraft = "draft"
+	// PayoutSlipStatusApproved means the slip will be paid by the next run.
+	PayoutSlipStatusApproved = "approved"
+	// PayoutSlipStatusRejected means the slip will not be paid.
+	PayoutSlipStatusRejected = "rejected"
+	// PayoutSlipStatusProcessing means a payout run is sending the money.
+	PayoutSlipStatusProcessing = "processing"
+	// PayoutSlipStatusPaid means the transfer to the payout account succeeded.
+	PayoutSlipStatusPaid = "paid"
+	// PayoutSlipStatusFailed means the transfer was attempted and failed.
+	// FailureReason explains why.
+	PayoutSlipStatusFailed = "failed"
+)
+
+// Payout run statuses.
+const (
+	PayoutRunStatusPending    = "pending"
+	PayoutRunStatusProcessing = "processing"
+	PayoutRunStatusCompleted  = "completed"
+	PayoutRunStatusFailed     = "failed"
+)
+
+// PayoutSlipLineItem is one metered earning on a payout slip.
+type PayoutSlipLineItem struct {
+	ID          string `json:"id"`
+	MetricID    string `json:"metric_id"`
+	PriceID     string `json:"price_id,omitempty"`
+	Description string `json:"description"`
+	Quantity    string `json:"quantity"`
+	UnitPrice   string `json:"unit_price"`
+	Amount      string `json:"amount"`
+}
+
+// PayoutSlip is the amount owed to a customer on a payout plan for one
+// billing period — the payout counterpart of an Invoice.
+// All monetary values are decimal strings.
+type PayoutSlip struct {
+	ID              string `json:"id"`
+	OrgID           string `json:"org_id"`
+	CustomerID      string `json:"customer_id"`
+	SubscriptionID  string `json:"subscription_id"`
+	PayoutAccountID string `json:"payout_account_id,omitempty"`
+	// Status is one of the PayoutSlipStatusXxx constants.
+	Status      string    `json:"status"`
+	Currency    string    `json:"currency"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	// GrossAmount is the metered earnings before commission.
+	GrossAmount string `json:"gross_amount"`
+	// CommissionAmount is the platform's share withheld under the plan's
+	// CommissionRule.
+	CommissionAmount string `json:"commission_amount,omitempty"`
+	// NetAmount is what will be paid out.
+	NetAmount string               `json:"net_amount"`
+	LineItems []PayoutSlipLineItem `json:"line_items,omitempty"`
+	// RunID is the payout run that paid or is paying this slip.
+	RunID           string     `json:"run_id,omitempty"`
+	RejectionReason string     `json:"rejection_reason,omitempty"`
+	FailureReason   string     `json:"failure_reason,omitempty"`
+	ApprovedAt      *time.Time `json:"approved_at,omitempty"`
+	PaidAt          *time.Time `json:"paid_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// ListPayoutSlipsParams are optional query parameters for GET /v1/payout-slips.
+type ListPayoutSlipsParams struct {
+	// Status filters by PayoutSlipStatusXxx.
+	Status string
+	// CustomerID filters slips to one payee.
+	CustomerID string
+	// RunID filters slips to one payout run.
+	RunID string
+	// PeriodStart and PeriodEnd restrict slips to billing periods that fall
+	// within the range. Zero values are ignored.
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+}
+
+// ListPayoutSlipsResponse is returned by GET /v1/payout-slips.
+type ListPayoutSlipsResponse struct {
+	PayoutSlips []PayoutSlip `json:"payout_slips"`
+	Count       int          `json:"count"`
+}
+
+// RejectPayoutSlipRequest is the body for POST /v1/payout-slips/{id}/reject.
+type RejectPayoutSlipRequest struct {
+	Reason string `json:"reason"`
+}
+
+// CreatePayoutRunRequest is the body for POST /v1/payout-runs.
+type CreatePayoutRunRequest struct {
+	// SlipIDs pays these approved slips. Empty pays every approved slip not
+	// yet in a run.
+	SlipIDs []string `json:"slip_ids,omitempty"`
+	// Currency restricts the run to slips in one currency. Optional.
+	Currency string `json:"currency,omitempty"`
+}
+
+// PayoutRun sends money for a batch of approved payout slips.
+type PayoutRun struct {
+	ID    string `json:"id"`
+	OrgID string `json:"org_id"`
+	// Status is one of the PayoutRunStatusXxx constants.
+	Status      string     `json:"status"`
+	Currency    string     `json:"currency,omitempty"`
+	TotalAmount string     `json:"total_amount"`
+	SlipCount   int        `json:"slip_count"`
+	PaidCount   int        `json:"paid_count"`
+	FailedCount int        `json:"failed_count"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
 // ---------------------------------------------------------------------------
 // Invoice types
 // ---------------------------------------------------------------------------
 
+// ---------------------------------------------------------------------------
+// Invoice line item type constants
+// ---------------------------------------------------------------------------
+
+const (
+	// InvoiceLineItemTypeUsage is a charge for metered usage of a metric.
+	InvoiceLineItemTypeUsage = "usage"
+	// InvoiceLineItemTypeCredit is prepaid credit applied against the invoice.
+	// Its Amount is negative.
+	InvoiceLineItemTypeCredit = "credit"
+	// InvoiceLineItemTypeDiscount is a coupon discount. Its Amount is negative.
+	InvoiceLineItemTypeDiscount = "discount"
+	// InvoiceLineItemTypeCommission is the platform's commission withheld from
+	// a payout slip. Its Amount is negative.
+	InvoiceLineItemTypeCommission = "commission"
+	// InvoiceLineItemTypeMinimumCommitment tops the invoice up to the
+	// subscription's minimum commitment when usage charges fall short.
+	InvoiceLineItemTypeMinimumCommitment = "minimum_commitment"
+	// InvoiceLineItemTypeAdjustment is a manual line added to a draft, such as
+	// a setup fee or a one-off discount (negative Amount).
+	InvoiceLineItemTypeAdjustment = "adjustment"
+)
+
 // InvoiceLineItem is one line on an invoice showing usage of a single metric.
 type InvoiceLineItem struct {
 	ID          string    `json:"id"`
 	InvoiceID   string    `json:"invoice_id"`
-	MetricID    string    `json:"metric_id"`
-	PriceID     string    `json:"price_id,omitempty"`
-	Description string    `json:"description"`
-	Quantity    string    `json:"quantity"`
-	UnitPrice   string    `json:"unit_price"`
-	Amount      string    `json:"amount"`
-	CreatedAt   time.Time `json:"created_at"`
+	// Type identifies what the line represents. Use the InvoiceLineItemTypeXxx
+	// constants. Empty is equivalent to "usage".
+	Type     string `json:"type,omitempty"`
+	MetricID string `json:"metric_id"`
+	PriceID  string `json:"price_id,omitempty"`
+	// SubscriptionItemID is set on lines charged for a subscription add-on.
+	SubscriptionItemID string `json:"subscription_item_id,omitempty"`
+	// CustomerID is set on lines of a consolidated parent invoice to the
+	// child account whose usage the line bills.
+	CustomerID  string `json:"customer_id,omitempty"`
+	Description string `json:"description"`
+	Quantity    string `json:"quantity"`
+	UnitPrice   string `json:"unit_price"`
+	Amount      string `json:"amount"`
+	// TaxRateID is the VAT rate applied to this line, if any, and TaxRate
+	// its percentage, e.g. "7.5". TaxAmount is the VAT on Amount.
+	TaxRateID string `json:"tax_rate_id,omitempty"`
+	TaxRate   string `json:"tax_rate,omitempty"`
+	TaxAmount string `json:"tax_amount,omitempty"`
+	// WithholdingRate is the withholding tax percentage applied to this
+	// line, and WithholdingAmount the tax withheld from Amount.
+	WithholdingRate   string    `json:"withholding_rate,omitempty"`
+	WithholdingAmount string    `json:"withholding_amount,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// InvoiceTaxSummary totals an invoice's tax for one rate, as returned in
+// Invoice.TaxSummary.
+type InvoiceTaxSummary struct {
+	TaxRateID string `json:"tax_rate_id,omitempty"`
+	Name      string `json:"name"`
+	// Type is TaxTypeVAT or TaxTypeWithholding.
+	Type    string `json:"type"`
+	Percent string `json:"percent"`
+	// TaxableAmount is the sum of the Amounts of the lines the rate applied
+	// to, and TaxAmount the tax charged or withheld on them.
+	TaxableAmount string `json:"taxable_amount"`
+	TaxAmount     string `json:"tax_amount"`
 }
 
 // Invoice represents a billing invoice.
@@ -464,43 +1454,312 @@ type InvoiceLineItem struct {
 type Invoice struct {
 	ID                string            `json:"id"`
 	OrgID             string            `json:"org_id"`
-	CustomerID        string            `json:"customer_id"`
-	SubscriptionID    string            `json:"subscription_id"`
-	Status            string            `json:"status"`
-	Currency          string            `json:"currency"`
-	Subtotal          string            `json:"subtotal"`
-	VATEnabled        bool              `json:"vat_enabled"`
-	VATRate           string            `json:"vat_rate,omitempty"`
-	VATAmount         string            `json:"vat_amount,omitempty"`
-	Total             string            `json:"total"`
-	PeriodStart       time.Time         `json:"period_start"`
-	PeriodEnd         time.Time         `json:"period_end"`
-	FinalizedAt       *time.Time        `json:"finalized_at,omitempty"`
-	PaidAt            *time.Time        `json:"paid_at,omitempty"`
+	// InvoiceNumber is the sequential number assigned at finalization from
+	// the organisation's InvoiceNumbering scheme, e.g. "INV-2026-000142".
+	// Empty on drafts.
+	InvoiceNumber  string        `json:"invoice_number,omitempty"`
+	CustomerID     string        `json:"customer_id"`
+	SubscriptionID string        `json:"subscription_id"`
+	Status         InvoiceStatus `json:"status"`
+	Currency       string        `json:"currency"`
+	Subtotal       string        `json:"subtotal"`
+	// Locale is the language the invoice was rendered in, inherited from the customer.
+	Locale     string `json:"locale,omitempty"`
+	VATEnabled bool   `json:"vat_enabled"`
+	VATRate    string `json:"vat_rate,omitempty"`
+	VATAmount  string `json:"vat_amount,omitempty"`
+	// WithholdingTaxAmount is withholding tax deducted from the total.
+	WithholdingTaxAmount string `json:"withholding_tax_amount,omitempty"`
+	// TaxSummary breaks VATAmount and WithholdingTaxAmount down by rate,
+	// one entry per rate applied to any line.
+	TaxSummary []InvoiceTaxSummary `json:"tax_summary,omitempty"`
+	// TaxExempt is true when a tax override exempted this invoice.
+	TaxExempt bool `json:"tax_exempt,omitempty"`
+	// ExchangeRate is the conversion applied when the invoice was priced
+	// in a currency different from the organisation's reporting currency.
+	ExchangeRate *ExchangeRate `json:"exchange_rate,omitempty"`
+	Total        string        `json:"total"`
+	// DiscountAmount is the total coupon discount subtracted from Subtotal.
+	DiscountAmount string `json:"discount_amount,omitempty"`
+	// AppliedCoupons lists the coupons that produced DiscountAmount.
+	AppliedCoupons []AppliedCoupon `json:"applied_coupons,omitempty"`
+	// CreditsApplied is the prepaid credit consumed by this invoice.
+	CreditsApplied string `json:"credits_applied,omitempty"`
+	// MinimumCommitmentAdjustment is the amount added to reach the
+	// subscription's minimum commitment. Empty when usage met the minimum.
+	MinimumCommitmentAdjustment string `json:"minimum_commitment_adjustment,omitempty"`
+	// ProrationFactor is the fraction of a full billing period a prorated
+	// first invoice covers, e.g. "0.516129" for 16 days of a 31-day month.
+	// The minimum commitment is scaled by it. Empty on full periods.
+	ProrationFactor string `json:"proration_factor,omitempty"`
+	// GrossAmount is the metered payout before commission. Only set on payout
+	// slips from plans with a CommissionRule.
+	GrossAmount string `json:"gross_amount,omitempty"`
+	// CommissionAmount is the platform commission withheld from GrossAmount.
+	// Total is the net amount paid out.
+	CommissionAmount string `json:"commission_amount,omitempty"`
+	// AmountDue is Total minus CreditsApplied — what the customer still owes.
+	AmountDue   string     `json:"amount_due,omitempty"`
+	PeriodStart time.Time  `json:"period_start"`
+	PeriodEnd   time.Time  `json:"period_end"`
+	FinalizedAt *time.Time `json:"finalized_at,omitempty"`
+	// PaymentTerms is the PaymentTermsXxx the invoice was finalized with.
+	PaymentTerms string `json:"payment_terms,omitempty"`
+	// DueAt is when payment is due. Set at finalization.
+	DueAt *time.Time `json:"due_at,omitempty"`
+	// DunningStatus is the DunningStatusXxx of a finalized invoice.
+	DunningStatus string     `json:"dunning_status,omitempty"`
+	PaidAt        *time.Time `json:"paid_at,omitempty"`
+	// Metadata is arbitrary key-value data set with UpdateMetadata.
+	Metadata Metadata `json:"metadata,omitempty"`
+	// CustomFields are printed on the invoice and its PDF, in order.
+	CustomFields []InvoiceCustomField `json:"custom_fields,omitempty"`
+	// IsTest is true for invoices generated from test-mode usage.
+	IsTest            bool              `json:"is_test"`
 	ProviderInvoiceID string            `json:"provider_invoice_id,omitempty"`
 	LineItems         []InvoiceLineItem `json:"line_items,omitempty"`
 	CreatedAt         time.Time         `json:"created_at"`
 	UpdatedAt         time.Time         `json:"updated_at"`
 }
 
+// ---------------------------------------------------------------------------
+// Job status constants
+// ---------------------------------------------------------------------------
+
+// Status values shared by asynchronous jobs such as EventReplayJob and
+// InvoiceGenerationJob.
+const (
+	JobStatusPending   = "pending"
+	JobStatusRunning   = "running"
+	JobStatusCompleted = "completed"
+	JobStatusFailed    = "failed"
+	// JobStatusCanceled is set on jobs stopped before completion, e.g. by
+	// EventService.CancelReplay.
+	JobStatusCanceled = "canceled"
+)
+
+// InvoiceGenerationJob tracks an invoice being generated in the background
+// by InvoiceService.GenerateAsync.
+type InvoiceGenerationJob struct {
+	ID             string `json:"id"`
+	OrgID          string `json:"org_id"`
+	SubscriptionID string `json:"subscription_id"`
+	// Status is one of the JobStatusXxx constants.
+	Status string `json:"status"`
+	// InvoiceID is set once the job has completed successfully.
+	InvoiceID    *string    `json:"invoice_id,omitempty"`
+	ErrorMessage *string    `json:"error_message,omitempty"`
+	StartedAt    *time.Time `json:"started_at,omitempty"`
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// GenerateBatchRequest is the body for POST /v1/invoices/generate/batches.
+type GenerateBatchRequest struct {
+	// PlanID restricts the batch to subscriptions on one plan. Empty covers
+	// every plan.
+	PlanID string `json:"plan_id,omitempty"`
+	// PeriodEnd selects subscriptions whose current period ends at or before
+	// this time. Defaults to now.
+	PeriodEnd *time.Time `json:"period_end,omitempty"`
+	// DryRun counts the subscriptions that would be invoiced without
+	// creating any invoices.
+	DryRun bool `json:"dry_run,omitempty"`
+}
+
+// InvoiceBatchError records why one subscription in a batch failed.
+type InvoiceBatchError struct {
+	SubscriptionID string `json:"subscription_id"`
+	Message        string `json:"message"`
+}
+
+// InvoiceBatchJob tracks invoice generation for many subscriptions started
+// by InvoiceService.GenerateBatch.
+type InvoiceBatchJob struct {
+	ID     string `json:"id"`
+	OrgID  string `json:"org_id"`
+	PlanID string `json:"plan_id,omitempty"`
+	DryRun bool   `json:"dry_run"`
+	// Status is one of the JobStatusXxx constants.
+	Status string `json:"status"`
+	// Total is the number of subscriptions selected for the batch.
+	Total int `json:"total"`
+	// Processed is the number handled so far; Succeeded + Failed == Processed.
+	Processed int `json:"processed"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	// Errors lists the failed subscriptions.
+	Errors      []InvoiceBatchError `json:"errors,omitempty"`
+	PeriodEnd   time.Time           `json:"period_end"`
+	StartedAt   *time.Time          `json:"started_at,omitempty"`
+	CompletedAt *time.Time          `json:"completed_at,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
 // GenerateInvoiceRequest is the body for POST /v1/invoices/generate.
 type GenerateInvoiceRequest struct {
 	// SubscriptionID is the UUID of the subscription to generate an invoice for.
 	SubscriptionID string `json:"subscription_id"`
+	// CustomFields are printed on the draft invoice.
+	CustomFields []InvoiceCustomField `json:"custom_fields,omitempty"`
+	// PeriodStart and PeriodEnd bill an explicit period instead of the
+	// subscription's current one. Set both or neither.
+	PeriodStart *time.Time `json:"period_start,omitempty"`
+	PeriodEnd   *time.Time `json:"period_end,omitempty"`
+}
+
+// GenerateInvoiceOptions are the settings for
+// InvoiceService.GenerateWithOptions.
+type GenerateInvoiceOptions struct {
+	// PeriodStart and PeriodEnd are the period to bill, such as a period
+	// that was missed or needs re-billing. Usage with timestamps in
+	// [PeriodStart, PeriodEnd) is priced under the subscription's plan.
+	// Leave both zero to bill the current period.
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	// CustomFields are printed on the draft invoice, e.g. a purchase order
+	// number. They can be replaced when the invoice is finalized.
+	CustomFields []InvoiceCustomField
+}
+
+// InvoiceCustomField is a labelled value printed on an invoice, such as a
+// purchase order number or cost center that a customer's procurement
+// process requires.
+type InvoiceCustomField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// InvoiceNumbering is the organisation's scheme for numbering finalized
+// invoices. Numbers are assigned without gaps in finalization order.
+type InvoiceNumbering struct {
+	// Prefix is prepended to every number. It may contain {YYYY} and {MM},
+	// replaced with the finalization year and month, e.g. "INV-{YYYY}-".
+	Prefix string `json:"prefix"`
+	// NextNumber is the sequence number the next finalized invoice gets.
+	NextNumber int64 `json:"next_number"`
+	// Padding zero-pads the sequence number to this many digits.
+	Padding int `json:"padding"`
+	// PerCustomer keeps a separate sequence for each customer instead of
+	// one for the organisation.
+	PerCustomer bool `json:"per_customer"`
+	// ResetYearly restarts the sequence at 1 every calendar year.
+	ResetYearly bool `json:"reset_yearly"`
+}
+
+// UpdateInvoiceNumberingRequest is the body for PUT /v1/invoices/numbering.
+// Nil fields are left unchanged. NextNumber can only move forwards.
+type UpdateInvoiceNumberingRequest struct {
+	Prefix      *string `json:"prefix,omitempty"`
+	NextNumber  *int64  `json:"next_number,omitempty"`
+	Padding     *int    `json:"padding,omitempty"`
+	PerCustomer *bool   `json:"per_customer,omitempty"`
+	ResetYearly *bool   `json:"reset_yearly,omitempty"`
+}
+
+// AddLineItemRequest is the body for POST /v1/invoices/{id}/line-items.
+// Set Amount for a fixed charge, or Quantity and UnitPrice to have the
+// amount computed. Use a negative Amount for a manual discount.
+type AddLineItemRequest struct {
+	Description string `json:"description"`
+	Quantity    string `json:"quantity,omitempty"`
+	UnitPrice   string `json:"unit_price,omitempty"`
+	Amount      string `json:"amount,omitempty"`
+}
+
+// UpdateLineItemRequest is the body for PUT /v1/invoices/{id}/line-items/{line_item_id}.
+// Only manual adjustment lines can be updated; empty fields are unchanged.
+type UpdateLineItemRequest struct {
+	Description string `json:"description,omitempty"`
+	Quantity    string `json:"quantity,omitempty"`
+	UnitPrice   string `json:"unit_price,omitempty"`
+	Amount      string `json:"amount,omitempty"`
+}
+
+// FinalizeInvoiceOptions is the body for POST /v1/invoices/{id}/finalize
+// when finalizing with InvoiceService.FinalizeWithOptions.
+type FinalizeInvoiceOptions struct {
+	// PaymentTerms sets the due date relative to finalization. Use the
+	// PaymentTermsXxx constants. Defaults to the organisation's terms.
+	PaymentTerms string `json:"payment_terms,omitempty"`
+	// DueAt sets an exact due date, overriding PaymentTerms.
+	DueAt *time.Time `json:"due_at,omitempty"`
+	// CustomFields replaces the invoice's custom fields when non-nil.
+	CustomFields []InvoiceCustomField `json:"custom_fields,omitempty"`
+}
+
+// PaymentLinkOptions is the body for POST /v1/invoices/{id}/payment-link.
+type PaymentLinkOptions struct {
+	// ExpiresInSeconds is how long the link stays valid. Zero uses the
+	// server default of seven days; links never outlive the invoice being
+	// paid or voided.
+	ExpiresInSeconds int `json:"expires_in,omitempty"`
+	// RedirectURL is where the customer is sent after paying.
+	RedirectURL string `json:"redirect_url,omitempty"`
+	// PaymentMethodTypes limits the checkout to these payment methods.
+	// Empty offers every method the organisation accepts.
+	PaymentMethodTypes []PaymentMethodType `json:"payment_method_types,omitempty"`
+}
+
+// PaymentLink is a hosted checkout page for paying one invoice.
+type PaymentLink struct {
+	ID        string `json:"id"`
+	InvoiceID string `json:"invoice_id"`
+	// URL is the checkout page to link a "Pay now" button to.
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 // ListInvoicesParams are optional query parameters for GET /v1/invoices.
 type ListInvoicesParams struct {
 	// Status filters by invoice status (draft, finalized, paid, void).
-	Status string
+	Status InvoiceStatus
+	// MetadataFilter matches invoices whose metadata has every given key
+	// set to the given value.
+	MetadataFilter map[string]string
 	// CustomerID filters invoices to a specific customer.
 	CustomerID string
+	// Overdue restricts the list to unpaid invoices past their due date.
+	Overdue bool
+	// DueBefore restricts the list to invoices due before this time.
+	DueBefore *time.Time
+	// DunningStatus filters by dunning status, e.g. DunningStatusActive.
+	DunningStatus string
+	// SubscriptionID filters invoices to a specific subscription.
+	SubscriptionID string
+	// Currency filters by ISO 4217 currency code.
+	Currency string
+	// IsTest, when set, restricts the list to test (true) or live (false)
+	// invoices. Nil returns both.
+	IsTest *bool
+	// PeriodStartFrom is the inclusive lower bound on PeriodStart.
+	PeriodStartFrom *time.Time
+	// PeriodStartTo is the exclusive upper bound on PeriodStart.
+	PeriodStartTo *time.Time
+	// MinTotal and MaxTotal bound Total (inclusive), as decimal strings.
+	MinTotal string
+	MaxTotal string
+	// Sort orders the results by a field: "created_at", "period_start",
+	// "due_at", or "total". Prefix with "-" for descending order. Defaults
+	// to "-created_at".
+	Sort string
+	// Page is the 1-based page number. Zero means the first page.
+	Page int
+	// PerPage is the page size. Zero uses the server default.
+	PerPage int
 }
 
 // ListInvoicesResponse is returned by GET /v1/invoices.
 type ListInvoicesResponse struct {
 	Invoices []Invoice `json:"invoices"`
-	Count    int       `json:"count"`
+	// Count is the number of invoices in this response.
+	Count int `json:"count"`
+	// Total is the number of invoices matching the filters across all
+	// pages. It is only set when the request was paginated.
+	Total int `json:"total,omitempty"`
 }
 
 // ---------------------------------------------------------------------------
@@ -519,17 +1778,53 @@ type UsageParams struct {
 	// To is the exclusive upper bound of the period_start to query (RFC3339).
 	// Defaults to the end of the current billing period.
 	To *time.Time
+	// Filter restricts the rollups to events matching a property filter.
+	// Build one with Where.
+	Filter Filter
+	// IsTest, when set, restricts the rollups to test (true) or live (false)
+	// usage. Nil returns both.
+	IsTest *bool
+	// Cursor continues a previous query; pass the NextCursor of the last
+	// page.
+	Cursor string
+	// Limit is the page size. Zero uses the server default.
+	Limit int
+	// GroupBy sets the dimensions rollups are split by: UsageGroupByCustomer,
+	// UsageGroupByMetric, and/or "properties.<key>" for a property, whose
+	// values are returned in UsageRollup.Dimensions. Empty keeps the default
+	// of one rollup per customer and metric.
+	GroupBy []string
+	// Granularity is the bucket size of each rollup, one of the
+	// GranularityXxx constants. Defaults to GranularityBillingPeriod.
+	Granularity string
 }
 
+// Dimensions for UsageParams.GroupBy.
+const (
+	UsageGroupByCustomer = "customer"
+	UsageGroupByMetric   = "metric"
+)
+
+// Bucket sizes for UsageParams.Granularity.
+const (
+	GranularityHour          = "hour"
+	GranularityDay           = "day"
+	GranularityWeek          = "week"
+	GranularityBillingPeriod = "billing_period"
+)
+
 // UsageRollup is one aggregated usage record for a customer/metric/period tuple.
 type UsageRollup struct {
-	ID          string     `json:"id"`
-	OrgID       string     `json:"org_id"`
-	CustomerID  string     `json:"customer_id"`
-	MetricID    string     `json:"metric_id"`
-	PeriodStart time.Time  `json:"period_start"`
-	PeriodEnd   time.Time  `json:"period_end"`
-	Aggregation string     `json:"aggregation"`
+	ID          string      `json:"id"`
+	OrgID       string      `json:"org_id"`
+	CustomerID  string      `json:"customer_id"`
+	MetricID    string      `json:"metric_id"`
+	PeriodStart time.Time   `json:"period_start"`
+	PeriodEnd   time.Time   `json:"period_end"`
+	Aggregation Aggregation `json:"aggregation"`
+	// Dimensions holds the values of the metric's GroupBy properties for
+	// this rollup, e.g. {"endpoint": "/v1/charges"}. Nil for ungrouped metrics.
+	Dimensions map[string]string `json:"dimensions,omitempty"`
 	// Value is the aggregated usage (count, sum, max, etc.).
 	Value       float64    `json:"value"`
 	EventCount  int64      `json:"event_count"`
@@ -543,8 +1838,122 @@ type UsageRollup struct {
 type UsageQueryResult struct {
 	Rollups []UsageRollup `json:"rollups"`
 	Count   int           `json:"count"`
+	// NextCursor fetches the following page. Empty on the last page.
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
+// Allowance is how much of a metric a customer may still use in the
+// current billing period, as returned by UsageService.Allowance.
+type Allowance struct {
+	CustomerID string `json:"customer_id"`
+	MetricID   string `json:"metric_id"`
+	// Included is the allowance for the period: the plan's included units
+	// or the subscription's quota limit, whichever is lower.
+	Included float64 `json:"included"`
+	// Consumed is the usage recorded so far this period.
+	Consumed float64 `json:"consumed"`
+	// Remaining is Included minus Consumed, never below zero.
+	Remaining float64 `json:"remaining"`
+	// Unlimited is true when neither the plan nor a quota caps the metric;
+	// Included and Remaining are then zero.
+	Unlimited bool      `json:"unlimited"`
+	PeriodEnd time.Time `json:"period_end"`
+	// AsOf is when the server computed the allowance. Results served from
+	// the cache set up by WithAllowanceCache keep their original AsOf.
+	AsOf time.Time `json:"as_of"`
+}
+
+// Has reports whether the customer can use n more units.
+func (a *Allowance) Has(n float64) bool {
+	return a.Unlimited || a.Remaining >= n
+}
+
+// Periods for TopUsageParams.Period.
+const (
+	UsagePeriodCurrent    = "current"
+	UsagePeriodPrevious   = "previous"
+	UsagePeriodLast7Days  = "7d"
+	UsagePeriodLast30Days = "30d"
+)
+
+// TopUsageParams are the query parameters for GET /v1/usage/top.
+type TopUsageParams struct {
+	// MetricID is the metric to rank customers by. Required.
+	MetricID string
+	// Period is one of the UsagePeriodXxx constants. Defaults to
+	// UsagePeriodCurrent, the current billing period.
+	Period string
+	// Limit is the number of customers to return. Zero uses the server
+	// default of 10.
+	Limit int
+}
+
+// TopUsageResponse is returned by GET /v1/usage/top.
+type TopUsageResponse struct {
+	MetricID    string    `json:"metric_id"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	// Customers are ordered by Value, highest first.
+	Customers []CustomerUsage `json:"customers"`
+	Count     int             `json:"count"`
+}
+
+// CustomerUsage is one entry in a TopUsageResponse.
+type CustomerUsage struct {
+	// Rank is the 1-based position in the leaderboard.
+	Rank         int    `json:"rank"`
+	CustomerID   string `json:"customer_id"`
+	ExternalID   string `json:"external_id,omitempty"`
+	CustomerName string `json:"customer_name,omitempty"`
+	// Value is the customer's aggregated usage of the metric in the period.
+	Value      float64 `json:"value"`
+	EventCount int64   `json:"event_count"`
+	// Share is Value as a fraction (0–1) of the metric's total usage across
+	// all customers in the period.
+	Share float64 `json:"share"`
+}
+
+// RecomputeRequest is the body for POST /v1/usage/recompute.
+type RecomputeRequest struct {
+	// MetricID is the metric whose rollups are rebuilt. Required.
+	MetricID string `json:"metric_id"`
+	// CustomerID limits the rebuild to one customer's rollups. Empty
+	// rebuilds the metric's rollups for every customer.
+	CustomerID string `json:"customer_id,omitempty"`
+	// Period is one of the UsagePeriodXxx constants. Defaults to
+	// UsagePeriodCurrent, the current billing period.
+	Period string `json:"period,omitempty"`
+}
+
+// RecomputeJob tracks a rollup rebuild started by UsageService.Recompute.
+type RecomputeJob struct {
+	ID         string `json:"id"`
+	OrgID      string `json:"org_id"`
+	MetricID   string `json:"metric_id"`
+	CustomerID string `json:"customer_id,omitempty"`
+	// Status is one of the JobStatusXxx constants.
+	Status string `json:"status"`
+	// PeriodStart and PeriodEnd are the bounds the request's Period
+	// resolved to.
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	// RollupsTotal is the number of rollups being rebuilt, known once the
+	// job is running.
+	RollupsTotal      int64      `json:"rollups_total"`
+	RollupsRecomputed int64      `json:"rollups_recomputed"`
+	ErrorMessage      *string    `json:"error_message,omitempty"`
+	StartedAt         *time.Time `json:"started_at,omitempty"`
+	CompletedAt       *time.Time `json:"completed_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// Output formats for UsageService.Export.
+const (
+	ExportFormatCSV    = "csv"
+	ExportFormatNDJSON = "ndjson"
+)
+
 // ---------------------------------------------------------------------------
 // Portal token types
 // ---------------------------------------------------------------------------
@@ -581,12 +1990,41 @@ type CreatePortalTokenRequest struct {
 	ExpiresAt string `json:"expires_at,omitempty"`
 }
 
+// UpdatePortalTokenRequest is the body for PUT /v1/portal/tokens/{id}.
+// Empty fields are left unchanged; the token and its URL never change.
+type UpdatePortalTokenRequest struct {
+	Label string `json:"label,omitempty"`
+	// ExpiresAt is a new RFC3339 expiry, e.g. to extend a link that is about
+	// to lapse.
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
 // ListPortalTokensResponse is returned by GET /v1/portal/tokens.
 type ListPortalTokensResponse struct {
 	Tokens []PortalToken `json:"tokens"`
 	Count  int           `json:"count"`
 }
 
+// PortalSession is a short-lived, signed portal URL for embedding the portal
+// in your own dashboard. Unlike a PortalToken it cannot be listed or
+// shared: it stops working at ExpiresAt unless the embedded portal renews it.
+type PortalSession struct {
+	ID         string `json:"id"`
+	OrgID      string `json:"org_id"`
+	CustomerID string `json:"customer_id"`
+	// URL is the signed URL to load in an iframe.
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreatePortalSessionRequest is the body for POST /v1/portal/sessions.
+type CreatePortalSessionRequest struct {
+	CustomerExternalID string `json:"customer_external_id"`
+	// TTLSeconds is the session lifetime. Zero uses the server default.
+	TTLSeconds int64 `json:"ttl_seconds,omitempty"`
+}
+
 // ---------------------------------------------------------------------------
 // Wallet constants
 // ---------------------------------------------------------------------------
@@ -636,43 +2074,43 @@ type CustomerWallet struct {
 
 // VirtualAccount is a dedicated bank account that funds a customer wallet.
 type VirtualAccount struct {
-	ID            string          `json:"id"`
-	CustomerID    string          `json:"customer_id"`
-	WalletID      string          `json:"wallet_id"`
-	OrgID         string          `json:"org_id"`
-	Provider      string          `json:"provider"`
-	AccountNumber string          `json:"account_number"`
-	AccountName   string          `json:"account_name"`
-	BankName      string          `json:"bank_name"`
-	BankCode      string          `json:"bank_code"`
-	Currency      string          `json:"currency"`
-	ProviderRef   string          `json:"provider_ref"`
-	IsActive      bool            `json:"is_active"`
-	Metadata      json.RawMessage `json:"metadata,omitempty"`
-	CreatedAt     time.Time       `json:"created_at"`
-	UpdatedAt     time.Time       `json:"updated_at"`
+	ID            string    `json:"id"`
+	CustomerID    string    `json:"customer_id"`
+	WalletID      string    `json:"wallet_id"`
+	OrgID         string    `json:"org_id"`
+	Provider      string    `json:"provider"`
+	AccountNumber string    `json:"account_number"`
+	AccountName   string    `json:"account_name"`
+	BankName      string    `json:"bank_name"`
+	BankCode      string    `json:"bank_code"`
+	Currency      string    `json:"currency"`
+	ProviderRef   string    `json:"provider_ref"`
+	IsActive      bool      `json:"is_active"`
+	Metadata      Metadata  `json:"metadata,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
 }
 
 // LedgerEntry is one side of a double-entry accounting record.
 type LedgerEntry struct {
-	ID             string          `json:"id"`
-	OrgID          string          `json:"org_id"`
-	TransactionID  string          `json:"transaction_id"`
-	WalletID       *string         `json:"wallet_id,omitempty"`
-	AccountType    string          `json:"account_type"`
-	AccountID      string          `json:"account_id"`
-	Direction      string          `json:"direction"`
-	Amount         string          `json:"amount"`
-	Currency       string          `json:"currency"`
-	BalanceBefore  string          `json:"balance_before"`
-	BalanceAfter   string          `json:"balance_after"`
-	Description    string          `json:"description"`
-	EntryType      string          `json:"entry_type"`
-	ReferenceType  string          `json:"reference_type"`
-	ReferenceID    string          `json:"reference_id"`
-	IdempotencyKey string          `json:"idempotency_key"`
-	Metadata       json.RawMessage `json:"metadata,omitempty"`
-	CreatedAt      time.Time       `json:"created_at"`
+	ID             string    `json:"id"`
+	OrgID          string    `json:"org_id"`
+	TransactionID  string    `json:"transaction_id"`
+	WalletID       *string   `json:"wallet_id,omitempty"`
+	AccountType    string    `json:"account_type"`
+	AccountID      string    `json:"account_id"`
+	Direction      string    `json:"direction"`
+	Amount         string    `json:"amount"`
+	Currency       string    `json:"currency"`
+	BalanceBefore  string    `json:"balance_before"`
+	BalanceAfter   string    `json:"balance_after"`
+	Description    string    `json:"description"`
+	EntryType      string    `json:"entry_type"`
+	ReferenceType  string    `json:"reference_type"`
+	ReferenceID    string    `json:"reference_id"`
+	IdempotencyKey string    `json:"idempotency_key"`
+	Metadata       Metadata  `json:"metadata,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
 }
 
 // GetOrCreateWalletRequest is the body for POST /v1/wallets.
@@ -760,15 +2198,38 @@ type ListVirtualAccountsResponse struct {
 // Event replay types
 // ---------------------------------------------------------------------------
 
+// ReplayRequest is the body for POST /v1/events/replay. Only From and To are
+// required; the other fields narrow the replay so that fixing one
+// customer's bill does not reprocess the whole organisation's events.
+type ReplayRequest struct {
+	// From and To bound the event timestamps to replay, [From, To).
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+	// EventName replays only events with this name.
+	EventName string `json:"event_name,omitempty"`
+	// CustomerID replays only this customer's events.
+	CustomerID string `json:"customer_id,omitempty"`
+	// MetricID replays only the events this metric counts.
+	MetricID string `json:"metric_id,omitempty"`
+	// DryRun counts the events the replay would process, in
+	// EventReplayJob.EventsTotal, without replaying them.
+	DryRun bool `json:"dry_run,omitempty"`
+}
+
 // EventReplayJob tracks the progress of an event replay operation.
 type EventReplayJob struct {
-	ID             string     `json:"id"`
-	OrgID          string     `json:"org_id"`
-	InitiatedBy    string     `json:"initiated_by"`
-	Status         string     `json:"status"`
-	FromTimestamp  time.Time  `json:"from_timestamp"`
-	ToTimestamp    time.Time  `json:"to_timestamp"`
-	EventName      *string    `json:"event_name,omitempty"`
+	ID            string    `json:"id"`
+	OrgID         string    `json:"org_id"`
+	InitiatedBy   string    `json:"initiated_by"`
+	Status        string    `json:"status"`
+	FromTimestamp time.Time `json:"from_timestamp"`
+	ToTimestamp   time.Time `json:"to_timestamp"`
+	EventName     *string   `json:"event_name,omitempty"`
+	// CustomerID and MetricID are set when the replay was scoped to them.
+	CustomerID string `json:"customer_id,omitempty"`
+	MetricID   string `json:"metric_id,omitempty"`
+	// DryRun is true when the job only counted the events in scope.
+	DryRun         bool       `json:"dry_run,omitempty"`
 	IsTest         bool       `json:"is_test"`
 	EventsTotal    int64      `json:"events_total"`
 	EventsReplayed int64      `json:"events_replayed"`
@@ -778,3 +2239,881 @@ type EventReplayJob struct {
 	CreatedAt      time.Time  `json:"created_at"`
 	UpdatedAt      time.Time  `json:"updated_at"`
 }
+
+// ListReplaysParams are optional query parameters for GET /v1/events/replay.
+type ListReplaysParams struct {
+	// Status filters by JobStatusXxx.
+	Status string
+	// CreatedAfter and CreatedBefore restrict jobs by when they were started.
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// Limit caps the number of jobs returned, newest first. Zero uses the
+	// server default.
+	Limit int
+}
+
+// ListReplaysResponse is returned by GET /v1/events/replay.
+type ListReplaysResponse struct {
+	Jobs  []EventReplayJob `json:"jobs"`
+	Count int              `json:"count"`
+}
+
+// ---------------------------------------------------------------------------
+// Credit wallet constants
+// ---------------------------------------------------------------------------
+
+const (
+	// CreditTransactionTypeGrant adds prepaid credit to a customer's balance.
+	CreditTransactionTypeGrant = "grant"
+	// CreditTransactionTypeDeduct manually removes credit from the balance.
+	CreditTransactionTypeDeduct = "deduct"
+	// CreditTransactionTypeApplied is credit consumed by an invoice.
+	CreditTransactionTypeApplied = "applied"
+	// CreditTransactionTypeExpired is the unused remainder of a grant
+	// removed when it reached its ExpiresAt.
+	CreditTransactionTypeExpired = "expired"
+)
+
+// ---------------------------------------------------------------------------
+// Credit wallet types
+// ---------------------------------------------------------------------------
+
+// CreditBalance is a customer's remaining prepaid credit.
+// All monetary values are decimal strings (e.g. "2500.00").
+type CreditBalance struct {
+	CustomerID string    `json:"customer_id"`
+	Currency   string    `json:"currency"`
+	Balance    string    `json:"balance"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// CreditTransaction is one movement on a customer's credit balance.
+type CreditTransaction struct {
+	ID         string `json:"id"`
+	OrgID      string `json:"org_id"`
+	CustomerID string `json:"customer_id"`
+	// Type is one of the CreditTransactionTypeXxx constants.
+	Type         string `json:"type"`
+	Amount       string `json:"amount"`
+	Currency     string `json:"currency"`
+	BalanceAfter string `json:"balance_after"`
+	Description  string `json:"description,omitempty"`
+	// InvoiceID is set on "applied" transactions to the invoice that consumed the credit.
+	InvoiceID *string `json:"invoice_id,omitempty"`
+	// GrantID is set on "applied" and "expired" transactions to the grant
+	// the credit came from.
+	GrantID string `json:"grant_id,omitempty"`
+	// ExpiresAt and Priority are set on "grant" transactions; see
+	// GrantCreditsRequest.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Priority  int        `json:"priority,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// CreditGrant is a grant of prepaid credit and how much of it is left.
+// Invoices consume grants in order of Priority (lowest first), then
+// earliest ExpiresAt, then oldest grant.
+type CreditGrant struct {
+	// ID is the ID of the CreditTransactionTypeGrant transaction.
+	ID         string `json:"id"`
+	CustomerID string `json:"customer_id"`
+	Amount     string `json:"amount"`
+	// Remaining is the part of Amount not yet applied or expired.
+	Remaining   string     `json:"remaining"`
+	Currency    string     `json:"currency"`
+	Priority    int        `json:"priority"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	Description string     `json:"description,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ListCreditGrantsParams are the optional query parameters for
+// GET /v1/customers/{id}/credits/grants.
+type ListCreditGrantsParams struct {
+	// IncludeExhausted also returns grants that are fully applied or
+	// expired, which are omitted by default.
+	IncludeExhausted bool
+}
+
+// ListCreditGrantsResponse is returned by GET /v1/customers/{id}/credits/grants.
+// Grants are listed in the order invoices consume them.
+type ListCreditGrantsResponse struct {
+	Grants []CreditGrant `json:"grants"`
+	Count  int           `json:"count"`
+}
+
+// CreditApplication records credit from one grant applied to an invoice.
+type CreditApplication struct {
+	ID        string    `json:"id"`
+	InvoiceID string    `json:"invoice_id"`
+	GrantID   string    `json:"grant_id"`
+	Amount    string    `json:"amount"`
+	Currency  string    `json:"currency"`
+	AppliedAt time.Time `json:"applied_at"`
+}
+
+// ListCreditApplicationsResponse is returned by
+// GET /v1/invoices/{id}/credit-applications.
+type ListCreditApplicationsResponse struct {
+	Applications []CreditApplication `json:"applications"`
+	Count        int                 `json:"count"`
+}
+
+// GrantCreditsRequest is the body for POST /v1/customers/{id}/credits/grant.
+type GrantCreditsRequest struct {
+	// Amount is the credit to add, as a decimal string (e.g. "5000.00").
+	Amount string `json:"amount"`
+	// Currency is the ISO 4217 currency code of the credit.
+	Currency string `json:"currency"`
+	// Description is an optional note shown on the transaction.
+	Description string `json:"description,omitempty"`
+	// ExpiresAt is when any unused part of the grant is removed from the
+	// balance. Nil means the credit never expires.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Priority orders grants for consumption: lower values are applied to
+	// invoices first. Defaults to 0.
+	Priority int `json:"priority,omitempty"`
+}
+
+// DeductCreditsRequest is the body for POST /v1/customers/{id}/credits/deduct.
+type DeductCreditsRequest struct {
+	// Amount is the credit to remove, as a decimal string (e.g. "1000.00").
+	Amount string `json:"amount"`
+	// Currency is the ISO 4217 currency code of the credit.
+	Currency string `json:"currency"`
+	// Description is an optional note shown on the transaction.
+	Description string `json:"description,omitempty"`
+}
+
+// ListCreditTransactionsResponse is returned by GET /v1/customers/{id}/credits/transactions.
+type ListCreditTransactionsResponse struct {
+	Transactions []CreditTransaction `json:"transactions"`
+	Total        int                 `json:"total"`
+	Limit        int                 `json:"limit"`
+	Offset       int                 `json:"offset"`
+}
+
+// ---------------------------------------------------------------------------
+// Coupon constants
+// ---------------------------------------------------------------------------
+
+const (
+	// CouponTypePercentage discounts a percentage of the invoice subtotal.
+	CouponTypePercentage = "percentage"
+	// CouponTypeFixedAmount discounts a fixed amount in the coupon's currency.
+	CouponTypeFixedAmount = "fixed_amount"
+)
+
+const (
+	// CouponDurationOnce applies the discount to the first invoice only.
+	CouponDurationOnce = "once"
+	// CouponDurationRepeating applies the discount for DurationPeriods billing periods.
+	CouponDurationRepeating = "repeating"
+	// CouponDurationForever applies the discount to every invoice.
+	CouponDurationForever = "forever"
+)
+
+// ---------------------------------------------------------------------------
+// Coupon types
+// ---------------------------------------------------------------------------
+
+// Coupon is a reusable discount that can be applied to subscriptions.
+type Coupon struct {
+	ID    string `json:"id"`
+	OrgID string `json:"org_id"`
+	// Code is the customer-facing redemption code (e.g. "LAUNCH20").
+	Code string `json:"code"`
+	Name string `json:"name"`
+	// Type is one of the CouponTypeXxx constants.
+	Type string `json:"type"`
+	// PercentOff is set for percentage coupons (e.g. "20.00").
+	PercentOff string `json:"percent_off,omitempty"`
+	// AmountOff is set for fixed-amount coupons, in Currency.
+	AmountOff string `json:"amount_off,omitempty"`
+	Currency  string `json:"currency,omitempty"`
+	// Duration is one of the CouponDurationXxx constants.
+	Duration string `json:"duration"`
+	// DurationPeriods is the number of billing periods a repeating coupon lasts.
+	DurationPeriods int32      `json:"duration_periods,omitempty"`
+	MaxRedemptions  int32      `json:"max_redemptions,omitempty"`
+	TimesRedeemed   int32      `json:"times_redeemed"`
+	ExpiresAt       *time.Time `json:"expires_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// CreateCouponRequest is the body for POST /v1/coupons.
+type CreateCouponRequest struct {
+	// Code is the customer-facing redemption code. Must be unique in the organisation.
+	Code string `json:"code"`
+	// Name is an optional display name shown on invoices.
+	Name string `json:"name,omitempty"`
+	// Type is either CouponTypePercentage or CouponTypeFixedAmount.
+	Type string `json:"type"`
+	// PercentOff is required for percentage coupons, as a decimal string (e.g. "20.00").
+	PercentOff string `json:"percent_off,omitempty"`
+	// AmountOff is required for fixed-amount coupons, as a decimal string.
+	AmountOff string `json:"amount_off,omitempty"`
+	// Currency is required for fixed-amount coupons.
+	Currency string `json:"currency,omitempty"`
+	// Duration is one of the CouponDurationXxx constants.
+	Duration string `json:"duration"`
+	// DurationPeriods is required when Duration is CouponDurationRepeating.
+	DurationPeriods int32 `json:"duration_periods,omitempty"`
+	// MaxRedemptions optionally caps how many subscriptions may use the coupon.
+	MaxRedemptions int32 `json:"max_redemptions,omitempty"`
+	// ExpiresAt is an optional time after which the coupon can no longer be applied.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// ListCouponsResponse is returned by GET /v1/coupons.
+type ListCouponsResponse struct {
+	Coupons []Coupon `json:"coupons"`
+	Count   int      `json:"count"`
+}
+
+// ApplyCouponRequest is the body for POST /v1/subscriptions/{id}/coupon.
+// Set either CouponID or Code.
+type ApplyCouponRequest struct {
+	CouponID string `json:"coupon_id,omitempty"`
+	Code     string `json:"code,omitempty"`
+}
+
+// AppliedCoupon describes a coupon's effect on a single invoice.
+type AppliedCoupon struct {
+	CouponID   string `json:"coupon_id"`
+	Code       string `json:"code"`
+	Name       string `json:"name,omitempty"`
+	Type       string `json:"type"`
+	PercentOff string `json:"percent_off,omitempty"`
+	AmountOff  string `json:"amount_off,omitempty"`
+	// DiscountAmount is the amount this coupon took off the invoice.
+	DiscountAmount string `json:"discount_amount"`
+	// PeriodsRemaining is how many more billing periods a repeating coupon
+	// will apply for. Nil for once and forever coupons.
+	PeriodsRemaining *int32 `json:"periods_remaining,omitempty"`
+}
+
+// ---------------------------------------------------------------------------
+// Alert types
+// ---------------------------------------------------------------------------
+
+// AlertWebhookEventTriggered is the Type of the webhook sent when an alert
+// threshold is crossed.
+const AlertWebhookEventTriggered = "alert.triggered"
+
+// Alert notifies you when a customer's usage of a metric crosses a threshold
+// within a billing period.
+type Alert struct {
+	ID    string `json:"id"`
+	OrgID string `json:"org_id"`
+	// CustomerID scopes the alert to one customer. Empty means every customer.
+	CustomerID string `json:"customer_id,omitempty"`
+	MetricID   string `json:"metric_id"`
+	// ThresholdPercent is the percentage of the plan's included units that
+	// triggers the alert (e.g. 80). Zero when ThresholdValue is used.
+	ThresholdPercent float64 `json:"threshold_percent,omitempty"`
+	// ThresholdValue is an absolute usage value that triggers the alert.
+	// Zero when ThresholdPercent is used.
+	ThresholdValue float64 `json:"threshold_value,omitempty"`
+	// WebhookURL receives an AlertWebhookPayload when the alert fires.
+	WebhookURL      string     `json:"webhook_url,omitempty"`
+	Enabled         bool       `json:"enabled"`
+	LastTriggeredAt *time.Time `json:"last_triggered_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// CreateAlertRequest is the body for POST /v1/alerts.
+// Set exactly one of ThresholdPercent or ThresholdValue.
+type CreateAlertRequest struct {
+	// CustomerID optionally scopes the alert to a single customer.
+	CustomerID string `json:"customer_id,omitempty"`
+	// MetricID is the UUID of the metric to watch.
+	MetricID string `json:"metric_id"`
+	// ThresholdPercent fires the alert at this percentage of included units.
+	ThresholdPercent float64 `json:"threshold_percent,omitempty"`
+	// ThresholdValue fires the alert at this absolute usage value.
+	ThresholdValue float64 `json:"threshold_value,omitempty"`
+	// WebhookURL is the endpoint notified when the alert fires. Defaults to
+	// the organisation's webhook endpoint.
+	WebhookURL string `json:"webhook_url,omitempty"`
+}
+
+// ListAlertsParams are optional query parameters for GET /v1/alerts.
+type ListAlertsParams struct {
+	// CustomerID filters alerts to a specific customer.
+	CustomerID string
+	// MetricID filters alerts to a specific metric.
+	MetricID string
+}
+
+// ListAlertsResponse is returned by GET /v1/alerts.
+type ListAlertsResponse struct {
+	Alerts []Alert `json:"alerts"`
+	Count  int     `json:"count"`
+}
+
+// AlertWebhookPayload is the body Monigo POSTs to an alert's webhook URL
+// when its threshold is crossed. Decode it with ParseAlertWebhook.
+type AlertWebhookPayload struct {
+	// Type is always AlertWebhookEventTriggered.
+	Type             string  `json:"type"`
+	AlertID          string  `json:"alert_id"`
+	CustomerID       string  `json:"customer_id"`
+	MetricID         string  `json:"metric_id"`
+	ThresholdPercent float64 `json:"threshold_percent,omitempty"`
+	ThresholdValue   float64 `json:"threshold_value,omitempty"`
+	// IncludedUnits is the allowance on the customer's plan for the metric.
+	IncludedUnits float64 `json:"included_units"`
+	// CurrentUsage is the customer's usage in the period when the alert fired.
+	CurrentUsage float64   `json:"current_usage"`
+	PeriodStart  time.Time `json:"period_start"`
+	PeriodEnd    time.Time `json:"period_end"`
+	TriggeredAt  time.Time `json:"triggered_at"`
+}
+
+// ---------------------------------------------------------------------------
+// Quota types
+// ---------------------------------------------------------------------------
+
+// What happens to events past a quota's Limit, for Quota.Behavior.
+const (
+	// QuotaBehaviorBlock rejects events past the limit.
+	QuotaBehaviorBlock QuotaBehavior = "block"
+	// QuotaBehaviorNotify ingests the events and sends a quota.exceeded
+	// webhook the first time the limit is crossed in a period.
+	QuotaBehaviorNotify QuotaBehavior = "notify"
+	// QuotaBehaviorBillOverage ingests the events and bills the usage past
+	// the limit at the price's overage rate.
+	QuotaBehaviorBillOverage QuotaBehavior = "bill_overage"
+)
+
+// Quota caps a subscription's usage of a metric per billing period.
+type Quota struct {
+	ID             string `json:"id"`
+	OrgID          string `json:"org_id"`
+	SubscriptionID string `json:"subscription_id"`
+	MetricID       string `json:"metric_id"`
+	// Limit is the most usage allowed per billing period, in the metric's
+	// aggregated units.
+	Limit float64 `json:"limit"`
+	// Behavior is one of the QuotaBehaviorXxx constants.
+	Behavior QuotaBehavior `json:"behavior"`
+	// CurrentUsage is the subscription's usage of the metric so far this
+	// period.
+	CurrentUsage float64   `json:"current_usage"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// CreateQuotaRequest is the body for POST /v1/quotas.
+type CreateQuotaRequest struct {
+	SubscriptionID string  `json:"subscription_id"`
+	MetricID       string  `json:"metric_id"`
+	Limit          float64 `json:"limit"`
+	// Behavior is one of the QuotaBehaviorXxx constants. Defaults to
+	// QuotaBehaviorBlock.
+	Behavior QuotaBehavior `json:"behavior,omitempty"`
+}
+
+// UpdateQuotaRequest is the body for PATCH /v1/quotas/{id}.
+// Nil and empty fields are left unchanged.
+type UpdateQuotaRequest struct {
+	Limit    *float64      `json:"limit,omitempty"`
+	Behavior QuotaBehavior `json:"behavior,omitempty"`
+}
+
+// ListQuotasParams are optional query parameters for GET /v1/quotas.
+type ListQuotasParams struct {
+	// SubscriptionID filters quotas to a specific subscription.
+	SubscriptionID string
+	// MetricID filters quotas to a specific metric.
+	MetricID string
+}
+
+// ListQuotasResponse is returned by GET /v1/quotas.
+type ListQuotasResponse struct {
+	Quotas []Quota `json:"quotas"`
+	Count  int     `json:"count"`
+}
+
+// OverQuotaEvent reports an ingested event that went past a quota, in
+// IngestResponse.OverQuota.
+type OverQuotaEvent struct {
+	IdempotencyKey string        `json:"idempotency_key"`
+	QuotaID        string        `json:"quota_id"`
+	SubscriptionID string        `json:"subscription_id"`
+	MetricID       string        `json:"metric_id"`
+	Behavior       QuotaBehavior `json:"behavior"`
+	Limit          float64       `json:"limit"`
+	// Usage is the period's usage including the event, or what it would
+	// have been for a blocked event.
+	Usage float64 `json:"usage"`
+}
+
+// ---------------------------------------------------------------------------
+// Dunning types
+// ---------------------------------------------------------------------------
+
+// Actions taken when dunning is exhausted, for DunningPolicy.ExhaustedAction.
+const (
+	// DunningExhaustedNone leaves the subscription running.
+	DunningExhaustedNone DunningExhaustedAction = "none"
+	// DunningExhaustedPause pauses the invoice's subscription.
+	DunningExhaustedPause DunningExhaustedAction = "pause_subscription"
+	// DunningExhaustedCancel cancels the invoice's subscription.
+	DunningExhaustedCancel DunningExhaustedAction = "cancel_subscription"
+)
+
+// Types of the webhooks sent as dunning progresses. Decode them with
+// ParseDunningWebhook.
+const (
+	DunningWebhookReminderSent   DunningWebhookType = "dunning.reminder_sent"
+	DunningWebhookRetryFailed    DunningWebhookType = "dunning.retry_failed"
+	DunningWebhookRetrySucceeded DunningWebhookType = "dunning.retry_succeeded"
+	DunningWebhookExhausted      DunningWebhookType = "dunning.exhausted"
+)
+
+// DunningPolicy is the organisation's schedule for chasing overdue
+// invoices: when reminders are emailed, when invoices on AutoCharge
+// subscriptions are retried, and what happens when both run out.
+type DunningPolicy struct {
+	OrgID   string `json:"org_id"`
+	Enabled bool   `json:"enabled"`
+	// ReminderDays are the days relative to the due date reminders are
+	// sent on. Negative values send a reminder before the due date, e.g.
+	// []int{-3, 1, 7, 14}.
+	ReminderDays []int `json:"reminder_days"`
+	// RetryDays are the days after the due date a failed automatic charge
+	// is retried on.
+	RetryDays []int `json:"retry_days"`
+	// MaxRetryAttempts caps the number of automatic charge retries. Zero
+	// means one retry per RetryDays entry.
+	MaxRetryAttempts int `json:"max_retry_attempts"`
+	// EscalationWebhookURL receives a DunningWebhookExhausted event when
+	// dunning is exhausted, in addition to the organisation's webhook
+	// endpoint.
+	EscalationWebhookURL string `json:"escalation_webhook_url,omitempty"`
+	// ExhaustedAction is a DunningExhaustedXxx constant.
+	ExhaustedAction DunningExhaustedAction `json:"exhausted_action"`
+	UpdatedAt       time.Time              `json:"updated_at"`
+}
+
+// UpdateDunningPolicyRequest is the body for PUT /v1/dunning/policy.
+// Nil fields are left unchanged.
+type UpdateDunningPolicyRequest struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	// ReminderDays replaces the reminder schedule when non-nil. Point it
+	// at an empty slice to send no reminders.
+	ReminderDays *[]int `json:"reminder_days,omitempty"`
+	// RetryDays replaces the retry schedule when non-nil. Point it at an
+	// empty slice to disable retries.
+	RetryDays            *[]int                 `json:"retry_days,omitempty"`
+	MaxRetryAttempts     *int                   `json:"max_retry_attempts,omitempty"`
+	EscalationWebhookURL *string                `json:"escalation_webhook_url,omitempty"`
+	ExhaustedAction      DunningExhaustedAction `json:"exhausted_action,omitempty"`
+}
+
+// DunningWebhookPayload is the body Monigo POSTs for dunning events.
+type DunningWebhookPayload struct {
+	// Type is one of the DunningWebhookXxx constants.
+	Type       DunningWebhookType `json:"type"`
+	InvoiceID  string             `json:"invoice_id"`
+	CustomerID string             `json:"customer_id"`
+	// SubscriptionID is the invoice's subscription, if any.
+	SubscriptionID string `json:"subscription_id,omitempty"`
+	// Attempt is the 1-based reminder or retry number.
+	Attempt int `json:"attempt,omitempty"`
+	// AmountDue is what the customer still owes, as a decimal string.
+	AmountDue string `json:"amount_due"`
+	Currency  string `json:"currency"`
+	// FailureReason is the payment provider's decline reason on
+	// DunningWebhookRetryFailed events.
+	FailureReason string `json:"failure_reason,omitempty"`
+	// NextAttemptAt is when the next reminder or retry is scheduled. Nil
+	// once dunning is exhausted.
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	OccurredAt    time.Time  `json:"occurred_at"`
+}
+
+// ---------------------------------------------------------------------------
+// Report types
+// ---------------------------------------------------------------------------
+
+// TrialConversionParams are the optional query parameters for
+// GET /v1/reports/trial-conversions.
+type TrialConversionParams struct {
+	// PlanID restricts the report to a single plan.
+	PlanID string
+	// From is the inclusive lower bound on trial start time.
+	// Defaults to 30 days before To.
+	From *time.Time
+	// To is the exclusive upper bound on trial start time. Defaults to now.
+	To *time.Time
+}
+
+// PlanTrialConversion summarises trial outcomes for one plan.
+type PlanTrialConversion struct {
+	PlanID   string `json:"plan_id"`
+	PlanName string `json:"plan_name"`
+	// TrialsStarted is the number of trials that began in the period.
+	TrialsStarted int64 `json:"trials_started"`
+	// Converted is the number of those trials that became paid subscriptions.
+	Converted int64 `json:"converted"`
+	// Expired is the number of trials that ended without converting.
+	Expired int64 `json:"expired"`
+	// InTrial is the number of trials still running at the end of the period.
+	InTrial int64 `json:"in_trial"`
+	// ConversionRate is Converted / (Converted + Expired), between 0 and 1.
+	ConversionRate float64 `json:"conversion_rate"`
+}
+
+// TrialConversionReport is returned by GET /v1/reports/trial-conversions.
+type TrialConversionReport struct {
+	From  time.Time             `json:"from"`
+	To    time.Time             `json:"to"`
+	Plans []PlanTrialConversion `json:"plans"`
+}
+
+// ---------------------------------------------------------------------------
+// Dispute types
+// ---------------------------------------------------------------------------
+
+// Dispute statuses.
+const (
+	// DisputeStatusOpen means the dispute is awaiting resolution.
+	DisputeStatusOpen = "open"
+	// DisputeStatusAdjusted means the dispute was resolved in the customer's
+	// favour and the invoice amount was reduced.
+	DisputeStatusAdjusted = "adjusted"
+	// DisputeStatusUpheld means the dispute was resolved with the invoice
+	// standing as issued.
+	DisputeStatusUpheld = "upheld"
+)
+
+// Dispute records a customer's challenge to an invoice.
+type Dispute struct {
+	ID         string `json:"id"`
+	OrgID      string `json:"org_id"`
+	InvoiceID  string `json:"invoice_id"`
+	CustomerID string `json:"customer_id"`
+	// Status is one of DisputeStatusOpen, DisputeStatusAdjusted, or
+	// DisputeStatusUpheld.
+	Status string `json:"status"`
+	// Reason is the customer's stated reason for the dispute.
+	Reason string `json:"reason"`
+	// AdjustmentAmount is the amount taken off the invoice when the dispute
+	// was adjusted, as a decimal string.
+	AdjustmentAmount string `json:"adjustment_amount,omitempty"`
+	// ResolutionNote explains the outcome.
+	ResolutionNote string     `json:"resolution_note,omitempty"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// OpenDisputeRequest is the body for POST /v1/invoices/{id}/disputes.
+type OpenDisputeRequest struct {
+	Reason string `json:"reason"`
+}
+
+// ListDisputesParams are optional query parameters for GET /v1/disputes.
+type ListDisputesParams struct {
+	// Status filters by dispute status, e.g. DisputeStatusOpen.
+	Status string
+	// InvoiceID filters disputes to a specific invoice.
+	InvoiceID string
+	// CustomerID filters disputes to a specific customer.
+	CustomerID string
+}
+
+// ListDisputesResponse is returned by GET /v1/disputes.
+type ListDisputesResponse struct {
+	Disputes []Dispute `json:"disputes"`
+	Count    int       `json:"count"`
+}
+
+// DisputeComment is one entry in a dispute's comment thread.
+type DisputeComment struct {
+	ID        string `json:"id"`
+	DisputeID string `json:"dispute_id"`
+	// Author identifies who wrote the comment, e.g. a team member's email.
+	Author    string    `json:"author,omitempty"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AddDisputeCommentRequest is the body for POST /v1/disputes/{id}/comments.
+type AddDisputeCommentRequest struct {
+	Body   string `json:"body"`
+	Author string `json:"author,omitempty"`
+}
+
+// ListDisputeCommentsResponse is returned by GET /v1/disputes/{id}/comments.
+type ListDisputeCommentsResponse struct {
+	Comments []DisputeComment `json:"comments"`
+	Count    int              `json:"count"`
+}
+
+// AdjustDisputeRequest is the body for POST /v1/disputes/{id}/adjust.
+type AdjustDisputeRequest struct {
+	// Amount is the decimal amount to take off the invoice, e.g. "1500.00".
+	Amount string `json:"amount"`
+	// Note explains the adjustment; it is stored as the resolution note.
+	Note string `json:"note,omitempty"`
+}
+
+// UpholdDisputeRequest is the body for POST /v1/disputes/{id}/uphold.
+type UpholdDisputeRequest struct {
+	// Note explains why the invoice stands; it is stored as the resolution note.
+	Note string `json:"note,omitempty"`
+}
+
+// ---------------------------------------------------------------------------
+// Tax types
+// ---------------------------------------------------------------------------
+
+// Tax rate types.
+const (
+	// TaxTypeVAT is value-added tax charged on top of (or included in) the
+	// invoice subtotal.
+	TaxTypeVAT = "vat"
+	// TaxTypeWithholding is withholding tax deducted from the amount paid.
+	TaxTypeWithholding = "withholding"
+)
+
+// TaxRate is a tax applied to invoices for customers in a jurisdiction.
+type TaxRate struct {
+	ID    string `json:"id"`
+	OrgID string `json:"org_id"`
+	Name  string `json:"name"`
+	// Type is TaxTypeVAT or TaxTypeWithholding.
+	Type string `json:"type"`
+	// Jurisdiction is an ISO 3166-1 alpha-2 country code, optionally with a
+	// subdivision, e.g. "NG" or "KE-30".
+	Jurisdiction string `json:"jurisdiction"`
+	// Percent is the rate as a decimal string, e.g. "7.5".
+	Percent   string    `json:"percent"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateTaxRateRequest is the body for POST /v1/tax-rates.
+type CreateTaxRateRequest struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Jurisdiction string `json:"jurisdiction"`
+	Percent      string `json:"percent"`
+}
+
+// UpdateTaxRateRequest is the body for PUT /v1/tax-rates/{id}.
+// Changes apply to invoices finalized afterwards.
+type UpdateTaxRateRequest struct {
+	Name    string `json:"name,omitempty"`
+	Percent string `json:"percent,omitempty"`
+	Active  *bool  `json:"active,omitempty"`
+}
+
+// ListTaxRatesParams are optional query parameters for GET /v1/tax-rates.
+type ListTaxRatesParams struct {
+	// Jurisdiction filters rates to one jurisdiction.
+	Jurisdiction string
+	// Type filters by TaxTypeXxx.
+	Type string
+}
+
+// ListTaxRatesResponse is returned by GET /v1/tax-rates.
+type ListTaxRatesResponse struct {
+	TaxRates []TaxRate `json:"tax_rates"`
+	Count    int       `json:"count"`
+}
+
+// InvoiceTaxOverride is the body for PUT /v1/invoices/{id}/tax. It replaces
+// the tax rates that would otherwise apply to a draft invoice.
+type InvoiceTaxOverride struct {
+	// TaxRateIDs are the rates to apply instead of the jurisdiction defaults.
+	TaxRateIDs []string `json:"tax_rate_ids,omitempty"`
+	// Exempt removes all tax from the invoice.
+	Exempt bool `json:"exempt,omitempty"`
+	// ExemptReason is printed on the invoice when Exempt is set, e.g. a
+	// certificate number.
+	ExemptReason string `json:"exempt_reason,omitempty"`
+}
+
+// ---------------------------------------------------------------------------
+// Currency types
+// ---------------------------------------------------------------------------
+
+// Currency is a currency Monigo can bill in.
+type Currency struct {
+	// Code is the ISO 4217 code, e.g. "KES".
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Symbol string `json:"symbol,omitempty"`
+	// MinorUnits is the number of decimal places used for amounts, e.g. 2.
+	MinorUnits int `json:"minor_units"`
+}
+
+// ListCurrenciesResponse is returned by GET /v1/currencies.
+type ListCurrenciesResponse struct {
+	Currencies []Currency `json:"currencies"`
+	Count      int        `json:"count"`
+}
+
+// ExchangeRate records the currency conversion applied to an invoice.
+type ExchangeRate struct {
+	// From is the invoice currency.
+	From string `json:"from"`
+	// To is the organisation's reporting currency.
+	To string `json:"to"`
+	// Rate is the number of To units per From unit, as a decimal string.
+	Rate string `json:"rate"`
+	// Source names the rate provider.
+	Source string `json:"source,omitempty"`
+	// AsOf is when the rate was captured.
+	AsOf time.Time `json:"as_of"`
+}
+
+// ---------------------------------------------------------------------------
+// Bank directory types
+// ---------------------------------------------------------------------------
+
+// Bank is a bank or mobile-money provider that payouts can be sent to.
+type Bank struct {
+	// Code identifies the institution in CreatePayoutAccountRequest.BankCode
+	// and VerifyAccountRequest.BankCode.
+	Code string `json:"code"`
+	Name string `json:"name"`
+	// Country is the ISO 3166-1 alpha-2 country code, e.g. "NG".
+	Country  string `json:"country"`
+	Currency string `json:"currency"`
+	// PayoutMethods lists the PayoutMethodXxx values the institution
+	// supports.
+	PayoutMethods []PayoutMethod `json:"payout_methods"`
+	// SupportsVerification is true when account names can be resolved with
+	// PayoutAccountService.Verify.
+	SupportsVerification bool `json:"supports_verification"`
+}
+
+// ListBanksResponse is returned by GET /v1/banks.
+type ListBanksResponse struct {
+	Banks []Bank `json:"banks"`
+	Count int    `json:"count"`
+}
+
+// ---------------------------------------------------------------------------
+// Audit log types
+// ---------------------------------------------------------------------------
+
+// Actor types for AuditActor.Type.
+const (
+	AuditActorUser   = "user"
+	AuditActorAPIKey = "api_key"
+	AuditActorSystem = "system"
+)
+
+// AuditLogEntry records one change made in the organisation.
+type AuditLogEntry struct {
+	ID    string     `json:"id"`
+	OrgID string     `json:"org_id"`
+	Actor AuditActor `json:"actor"`
+	// Action is "<resource>.<verb>", e.g. "plan.updated", "invoice.voided",
+	// or "api_key.created".
+	Action       string `json:"action"`
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+	// Changes maps each modified field to its old and new values. Empty for
+	// actions that do not modify fields, such as deletions.
+	Changes   map[string]AuditChange `json:"changes,omitempty"`
+	IPAddress string                 `json:"ip_address,omitempty"`
+	UserAgent string                 `json:"user_agent,omitempty"`
+	// RequestID is the X-Request-Id of the API request that made the change.
+	RequestID string    `json:"request_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AuditActor identifies who performed an audited action.
+type AuditActor struct {
+	// Type is one of the AuditActorXxx constants.
+	Type  string `json:"type"`
+	ID    string `json:"id"`
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+// AuditChange is the before and after value of one field. Either side is
+// null when the field was added or removed.
+type AuditChange struct {
+	Old json.RawMessage `json:"old"`
+	New json.RawMessage `json:"new"`
+}
+
+// ListAuditLogsParams are the optional query parameters for
+// GET /v1/audit-logs.
+type ListAuditLogsParams struct {
+	ActorID string
+	// Action filters to one action, e.g. "invoice.voided".
+	Action       string
+	ResourceType string
+	ResourceID   string
+	// From is the inclusive lower bound on CreatedAt.
+	From *time.Time
+	// To is the exclusive upper bound on CreatedAt.
+	To *time.Time
+	// Cursor continues a previous listing; pass the NextCursor of the last
+	// page.
+	Cursor string
+	// Limit is the page size. Zero uses the server default.
+	Limit int
+}
+
+// ListAuditLogsResponse is returned by GET /v1/audit-logs.
+type ListAuditLogsResponse struct {
+	Entries []AuditLogEntry `json:"audit_logs"`
+	Count   int             `json:"count"`
+	// NextCursor fetches the following page. Empty on the last page.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// ---------------------------------------------------------------------------
+// Test clock types
+// ---------------------------------------------------------------------------
+
+// Test clock statuses.
+const (
+	// TestClockStatusReady means the clock is idle at FrozenTime.
+	TestClockStatusReady = "ready"
+	// TestClockStatusAdvancing means billing work between the old and new
+	// FrozenTime (period rollovers, trial ends, invoices) is still running.
+	TestClockStatusAdvancing = "advancing"
+	// TestClockStatusFailed means an advance could not be completed.
+	TestClockStatusFailed = "internal_failure"
+)
+
+// TestClock is a simulated "now" for test-mode customers attached to it.
+type TestClock struct {
+	ID    string `json:"id"`
+	OrgID string `json:"org_id"`
+	Name  string `json:"name,omitempty"`
+	// FrozenTime is the clock's current simulated time.
+	FrozenTime time.Time `json:"frozen_time"`
+	// Status is one of the TestClockStatusXxx constants.
+	Status       string    `json:"status"`
+	ErrorMessage string    `json:"error_message,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// CreateTestClockRequest is the body for POST /v1/test-clocks.
+type CreateTestClockRequest struct {
+	Name string `json:"name,omitempty"`
+	// FrozenTime is the clock's starting time.
+	FrozenTime time.Time `json:"frozen_time"`
+}
+
+// AdvanceTestClockRequest is the body for POST /v1/test-clocks/{id}/advance.
+type AdvanceTestClockRequest struct {
+	// FrozenTime is the new simulated time. It must be after the current one.
+	FrozenTime time.Time `json:"frozen_time"`
+}
@@ -2,10 +2,22 @@ package monigo
 
 import (
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
+// queryRangeConcurrency bounds how many chunk queries QueryRange runs at once.
+const queryRangeConcurrency = 4
+
 // UsageService queries usage rollups aggregated from ingested events.
 type UsageService struct {
 	client *Client
@@ -27,6 +39,24 @@ func (s *UsageService) Query(ctx context.Context, params UsageParams) (*UsageQue
 	if params.To != nil {
 		q.Set("to", params.To.UTC().Format(time.RFC3339))
 	}
+	if !params.Filter.IsZero() {
+		q.Set("filter", params.Filter.String())
+	}
+	if params.IsTest != nil {
+		q.Set("is_test", strconv.FormatBool(*params.IsTest))
+	}
+	if params.Cursor != "" {
+		q.Set("cursor", params.Cursor)
+	}
+	if params.Limit > 0 {
+		q.Set("limit", strconv.Itoa(params.Limit))
+	}
+	if len(params.GroupBy) > 0 {
+		q.Set("group_by", strings.Join(params.GroupBy, ","))
+	}
+	if params.Granularity != "" {
+		q.Set("granularity", params.Granularity)
+	}
 
 	path := "/v1/usage"
 	if len(q) > 0 {
@@ -39,3 +69,285 @@ func (s *UsageService) Query(ctx context.Context, params UsageParams) (*UsageQue
 	}
 	return &out, nil
 }
+
+// Top returns the customers with the highest usage of a metric over a
+// period, ranked highest first.
+func (s *UsageService) Top(ctx context.Context, params TopUsageParams) (*TopUsageResponse, error) {
+	f := fieldErrors{}
+	f.required("metric_id", params.MetricID)
+	if err := f.err(); err != nil {
+		return nil, err
+	}
+	q := url.Values{}
+	q.Set("metric_id", params.MetricID)
+	if params.Period != "" {
+		q.Set("period", params.Period)
+	}
+	if params.Limit > 0 {
+		q.Set("limit", strconv.Itoa(params.Limit))
+	}
+
+	var out TopUsageResponse
+	if err := s.client.do(ctx, "GET", "/v1/usage/top?"+q.Encode(), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Recompute rebuilds a metric's rollups from raw events, for when the
+// metric's definition changed and the rollups already stored no longer
+// match it. Unlike EventService.StartReplay it does not reprocess events
+// through the pipeline; it only re-aggregates them:
+//
+//	job, err := client.Usage.Recompute(ctx, monigo.RecomputeRequest{
+//		MetricID:   metric.ID,
+//		CustomerID: customer.ID,
+//	})
+//	job, err = client.Usage.WaitForRecompute(ctx, job.ID, monigo.PollOptions{})
+//
+// Returns a job record immediately — poll GetRecompute to track progress.
+func (s *UsageService) Recompute(ctx context.Context, req RecomputeRequest, opts ...RequestOption) (*RecomputeJob, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if err := checkID(req.MetricID); err != nil {
+		return nil, err
+	}
+	if req.CustomerID != "" {
+		if err := checkID(req.CustomerID); err != nil {
+			return nil, err
+		}
+	}
+
+	var wrapper struct {
+		Job RecomputeJob `json:"job"`
+	}
+	if err := s.client.do(ctx, "POST", "/v1/usage/recompute", req, &wrapper, opts...); err != nil {
+		return nil, err
+	}
+	return &wrapper.Job, nil
+}
+
+// GetRecompute fetches the current status of a rollup recompute job.
+func (s *UsageService) GetRecompute(ctx context.Context, jobID string) (*RecomputeJob, error) {
+	var wrapper struct {
+		Job RecomputeJob `json:"job"`
+	}
+	path, err := pathf("/v1/usage/recompute/%s", jobID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &wrapper); err != nil {
+		return nil, err
+	}
+	return &wrapper.Job, nil
+}
+
+// WaitForRecompute polls a recompute job until it completes, fails, or is
+// canceled, and returns its final state, as EventService.WaitForReplay does
+// for replays. opts.Progress receives rollups recomputed and total.
+func (s *UsageService) WaitForRecompute(ctx context.Context, jobID string, opts PollOptions) (*RecomputeJob, error) {
+	var job *RecomputeJob
+	err := poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		j, err := s.GetRecompute(ctx, jobID)
+		if err != nil {
+			return false, err
+		}
+		job = j
+		if opts.Progress != nil {
+			opts.Progress(j.RollupsRecomputed, j.RollupsTotal)
+		}
+		switch j.Status {
+		case JobStatusCompleted:
+			return true, nil
+		case JobStatusFailed, JobStatusCanceled:
+			msg := j.Status
+			if j.ErrorMessage != nil {
+				msg += ": " + *j.ErrorMessage
+			}
+			return true, fmt.Errorf("%w: recompute %s %s", ErrJobFailed, jobID, msg)
+		}
+		return false, nil
+	})
+	return job, err
+}
+
+// usageCSVHeader is the header row written by Export in CSV format.
+var usageCSVHeader = []string{
+	"id", "customer_id", "metric_id", "period_start", "period_end", "aggregation",
+	"dimensions", "value", "event_count", "last_event_at", "is_test",
+}
+
+// Export writes every rollup matching params to w in format, either
+// ExportFormatCSV (with a header row; Dimensions as a JSON object) or
+// ExportFormatNDJSON (one UsageRollup per line). It follows cursors from
+// params.Cursor until the last page, writing each page as it arrives, and
+// returns the number of rollups written.
+func (s *UsageService) Export(ctx context.Context, params UsageParams, w io.Writer, format string) (int, error) {
+	var write func(r *UsageRollup) error
+	var flush func() error
+	switch format {
+	case ExportFormatNDJSON:
+		enc := json.NewEncoder(w)
+		write = func(r *UsageRollup) error { return enc.Encode(r) }
+		flush = func() error { return nil }
+	case ExportFormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(usageCSVHeader); err != nil {
+			return 0, fmt.Errorf("monigo: export usage: %w", err)
+		}
+		write = func(r *UsageRollup) error { return cw.Write(usageCSVRecord(r)) }
+		flush = func() error { cw.Flush(); return cw.Error() }
+	default:
+		return 0, fmt.Errorf("monigo: unknown export format %q", format)
+	}
+
+	n := 0
+	seen := cursorGuard{}
+	for {
+		page, err := s.Query(ctx, params)
+		if err != nil {
+			return n, err
+		}
+		for i := range page.Rollups {
+			if err := write(&page.Rollups[i]); err != nil {
+				return n, fmt.Errorf("monigo: export usage: %w", err)
+			}
+			n++
+		}
+		if err := flush(); err != nil {
+			return n, fmt.Errorf("monigo: export usage: %w", err)
+		}
+		if page.NextCursor == "" {
+			return n, nil
+		}
+		if err := seen.follow(page.NextCursor); err != nil {
+			return n, err
+		}
+		params.Cursor = page.NextCursor
+	}
+}
+
+func usageCSVRecord(r *UsageRollup) []string {
+	var dims, lastEvent string
+	if len(r.Dimensions) > 0 {
+		b, _ := json.Marshal(r.Dimensions)
+		dims = string(b)
+	}
+	if r.LastEventAt != nil {
+		lastEvent = r.LastEventAt.UTC().Format(time.RFC3339)
+	}
+	return []string{
+		r.ID,
+		r.CustomerID,
+		r.MetricID,
+		r.PeriodStart.UTC().Format(time.RFC3339),
+		r.PeriodEnd.UTC().Format(time.RFC3339),
+		string(r.Aggregation),
+		dims,
+		strconv.FormatFloat(r.Value, 'f', -1, 64),
+		strconv.FormatInt(r.EventCount, 10),
+		lastEvent,
+		strconv.FormatBool(r.IsTest),
+	}
+}
+
+// QueryRange runs Query over a long time range by splitting [From, To) into
+// consecutive windows of length chunk, querying up to four windows in
+// parallel, and merging the rollups in period order. Use it for ranges too
+// large to answer in one request, such as a year of daily rollups.
+//
+// params.From and params.To are required. Every page of each window is
+// fetched; params.Cursor is ignored. The first failing window cancels the
+// rest and its error is returned.
+func (s *UsageService) QueryRange(ctx context.Context, params UsageParams, chunk time.Duration) (*UsageQueryResult, error) {
+	if params.From == nil || params.To == nil {
+		return nil, errors.New("monigo: QueryRange requires From and To")
+	}
+	if chunk <= 0 {
+		return nil, errors.New("monigo: QueryRange chunk must be positive")
+	}
+
+	var windows [][2]time.Time
+	for start := *params.From; start.Before(*params.To); start = start.Add(chunk) {
+		end := start.Add(chunk)
+		if end.After(*params.To) {
+			end = *params.To
+		}
+		windows = append(windows, [2]time.Time{start, end})
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		results  = make([][]UsageRollup, len(windows))
+		sem      = make(chan struct{}, queryRangeConcurrency)
+	)
+	for i, w := range windows {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+
+			p := params
+			p.From, p.To = &w[0], &w[1]
+			p.Cursor = ""
+			seen := cursorGuard{}
+			for {
+				res, err := s.Query(ctx, p)
+				if err == nil && res.NextCursor != "" {
+					err = seen.follow(res.NextCursor)
+				}
+				if err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+					mu.Unlock()
+					return
+				}
+				results[i] = append(results[i], res.Rollups...)
+				if res.NextCursor == "" {
+					return
+				}
+				p.Cursor = res.NextCursor
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	out := &UsageQueryResult{}
+	seen := make(map[string]bool)
+	for _, rollups := range results {
+		for _, r := range rollups {
+			if r.ID != "" {
+				if seen[r.ID] {
+					continue
+				}
+				seen[r.ID] = true
+			}
+			out.Rollups = append(out.Rollups, r)
+		}
+	}
+	sort.SliceStable(out.Rollups, func(i, j int) bool {
+		return out.Rollups[i].PeriodStart.Before(out.Rollups[j].PeriodStart)
+	})
+	out.Count = len(out.Rollups)
+	return out, nil
+}
@@ -2,7 +2,11 @@ package monigo_test
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -91,6 +95,49 @@ func TestUsage_Query_WithTimeRange(t *testing.T) {
 	}
 }
 
+func TestUsage_Query_GroupByAndGranularity(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if got := q.Get("group_by"); got != "customer,properties.endpoint" {
+			t.Errorf("group_by: got %q", got)
+		}
+		if got := q.Get("granularity"); got != monigo.GranularityDay {
+			t.Errorf("granularity: got %q", got)
+		}
+		respondJSON(t, w, 200, monigo.UsageQueryResult{Count: 1, Rollups: []monigo.UsageRollup{
+			{CustomerID: "cust-abc", Dimensions: map[string]string{"endpoint": "/v1/charges"}, Value: 12},
+		}})
+	}))
+
+	res, err := c.Usage.Query(context.Background(), monigo.UsageParams{
+		GroupBy:     []string{monigo.UsageGroupByCustomer, "properties.endpoint"},
+		Granularity: monigo.GranularityDay,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Rollups[0].Dimensions["endpoint"] != "/v1/charges" {
+		t.Errorf("unexpected rollup: %+v", res.Rollups[0])
+	}
+}
+
+func TestUsage_Query_WithFilter(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := `properties.region eq "lagos" and properties.tier in ["pro"]`
+		if got := r.URL.Query().Get("filter"); got != want {
+			t.Errorf("filter: got %q, want %q", got, want)
+		}
+		respondJSON(t, w, 200, monigo.UsageQueryResult{Count: 0, Rollups: []monigo.UsageRollup{}})
+	}))
+
+	_, err := c.Usage.Query(context.Background(), monigo.UsageParams{
+		Filter: monigo.Where("properties.region").Eq("lagos").And(monigo.Where("properties.tier").In("pro")),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestUsage_Query_EmptyResult(t *testing.T) {
 	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		respondJSON(t, w, 200, monigo.UsageQueryResult{Count: 0, Rollups: []monigo.UsageRollup{}})
@@ -117,3 +164,279 @@ func TestUsage_Query_Unauthorized(t *testing.T) {
 		t.Errorf("expected IsUnauthorized=true; err=%v", err)
 	}
 }
+
+func TestUsage_QueryRange(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 25)
+	var mu sync.Mutex
+	var windows []string
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertPath(t, r, "/v1/usage")
+		q := r.URL.Query()
+		if q.Get("customer_id") != "cust-abc" {
+			t.Errorf("customer_id should be passed to every chunk, got %q", q.Get("customer_id"))
+		}
+		start, _ := time.Parse(time.RFC3339, q.Get("from"))
+		mu.Lock()
+		windows = append(windows, q.Get("from")+"/"+q.Get("to"))
+		mu.Unlock()
+		respondJSON(t, w, 200, monigo.UsageQueryResult{
+			Rollups: []monigo.UsageRollup{{ID: "r-" + q.Get("from"), PeriodStart: start, Value: 1}},
+			Count:   1,
+		})
+	}))
+
+	// Every chunk records into the same ResponseMeta concurrently.
+	var meta monigo.ResponseMeta
+	ctx := monigo.ContextWithResponseMeta(context.Background(), &meta)
+	res, err := c.Usage.QueryRange(ctx, monigo.UsageParams{
+		CustomerID: "cust-abc",
+		From:       &from,
+		To:         &to,
+	}, 10*24*time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if meta.StatusCode != 200 {
+		t.Errorf("expected response metadata to be recorded, got %+v", meta)
+	}
+	if len(windows) != 3 {
+		t.Fatalf("expected 3 chunk queries, got %d: %v", len(windows), windows)
+	}
+	sort.Strings(windows)
+	if last := windows[2]; last != "2026-01-21T00:00:00Z/2026-01-26T00:00:00Z" {
+		t.Errorf("last window should be clipped to To, got %s", last)
+	}
+	if res.Count != 3 {
+		t.Fatalf("expected 3 merged rollups, got %d", res.Count)
+	}
+	for i := 1; i < len(res.Rollups); i++ {
+		if res.Rollups[i].PeriodStart.Before(res.Rollups[i-1].PeriodStart) {
+			t.Errorf("rollups not in period order: %v", res.Rollups)
+		}
+	}
+}
+
+func TestUsage_QueryRange_Error(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 30)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("from") == "2026-01-11T00:00:00Z" {
+			respondError(t, w, 504, "query timed out")
+			return
+		}
+		respondJSON(t, w, 200, monigo.UsageQueryResult{})
+	}))
+
+	_, err := c.Usage.QueryRange(context.Background(), monigo.UsageParams{From: &from, To: &to}, 10*24*time.Hour)
+	var apiErr *monigo.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 504 {
+		t.Errorf("expected the 504 from the failing chunk, got %v", err)
+	}
+}
+
+func TestUsage_QueryRange_FollowsCursors(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 20)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		start, _ := time.Parse(time.RFC3339, q.Get("from"))
+		if q.Get("cursor") == "" {
+			respondJSON(t, w, 200, monigo.UsageQueryResult{
+				Rollups:    []monigo.UsageRollup{{ID: "a-" + q.Get("from"), PeriodStart: start}},
+				NextCursor: "page-2",
+			})
+			return
+		}
+		if q.Get("cursor") != "page-2" {
+			t.Errorf("unexpected cursor %q", q.Get("cursor"))
+		}
+		respondJSON(t, w, 200, monigo.UsageQueryResult{
+			Rollups: []monigo.UsageRollup{{ID: "b-" + q.Get("from"), PeriodStart: start}},
+		})
+	}))
+
+	res, err := c.Usage.QueryRange(context.Background(), monigo.UsageParams{From: &from, To: &to}, 10*24*time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Count != 4 {
+		t.Errorf("expected both pages of both windows, got %d rollups: %+v", res.Count, res.Rollups)
+	}
+}
+
+func TestUsage_QueryRange_RequiresBounds(t *testing.T) {
+	c := monigo.New("test_key_abc")
+	if _, err := c.Usage.QueryRange(context.Background(), monigo.UsageParams{}, time.Hour); err == nil {
+		t.Error("expected error without From/To")
+	}
+}
+
+func TestUsage_Export(t *testing.T) {
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertPath(t, r, "/v1/usage")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			respondJSON(t, w, 200, monigo.UsageQueryResult{
+				Rollups:    []monigo.UsageRollup{{ID: "r1", CustomerID: "cust-abc", MetricID: "metric-1", PeriodStart: at, PeriodEnd: at.AddDate(0, 1, 0), Value: 1.5, EventCount: 3}},
+				NextCursor: "page-2",
+			})
+		case "page-2":
+			respondJSON(t, w, 200, monigo.UsageQueryResult{
+				Rollups: []monigo.UsageRollup{{ID: "r2", Dimensions: map[string]string{"region": "eu"}, PeriodStart: at, PeriodEnd: at, Value: 7}},
+			})
+		default:
+			t.Errorf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	}))
+
+	var buf strings.Builder
+	n, err := c.Usage.Export(context.Background(), monigo.UsageParams{}, &buf, monigo.ExportFormatCSV)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("expected 2 rollups, got %d", n)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "id,customer_id,") {
+		t.Fatalf("unexpected CSV:\n%s", buf.String())
+	}
+	if want := "r1,cust-abc,metric-1,2026-01-01T00:00:00Z,2026-02-01T00:00:00Z,,,1.5,3,,false"; lines[1] != want {
+		t.Errorf("row 1:\n got %s\nwant %s", lines[1], want)
+	}
+	if !strings.Contains(lines[2], `"{""region"":""eu""}"`) {
+		t.Errorf("expected quoted dimensions in row 2, got %s", lines[2])
+	}
+}
+
+func TestUsage_Export_NDJSON(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, 200, monigo.UsageQueryResult{Rollups: []monigo.UsageRollup{{ID: "r1"}, {ID: "r2"}}})
+	}))
+
+	var buf strings.Builder
+	if _, err := c.Usage.Export(context.Background(), monigo.UsageParams{}, &buf, monigo.ExportFormatNDJSON); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.Count(buf.String(), "\n"); got != 2 {
+		t.Errorf("expected 2 lines, got %d", got)
+	}
+	if _, err := c.Usage.Export(context.Background(), monigo.UsageParams{}, &buf, "xlsx"); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
+
+func TestUsage_Export_RepeatedCursor(t *testing.T) {
+	calls := 0
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		respondJSON(t, w, 200, monigo.UsageQueryResult{Rollups: []monigo.UsageRollup{{ID: "r1"}}, NextCursor: "stuck"})
+	}))
+
+	var buf strings.Builder
+	if _, err := c.Usage.Export(context.Background(), monigo.UsageParams{}, &buf, monigo.ExportFormatNDJSON); err == nil {
+		t.Error("expected an error for a cursor returned twice")
+	}
+	if calls != 2 {
+		t.Errorf("expected the export to stop after the repeat, got %d calls", calls)
+	}
+}
+
+func TestUsage_Top(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/usage/top")
+		q := r.URL.Query()
+		if q.Get("metric_id") != "metric-1" || q.Get("period") != monigo.UsagePeriodLast30Days || q.Get("limit") != "5" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		respondJSON(t, w, 200, monigo.TopUsageResponse{
+			MetricID:  "metric-1",
+			Customers: []monigo.CustomerUsage{{Rank: 1, CustomerID: "cust-abc", Value: 9000, Share: 0.6}},
+			Count:     1,
+		})
+	}))
+
+	res, err := c.Usage.Top(context.Background(), monigo.TopUsageParams{
+		MetricID: "metric-1",
+		Period:   monigo.UsagePeriodLast30Days,
+		Limit:    5,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(res.Customers) != 1 || res.Customers[0].Rank != 1 || res.Customers[0].Share != 0.6 {
+		t.Errorf("unexpected leaderboard: %+v", res.Customers)
+	}
+
+	if _, err := c.Usage.Top(context.Background(), monigo.TopUsageParams{}); !errors.Is(err, monigo.ErrInvalidRequest) {
+		t.Errorf("expected ErrInvalidRequest without MetricID, got %v", err)
+	}
+}
+
+func TestUsage_Recompute(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "POST")
+		assertPath(t, r, "/v1/usage/recompute")
+		var body map[string]any
+		decodeBody(t, r, &body)
+		if body["metric_id"] != "metric-1" || body["customer_id"] != "cust-abc" || body["period"] != monigo.UsagePeriodPrevious {
+			t.Errorf("unexpected body: %v", body)
+		}
+		respondJSON(t, w, 202, map[string]any{
+			"job": monigo.RecomputeJob{ID: "rc-1", MetricID: "metric-1", CustomerID: "cust-abc", Status: monigo.JobStatusPending},
+		})
+	}))
+
+	job, err := c.Usage.Recompute(context.Background(), monigo.RecomputeRequest{
+		MetricID:   "metric-1",
+		CustomerID: "cust-abc",
+		Period:     monigo.UsagePeriodPrevious,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.ID != "rc-1" || job.Status != monigo.JobStatusPending {
+		t.Errorf("unexpected job: %+v", job)
+	}
+}
+
+func TestUsage_Recompute_Validates(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected")
+	}))
+
+	for _, req := range []monigo.RecomputeRequest{
+		{},
+		{MetricID: "metric-1", Period: "last-year"},
+	} {
+		if _, err := c.Usage.Recompute(context.Background(), req); !errors.Is(err, monigo.ErrInvalidRequest) {
+			t.Errorf("%+v: expected a validation error, got %v", req, err)
+		}
+	}
+}
+
+func TestUsage_WaitForRecompute(t *testing.T) {
+	var polls int
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, r, "GET")
+		assertPath(t, r, "/v1/usage/recompute/rc-1")
+		polls++
+		job := monigo.RecomputeJob{ID: "rc-1", Status: monigo.JobStatusRunning, RollupsTotal: 4, RollupsRecomputed: int64(polls)}
+		if polls == 2 {
+			msg := "metric deleted"
+			job.Status, job.ErrorMessage = monigo.JobStatusFailed, &msg
+		}
+		respondJSON(t, w, 200, map[string]any{"job": job})
+	}))
+
+	job, err := c.Usage.WaitForRecompute(context.Background(), "rc-1", monigo.PollOptions{Interval: time.Millisecond})
+	if !errors.Is(err, monigo.ErrJobFailed) || !strings.Contains(err.Error(), "metric deleted") {
+		t.Fatalf("expected ErrJobFailed with the job's message, got %v", err)
+	}
+	if job == nil || job.Status != monigo.JobStatusFailed || polls != 2 {
+		t.Errorf("unexpected job %+v after %d polls", job, polls)
+	}
+}
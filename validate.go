@@ -0,0 +1,423 @@
+package monigo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/mail"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ErrInvalidRequest is matched, via errors.Is, by the *RequestValidationError
+// that service methods return without contacting the API when a request
+// fails its Validate method.
+var ErrInvalidRequest = errors.New("monigo: invalid request")
+
+// RequestValidationError lists every problem Validate found in a request.
+type RequestValidationError struct {
+	// Fields maps JSON field paths, such as "prices[0].tiers", to what is
+	// wrong with them, in the same shape as APIError.Details.
+	Fields map[string]string
+}
+
+func (e *RequestValidationError) Error() string {
+	keys := make([]string, 0, len(e.Fields))
+	for k := range e.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + ": " + e.Fields[k]
+	}
+	return "monigo: invalid request: " + strings.Join(parts, "; ")
+}
+
+// Is reports whether target is ErrInvalidRequest.
+func (e *RequestValidationError) Is(target error) bool {
+	return target == ErrInvalidRequest
+}
+
+// fieldErrors collects the problems found by a Validate method, keeping the
+// first message for each field.
+type fieldErrors map[string]string
+
+func (f fieldErrors) add(field, format string, args ...any) {
+	if _, ok := f[field]; !ok {
+		f[field] = fmt.Sprintf(format, args...)
+	}
+}
+
+func (f fieldErrors) required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		f.add(field, "is required")
+	}
+}
+
+func (f fieldErrors) err() error {
+	if len(f) == 0 {
+		return nil
+	}
+	return &RequestValidationError{Fields: f}
+}
+
+// e164 matches phone numbers in E.164 format, e.g. +2348012345678.
+var e164 = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+func (f fieldErrors) phone(field, value string) {
+	if value != "" && !e164.MatchString(value) {
+		f.add(field, "must be in E.164 format, e.g. +2348012345678")
+	}
+}
+
+func (f fieldErrors) email(field, value string) {
+	if value == "" {
+		return
+	}
+	if a, err := mail.ParseAddress(value); err != nil || a.Address != value {
+		f.add(field, "is not a valid email address")
+	}
+}
+
+// amount checks that value, if set, is a non-negative decimal string.
+func (f fieldErrors) amount(field, value string) {
+	if value == "" {
+		return
+	}
+	if r, ok := new(big.Rat).SetString(value); !ok || r.Sign() < 0 {
+		f.add(field, "must be a non-negative decimal string")
+	}
+}
+
+// enum checks that v, if set, is one of its type's known values.
+func enum[T interface {
+	~string
+	Valid() bool
+}](f fieldErrors, field string, v T) {
+	if v != "" && !v.Valid() {
+		f.add(field, "unknown value %q", string(v))
+	}
+}
+
+// Validate checks that every event names an event and a customer and
+// carries an idempotency key.
+func (r IngestRequest) Validate() error {
+	f := fieldErrors{}
+	for i, e := range r.Events {
+		p := fmt.Sprintf("events[%d].", i)
+		f.required(p+"event_name", e.EventName)
+		f.required(p+"customer_id", e.CustomerID)
+		f.required(p+"idempotency_key", e.IdempotencyKey)
+	}
+	return f.err()
+}
+
+// Validate checks required fields and the format of Email and Phone.
+func (r CreateCustomerRequest) Validate() error {
+	f := fieldErrors{}
+	f.required("name", r.Name)
+	f.email("email", r.Email)
+	f.phone("phone", r.Phone)
+	return f.err()
+}
+
+// Validate checks the format of Email and Phone.
+func (r UpdateCustomerRequest) Validate() error {
+	f := fieldErrors{}
+	f.email("email", r.Email)
+	f.phone("phone", r.Phone)
+	return f.err()
+}
+
+// Validate checks required fields, that Aggregation is known and has the
+// property it needs, and the filters.
+func (r CreateMetricRequest) Validate() error {
+	f := fieldErrors{}
+	f.required("name", r.Name)
+	f.required("event_name", r.EventName)
+	if r.Aggregation == "" {
+		f.add("aggregation", "is required")
+	}
+	enum(f, "aggregation", r.Aggregation)
+	if r.Aggregation != "" && r.Aggregation != AggregationCount {
+		f.required("aggregation_property", r.AggregationProperty)
+	}
+	validateFilters(f, r.Filters)
+	return f.err()
+}
+
+// Validate checks that Aggregation, if set, is known and the filters.
+func (r UpdateMetricRequest) Validate() error {
+	f := fieldErrors{}
+	enum(f, "aggregation", r.Aggregation)
+	validateFilters(f, r.Filters)
+	return f.err()
+}
+
+func validateFilters(f fieldErrors, filters []MetricFilter) {
+	for i, flt := range filters {
+		p := fmt.Sprintf("filters[%d].", i)
+		f.required(p+"property", flt.Property)
+		switch flt.Operator {
+		case MetricFilterEq, MetricFilterNeq, MetricFilterGt, MetricFilterGte,
+			MetricFilterLt, MetricFilterLte, MetricFilterIn, MetricFilterExists:
+		case "":
+			f.add(p+"operator", "is required")
+		default:
+			f.add(p+"operator", "unknown value %q", flt.Operator)
+		}
+	}
+}
+
+// Validate checks required fields, enums, and each price.
+func (r CreatePlanRequest) Validate() error {
+	f := fieldErrors{}
+	f.required("name", r.Name)
+	enum(f, "plan_type", r.PlanType)
+	enum(f, "billing_period", r.BillingPeriod)
+	f.amount("minimum_amount", r.MinimumAmount)
+	for i, p := range r.Prices {
+		validatePrice(f, fmt.Sprintf("prices[%d].", i), p.MetricID, p.Model, p.UnitPrice, p.Tiers, true)
+	}
+	return f.err()
+}
+
+// Validate checks enums and each price that sets a model.
+func (r UpdatePlanRequest) Validate() error {
+	f := fieldErrors{}
+	enum(f, "plan_type", r.PlanType)
+	enum(f, "billing_period", r.BillingPeriod)
+	if r.MinimumAmount != nil {
+		f.amount("minimum_amount", *r.MinimumAmount)
+	}
+	for i, p := range r.Prices {
+		validatePrice(f, fmt.Sprintf("prices[%d].", i), p.MetricID, p.Model, p.UnitPrice, p.Tiers, p.ID == "")
+	}
+	return f.err()
+}
+
+// validatePrice checks one price. New prices must name a metric and model.
+func validatePrice(f fieldErrors, p, metricID string, model PricingModel, unitPrice string, tiers json.RawMessage, isNew bool) {
+	if isNew {
+		f.required(p+"metric_id", metricID)
+		if model == "" {
+			f.add(p+"model", "is required")
+		}
+	}
+	enum(f, p+"model", model)
+	f.amount(p+"unit_price", unitPrice)
+
+	var err error
+	switch model {
+	case PricingModelFlat, PricingModelPerUnit:
+		if isNew {
+			f.required(p+"unit_price", unitPrice)
+		}
+		return
+	case PricingModelTiered, PricingModelVolume, PricingModelWeightedTiered:
+		if len(tiers) == 0 {
+			break
+		}
+		var t []PriceTier
+		if err = json.Unmarshal(tiers, &t); err == nil {
+			err = ValidateTiers(t)
+		}
+	case PricingModelPackage:
+		if len(tiers) == 0 {
+			break
+		}
+		var c PackageConfig
+		if err = json.Unmarshal(tiers, &c); err == nil && c.PackageSize <= 0 {
+			err = errors.New("package_size must be positive")
+		}
+		if err == nil {
+			f.amount(p+"tiers.package_price", c.PackagePrice)
+		}
+	case PricingModelOverage:
+		if len(tiers) == 0 {
+			break
+		}
+		var c OverageConfig
+		if err = json.Unmarshal(tiers, &c); err == nil && c.IncludedUnits < 0 {
+			err = errors.New("included_units must not be negative")
+		}
+		if err == nil {
+			f.amount(p+"tiers.base_price", c.BasePrice)
+			f.amount(p+"tiers.overage_price", c.OveragePrice)
+		}
+	default:
+		return
+	}
+	if len(tiers) == 0 && isNew {
+		f.add(p+"tiers", "is required for the %s model", model)
+	}
+	if err != nil {
+		f.add(p+"tiers", "%s", strings.TrimPrefix(err.Error(), "monigo: "))
+	}
+}
+
+// Validate checks that a metric is named and the period is known.
+func (r RecomputeRequest) Validate() error {
+	f := fieldErrors{}
+	f.required("metric_id", r.MetricID)
+	switch r.Period {
+	case "", UsagePeriodCurrent, UsagePeriodPrevious, UsagePeriodLast7Days, UsagePeriodLast30Days:
+	default:
+		f.add("period", "must be one of %q, %q, %q or %q", UsagePeriodCurrent, UsagePeriodPrevious, UsagePeriodLast7Days, UsagePeriodLast30Days)
+	}
+	return f.err()
+}
+
+// Validate checks that the replay window is set and in order.
+func (r ReplayRequest) Validate() error {
+	f := fieldErrors{}
+	if r.From.IsZero() {
+		f.add("from", "is required")
+	}
+	if r.To.IsZero() {
+		f.add("to", "is required")
+	} else if !r.To.After(r.From) {
+		f.add("to", "must be after from")
+	}
+	return f.err()
+}
+
+// Validate checks required fields and mutually exclusive options.
+func (r CreateSubscriptionRequest) Validate() error {
+	f := fieldErrors{}
+	f.required("customer_id", r.CustomerID)
+	f.required("plan_id", r.PlanID)
+	if r.StartAt != nil && r.BackdateTo != nil {
+		f.add("backdate_to", "cannot be combined with start_at")
+	}
+	if r.TrialDays != nil && r.TrialEndsAt != nil {
+		f.add("trial_ends_at", "cannot be combined with trial_days")
+	}
+	if r.TrialDays != nil && *r.TrialDays < 0 {
+		f.add("trial_days", "must not be negative")
+	}
+	enum(f, "proration_behavior", r.ProrationBehavior)
+	if r.ProrationBehavior != "" && r.BillingCycleAnchor == nil {
+		f.add("proration_behavior", "requires billing_cycle_anchor")
+	}
+	f.amount("minimum_amount", r.MinimumAmount)
+	return f.err()
+}
+
+// Validate checks that an explicit period has both bounds, in order.
+func (r GenerateInvoiceRequest) Validate() error {
+	f := fieldErrors{}
+	f.required("subscription_id", r.SubscriptionID)
+	start := r.PeriodStart != nil && !r.PeriodStart.IsZero()
+	end := r.PeriodEnd != nil && !r.PeriodEnd.IsZero()
+	switch {
+	case start && !end:
+		f.add("period_end", "is required with period_start")
+	case end && !start:
+		f.add("period_start", "is required with period_end")
+	case start && !r.PeriodEnd.After(*r.PeriodStart):
+		f.add("period_end", "must be after period_start")
+	}
+	return f.err()
+}
+
+// Validate checks that Status, if set, is known.
+func (r UpdateSubscriptionRequest) Validate() error {
+	f := fieldErrors{}
+	enum(f, "status", r.Status)
+	if r.MinimumAmount != nil {
+		f.amount("minimum_amount", *r.MinimumAmount)
+	}
+	return f.err()
+}
+
+// Validate checks required fields and the account details each payout
+// method needs.
+func (r CreatePayoutAccountRequest) Validate() error {
+	f := fieldErrors{}
+	f.required("account_name", r.AccountName)
+	if r.PayoutMethod == "" {
+		f.add("payout_method", "is required")
+	}
+	enum(f, "payout_method", r.PayoutMethod)
+	switch r.PayoutMethod {
+	case PayoutMethodBankTransfer:
+		f.required("account_number", r.AccountNumber)
+		if r.BankCode == "" && r.BankName == "" {
+			f.add("bank_code", "bank_code or bank_name is required for bank_transfer")
+		}
+	case PayoutMethodMobileMoney:
+		f.required("mobile_money_number", r.MobileMoneyNumber)
+	}
+	return f.err()
+}
+
+// Validate checks that PayoutMethod, if set, is known.
+func (r UpdatePayoutAccountRequest) Validate() error {
+	f := fieldErrors{}
+	enum(f, "payout_method", r.PayoutMethod)
+	return f.err()
+}
+
+// Validate checks required fields and that Type is known.
+func (r CreatePaymentMethodRequest) Validate() error {
+	f := fieldErrors{}
+	if r.Type == "" {
+		f.add("type", "is required")
+	}
+	enum(f, "type", r.Type)
+	f.required("provider", r.Provider)
+	f.required("provider_token", r.ProviderToken)
+	return f.err()
+}
+
+// Validate checks that the schedules are in ascending order, that counts
+// are not negative, and that ExhaustedAction, if set, is known.
+func (r UpdateDunningPolicyRequest) Validate() error {
+	f := fieldErrors{}
+	if r.ReminderDays != nil && !sort.IntsAreSorted(*r.ReminderDays) {
+		f.add("reminder_days", "must be in ascending order")
+	}
+	if r.RetryDays != nil {
+		for i, d := range *r.RetryDays {
+			if d < 0 {
+				f.add(fmt.Sprintf("retry_days[%d]", i), "must not be negative")
+			}
+		}
+		if !sort.IntsAreSorted(*r.RetryDays) {
+			f.add("retry_days", "must be in ascending order")
+		}
+	}
+	if r.MaxRetryAttempts != nil && *r.MaxRetryAttempts < 0 {
+		f.add("max_retry_attempts", "must not be negative")
+	}
+	enum(f, "exhausted_action", r.ExhaustedAction)
+	return f.err()
+}
+
+// Validate checks required fields, that Limit is not negative, and that
+// Behavior, if set, is known.
+func (r CreateQuotaRequest) Validate() error {
+	f := fieldErrors{}
+	f.required("subscription_id", r.SubscriptionID)
+	f.required("metric_id", r.MetricID)
+	if r.Limit < 0 {
+		f.add("limit", "must not be negative")
+	}
+	enum(f, "behavior", r.Behavior)
+	return f.err()
+}
+
+// Validate checks that Limit is not negative and that Behavior, if set, is
+// known.
+func (r UpdateQuotaRequest) Validate() error {
+	f := fieldErrors{}
+	if r.Limit != nil && *r.Limit < 0 {
+		f.add("limit", "must not be negative")
+	}
+	enum(f, "behavior", r.Behavior)
+	return f.err()
+}
@@ -0,0 +1,82 @@
+package monigo_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestValidate_AggregatesFieldErrors(t *testing.T) {
+	err := monigo.CreateCustomerRequest{Email: "not-an-email", Phone: "08012345678"}.Validate()
+	var verr *monigo.RequestValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected RequestValidationError, got %v", err)
+	}
+	for _, field := range []string{"name", "email", "phone"} {
+		if verr.Fields[field] == "" {
+			t.Errorf("expected an error for %s, got %v", field, verr.Fields)
+		}
+	}
+	if !errors.Is(err, monigo.ErrInvalidRequest) || !monigo.IsValidationError(err) {
+		t.Error("expected ErrInvalidRequest and IsValidationError to match")
+	}
+	if err := (monigo.CreateCustomerRequest{Name: "Acme", Phone: "+2348012345678", Email: "billing@acme.co"}).Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestValidate_Plan(t *testing.T) {
+	neg := int64(-5)
+	tiers, _ := json.Marshal([]monigo.PriceTier{{UpTo: &neg, UnitAmount: "1"}, {UnitAmount: "0.5"}})
+	err := monigo.CreatePlanRequest{
+		Name:          "Pro",
+		BillingPeriod: "fortnightly",
+		Prices: []monigo.CreatePriceRequest{
+			{MetricID: "metric-1", Model: monigo.PricingModelTiered, Tiers: tiers},
+			{Model: monigo.PricingModelFlat},
+		},
+	}.Validate()
+	var verr *monigo.RequestValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected RequestValidationError, got %v", err)
+	}
+	for _, field := range []string{"billing_period", "prices[0].tiers", "prices[1].metric_id", "prices[1].unit_price"} {
+		if verr.Fields[field] == "" {
+			t.Errorf("expected an error for %s, got %v", field, verr.Fields)
+		}
+	}
+}
+
+func TestValidate_Metric(t *testing.T) {
+	err := monigo.CreateMetricRequest{
+		Name:        "Bytes",
+		Aggregation: monigo.AggregationSum,
+		Filters:     []monigo.MetricFilter{{Property: "region", Operator: "like"}},
+	}.Validate()
+	var verr *monigo.RequestValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected RequestValidationError, got %v", err)
+	}
+	for _, field := range []string{"event_name", "aggregation_property", "filters[0].operator"} {
+		if verr.Fields[field] == "" {
+			t.Errorf("expected an error for %s, got %v", field, verr.Fields)
+		}
+	}
+}
+
+func TestValidate_CalledBeforeRequest(t *testing.T) {
+	c := mockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+	ctx := context.Background()
+	if _, err := c.Subscriptions.Create(ctx, monigo.CreateSubscriptionRequest{CustomerID: "cust-abc"}); !errors.Is(err, monigo.ErrInvalidRequest) {
+		t.Errorf("Subscriptions.Create: expected ErrInvalidRequest, got %v", err)
+	}
+	if _, err := c.Events.Ingest(ctx, monigo.IngestRequest{Events: []monigo.IngestEvent{{CustomerID: "cust-abc", IdempotencyKey: "k1"}}}); !errors.Is(err, monigo.ErrInvalidRequest) {
+		t.Errorf("Events.Ingest: expected ErrInvalidRequest, got %v", err)
+	}
+}
@@ -2,7 +2,6 @@ package monigo
 
 import (
 	"context"
-	"fmt"
 	"net/url"
 	"strconv"
 )
@@ -44,7 +43,11 @@ func (s *WalletService) List(ctx context.Context, params ...ListWalletsParams) (
 // ListByCustomer returns all wallets belonging to a specific customer.
 func (s *WalletService) ListByCustomer(ctx context.Context, customerID string) (*ListWalletsResponse, error) {
 	var out ListWalletsResponse
-	if err := s.client.do(ctx, "GET", fmt.Sprintf("/v1/customers/%s/wallets", customerID), nil, &out); err != nil {
+	path, err := pathf("/v1/customers/%s/wallets", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
 		return nil, err
 	}
 	return &out, nil
@@ -53,7 +56,11 @@ func (s *WalletService) ListByCustomer(ctx context.Context, customerID string) (
 // Get fetches a single wallet by its UUID, including its virtual accounts.
 func (s *WalletService) Get(ctx context.Context, walletID string) (*WalletWithVirtualAccountsResponse, error) {
 	var out WalletWithVirtualAccountsResponse
-	if err := s.client.do(ctx, "GET", fmt.Sprintf("/v1/wallets/%s", walletID), nil, &out); err != nil {
+	path, err := pathf("/v1/wallets/%s", walletID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
 		return nil, err
 	}
 	return &out, nil
@@ -62,7 +69,11 @@ func (s *WalletService) Get(ctx context.Context, walletID string) (*WalletWithVi
 // Credit adds funds to a wallet and returns the updated wallet with ledger entries.
 func (s *WalletService) Credit(ctx context.Context, walletID string, req CreditWalletRequest, opts ...RequestOption) (*WalletOperationResponse, error) {
 	var out WalletOperationResponse
-	if err := s.client.do(ctx, "POST", fmt.Sprintf("/v1/wallets/%s/credit", walletID), req, &out, opts...); err != nil {
+	path, err := pathf("/v1/wallets/%s/credit", walletID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, req, &out, opts...); err != nil {
 		return nil, err
 	}
 	return &out, nil
@@ -72,7 +83,11 @@ func (s *WalletService) Credit(ctx context.Context, walletID string, req CreditW
 // Returns a 402 error if the wallet has insufficient balance.
 func (s *WalletService) Debit(ctx context.Context, walletID string, req DebitWalletRequest, opts ...RequestOption) (*WalletOperationResponse, error) {
 	var out WalletOperationResponse
-	if err := s.client.do(ctx, "POST", fmt.Sprintf("/v1/wallets/%s/debit", walletID), req, &out, opts...); err != nil {
+	path, err := pathf("/v1/wallets/%s/debit", walletID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, req, &out, opts...); err != nil {
 		return nil, err
 	}
 	return &out, nil
@@ -88,7 +103,10 @@ func (s *WalletService) ListTransactions(ctx context.Context, walletID string, p
 		q.Set("offset", strconv.Itoa(params.Offset))
 	}
 
-	path := fmt.Sprintf("/v1/wallets/%s/transactions", walletID)
+	path, err := pathf("/v1/wallets/%s/transactions", walletID)
+	if err != nil {
+		return nil, err
+	}
 	if len(q) > 0 {
 		path = path + "?" + q.Encode()
 	}
@@ -106,7 +124,11 @@ func (s *WalletService) CreateVirtualAccount(ctx context.Context, walletID strin
 	var wrapper struct {
 		VirtualAccount VirtualAccount `json:"virtual_account"`
 	}
-	if err := s.client.do(ctx, "POST", fmt.Sprintf("/v1/wallets/%s/virtual-accounts", walletID), req, &wrapper, opts...); err != nil {
+	path, err := pathf("/v1/wallets/%s/virtual-accounts", walletID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "POST", path, req, &wrapper, opts...); err != nil {
 		return nil, err
 	}
 	return &wrapper.VirtualAccount, nil
@@ -115,7 +137,11 @@ func (s *WalletService) CreateVirtualAccount(ctx context.Context, walletID strin
 // ListVirtualAccounts returns all virtual accounts linked to a wallet.
 func (s *WalletService) ListVirtualAccounts(ctx context.Context, walletID string) (*ListVirtualAccountsResponse, error) {
 	var out ListVirtualAccountsResponse
-	if err := s.client.do(ctx, "GET", fmt.Sprintf("/v1/wallets/%s/virtual-accounts", walletID), nil, &out); err != nil {
+	path, err := pathf("/v1/wallets/%s/virtual-accounts", walletID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(ctx, "GET", path, nil, &out); err != nil {
 		return nil, err
 	}
 	return &out, nil
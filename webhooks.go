@@ -0,0 +1,82 @@
+package monigo
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WebhookSignatureHeader is the request header that carries a webhook
+// delivery's signature.
+const WebhookSignatureHeader = "Monigo-Signature"
+
+// WebhookTolerance is how far a delivery's signed timestamp may be from the
+// current time before VerifyWebhook rejects it as a replay.
+const WebhookTolerance = 5 * time.Minute
+
+// ErrInvalidWebhookSignature is matched, via errors.Is, by the error
+// VerifyWebhook returns for a delivery that was not signed with the secret,
+// is missing its signature, or is too old.
+var ErrInvalidWebhookSignature = errors.New("monigo: invalid webhook signature")
+
+// VerifyWebhook checks that body was sent by Monigo. signature is the value
+// of the WebhookSignatureHeader header, "t=<unix seconds>,v1=<hex>", where
+// v1 is the HMAC-SHA256 of "<t>.<body>" keyed with the endpoint's signing
+// secret. While a secret is being rotated the header carries one v1 per
+// secret; any match is accepted.
+//
+// Always verify before acting on a delivery; ParseAlertWebhook and
+// ParseDunningWebhook do so themselves.
+func VerifyWebhook(body []byte, signature, secret string) error {
+	if secret == "" {
+		return errors.New("monigo: VerifyWebhook requires the signing secret")
+	}
+	var (
+		ts   string
+		sigs []string
+	)
+	for _, part := range strings.Split(signature, ",") {
+		k, v, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch k {
+		case "t":
+			ts = v
+		case "v1":
+			sigs = append(sigs, v)
+		}
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || len(sigs) == 0 {
+		return fmt.Errorf("%w: malformed %s header", ErrInvalidWebhookSignature, WebhookSignatureHeader)
+	}
+	if age := time.Since(time.Unix(unix, 0)); age > WebhookTolerance || age < -WebhookTolerance {
+		return fmt.Errorf("%w: timestamp outside the %s tolerance", ErrInvalidWebhookSignature, WebhookTolerance)
+	}
+	want := webhookMAC(body, ts, secret)
+	for _, s := range sigs {
+		got, err := hex.DecodeString(s)
+		if err == nil && hmac.Equal(got, want) {
+			return nil
+		}
+	}
+	return ErrInvalidWebhookSignature
+}
+
+// SignWebhook returns the WebhookSignatureHeader value Monigo would send
+// with body at time t. Use it to build deliveries in tests.
+func SignWebhook(body []byte, secret string, t time.Time) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(webhookMAC(body, ts, secret))
+}
+
+func webhookMAC(body []byte, ts, secret string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
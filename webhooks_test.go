@@ -0,0 +1,55 @@
+package monigo_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	monigo "github.com/monigo-africa/go-monigo"
+)
+
+func TestVerifyWebhook(t *testing.T) {
+	body := []byte(`{"type":"dunning.exhausted"}`)
+	now := time.Now()
+	valid := monigo.SignWebhook(body, "whsec_new", now)
+	rotating := valid + "," + strings.Split(monigo.SignWebhook(body, "whsec_old", now), ",")[1]
+
+	cases := []struct {
+		name      string
+		signature string
+		body      []byte
+		ok        bool
+	}{
+		{"valid", valid, body, true},
+		{"either secret while rotating", rotating, body, true},
+		{"tampered body", valid, []byte(`{"type":"dunning.exhausted","x":1}`), false},
+		{"other secret", monigo.SignWebhook(body, "whsec_other", now), body, false},
+		{"stale", monigo.SignWebhook(body, "whsec_new", now.Add(-time.Hour)), body, false},
+		{"missing", "", body, false},
+		{"no timestamp", "v1=" + strings.Split(valid, "v1=")[1], body, false},
+	}
+	for _, c := range cases {
+		err := monigo.VerifyWebhook(c.body, c.signature, "whsec_new")
+		if c.ok && err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		}
+		if !c.ok && !errors.Is(err, monigo.ErrInvalidWebhookSignature) {
+			t.Errorf("%s: expected ErrInvalidWebhookSignature, got %v", c.name, err)
+		}
+	}
+}
+
+func TestParseDunningWebhook_RejectsUnsigned(t *testing.T) {
+	body := []byte(`{"type":"dunning.exhausted","invoice_id":"inv-1"}`)
+	if _, err := monigo.ParseDunningWebhook(body, "", "whsec_test"); !errors.Is(err, monigo.ErrInvalidWebhookSignature) {
+		t.Errorf("expected ErrInvalidWebhookSignature, got %v", err)
+	}
+}
+
+func TestParseAlertWebhook_RejectsUnsigned(t *testing.T) {
+	body := []byte(`{"type":"alert.triggered","customer_id":"cust-abc"}`)
+	if _, err := monigo.ParseAlertWebhook(body, "", "whsec_test"); !errors.Is(err, monigo.ErrInvalidWebhookSignature) {
+		t.Errorf("expected ErrInvalidWebhookSignature, got %v", err)
+	}
+}